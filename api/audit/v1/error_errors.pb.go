@@ -0,0 +1,44 @@
+// Code generated by protoc-gen-go-errors. DO NOT EDIT.
+
+package v1
+
+import (
+	errors "github.com/tkeel-io/kit/errors"
+	codes "google.golang.org/grpc/codes"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the ego package it is being compiled against.
+const _ = errors.SupportPackageIsVersion1
+
+var auditErrUnknown *errors.TError
+var auditErrInternalError *errors.TError
+var auditErrInvalidArgument *errors.TError
+var auditErrPermissionDenied *errors.TError
+
+func init() {
+	auditErrUnknown = errors.New(int(codes.Unknown), "io.tkeel.rudder.api.audit.v1.AUDIT_ERR_UNKNOWN", "未知类型")
+	errors.Register(auditErrUnknown)
+	auditErrInternalError = errors.New(int(codes.Internal), "io.tkeel.rudder.api.audit.v1.AUDIT_ERR_INTERNAL_ERROR", "请求后端内部错误")
+	errors.Register(auditErrInternalError)
+	auditErrInvalidArgument = errors.New(int(codes.InvalidArgument), "io.tkeel.rudder.api.audit.v1.AUDIT_ERR_INVALID_ARGUMENT", "请求参数无效")
+	errors.Register(auditErrInvalidArgument)
+	auditErrPermissionDenied = errors.New(int(codes.PermissionDenied), "io.tkeel.rudder.api.audit.v1.AUDIT_ERR_PERMISSION_DENIED", "没有查询审计日志的权限")
+	errors.Register(auditErrPermissionDenied)
+}
+
+func AuditErrUnknown() errors.Error {
+	return auditErrUnknown
+}
+
+func AuditErrInternalError() errors.Error {
+	return auditErrInternalError
+}
+
+func AuditErrInvalidArgument() errors.Error {
+	return auditErrInvalidArgument
+}
+
+func AuditErrPermissionDenied() errors.Error {
+	return auditErrPermissionDenied
+}
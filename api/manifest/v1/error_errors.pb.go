@@ -0,0 +1,44 @@
+// Code generated by protoc-gen-go-errors. DO NOT EDIT.
+
+package v1
+
+import (
+	errors "github.com/tkeel-io/kit/errors"
+	codes "google.golang.org/grpc/codes"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the ego package it is being compiled against.
+const _ = errors.SupportPackageIsVersion1
+
+var manifestErrUnknown *errors.TError
+var manifestErrInternalError *errors.TError
+var manifestErrInvalidManifest *errors.TError
+var manifestErrPermissionDenied *errors.TError
+
+func init() {
+	manifestErrUnknown = errors.New(int(codes.Unknown), "io.tkeel.rudder.api.manifest.v1.MANIFEST_ERR_UNKNOWN", "未知类型")
+	errors.Register(manifestErrUnknown)
+	manifestErrInternalError = errors.New(int(codes.Internal), "io.tkeel.rudder.api.manifest.v1.MANIFEST_ERR_INTERNAL_ERROR", "请求后端内部错误")
+	errors.Register(manifestErrInternalError)
+	manifestErrInvalidManifest = errors.New(int(codes.InvalidArgument), "io.tkeel.rudder.api.manifest.v1.MANIFEST_ERR_INVALID_MANIFEST", "平台声明清单无效")
+	errors.Register(manifestErrInvalidManifest)
+	manifestErrPermissionDenied = errors.New(int(codes.PermissionDenied), "io.tkeel.rudder.api.manifest.v1.MANIFEST_ERR_PERMISSION_DENIED", "非平台管理员")
+	errors.Register(manifestErrPermissionDenied)
+}
+
+func ManifestErrUnknown() errors.Error {
+	return manifestErrUnknown
+}
+
+func ManifestErrInternalError() errors.Error {
+	return manifestErrInternalError
+}
+
+func ManifestErrInvalidManifest() errors.Error {
+	return manifestErrInvalidManifest
+}
+
+func ManifestErrPermissionDenied() errors.Error {
+	return manifestErrPermissionDenied
+}
@@ -0,0 +1,679 @@
+//
+//Copyright 2021 The tKeel Authors.
+//Licensed under the Apache License, Version 2.0 (the "License");
+//you may not use this file except in compliance with the License.
+//You may obtain a copy of the License at
+//
+//http://www.apache.org/licenses/LICENSE-2.0
+//
+//Unless required by applicable law or agreed to in writing, software
+//distributed under the License is distributed on an "AS IS" BASIS,
+//WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//See the License for the specific language governing permissions and
+//limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.1
+// source: api/manifest/v1/manifest.proto
+
+package v1
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ManifestChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind    string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Action  string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Target  string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Detail  string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	Status  string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ManifestChange) Reset() {
+	*x = ManifestChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_manifest_v1_manifest_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManifestChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestChange) ProtoMessage() {}
+
+func (x *ManifestChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_manifest_v1_manifest_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestChange.ProtoReflect.Descriptor instead.
+func (*ManifestChange) Descriptor() ([]byte, []int) {
+	return file_api_manifest_v1_manifest_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ManifestChange) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ManifestChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ManifestChange) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ManifestChange) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ManifestChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ManifestChange) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ApplyManifestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manifest []byte `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Prune    bool   `protobuf:"varint,2,opt,name=prune,proto3" json:"prune,omitempty"`
+	DryRun   bool   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ApplyManifestRequest) Reset() {
+	*x = ApplyManifestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_manifest_v1_manifest_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyManifestRequest) ProtoMessage() {}
+
+func (x *ApplyManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_manifest_v1_manifest_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyManifestRequest.ProtoReflect.Descriptor instead.
+func (*ApplyManifestRequest) Descriptor() ([]byte, []int) {
+	return file_api_manifest_v1_manifest_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApplyManifestRequest) GetManifest() []byte {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *ApplyManifestRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+func (x *ApplyManifestRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ApplyManifestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*ManifestChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Failed  int32             `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *ApplyManifestResponse) Reset() {
+	*x = ApplyManifestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_manifest_v1_manifest_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyManifestResponse) ProtoMessage() {}
+
+func (x *ApplyManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_manifest_v1_manifest_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyManifestResponse.ProtoReflect.Descriptor instead.
+func (*ApplyManifestResponse) Descriptor() ([]byte, []int) {
+	return file_api_manifest_v1_manifest_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApplyManifestResponse) GetChanges() []*ManifestChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ApplyManifestResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type DiffManifestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manifest []byte `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Prune    bool   `protobuf:"varint,2,opt,name=prune,proto3" json:"prune,omitempty"`
+}
+
+func (x *DiffManifestRequest) Reset() {
+	*x = DiffManifestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_manifest_v1_manifest_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffManifestRequest) ProtoMessage() {}
+
+func (x *DiffManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_manifest_v1_manifest_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffManifestRequest.ProtoReflect.Descriptor instead.
+func (*DiffManifestRequest) Descriptor() ([]byte, []int) {
+	return file_api_manifest_v1_manifest_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DiffManifestRequest) GetManifest() []byte {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *DiffManifestRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+type DiffManifestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*ManifestChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *DiffManifestResponse) Reset() {
+	*x = DiffManifestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_manifest_v1_manifest_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffManifestResponse) ProtoMessage() {}
+
+func (x *DiffManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_manifest_v1_manifest_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffManifestResponse.ProtoReflect.Descriptor instead.
+func (*DiffManifestResponse) Descriptor() ([]byte, []int) {
+	return file_api_manifest_v1_manifest_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DiffManifestResponse) GetChanges() []*ManifestChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ExportManifestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manifest []byte `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+}
+
+func (x *ExportManifestResponse) Reset() {
+	*x = ExportManifestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_manifest_v1_manifest_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportManifestResponse) ProtoMessage() {}
+
+func (x *ExportManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_manifest_v1_manifest_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportManifestResponse.ProtoReflect.Descriptor instead.
+func (*ExportManifestResponse) Descriptor() ([]byte, []int) {
+	return file_api_manifest_v1_manifest_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExportManifestResponse) GetManifest() []byte {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+var File_api_manifest_v1_manifest_proto protoreflect.FileDescriptor
+
+var file_api_manifest_v1_manifest_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x1f, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x76,
+	0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x02, 0x0a,
+	0x0e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x4c, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0x92,
+	0x41, 0x35, 0x32, 0x33, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1,
+	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x5b, 0x72, 0x65, 0x70, 0x6f, 0x2c, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2c, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5d, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x29, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe5, 0x8a, 0xa8, 0xe4, 0xbd, 0x9c,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5,
+	0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4,
+	0xe8, 0xaf, 0xa6, 0xe6, 0x83, 0x85, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x58,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x40,
+	0x92, 0x41, 0x3d, 0x32, 0x3b, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
+	0x81, 0x5b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x2c, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x2c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5d, 0xef, 0xbc, 0x8c, 0xe4, 0xbb,
+	0x85, 0xe5, 0xaf, 0xb9, 0xe6, 0xaf, 0x94, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39,
+	0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0xb9, 0xb3, 0xe5, 0x8f, 0xb0, 0xe5, 0xa3, 0xb0,
+	0xe6, 0x98, 0x8e, 0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0x28, 0x59, 0x41, 0x4d, 0x4c, 0x29, 0x52,
+	0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x05, 0x70, 0x72, 0x75,
+	0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3e, 0x92, 0x41, 0x3b, 0x32, 0x39, 0xe6,
+	0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6, 0xb8, 0x85, 0xe5, 0x8d,
+	0x95, 0xe4, 0xb9, 0x8b, 0xe5, 0xa4, 0x96, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93,
+	0xe3, 0x80, 0x81, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x92, 0x8c, 0xe7, 0xa7, 0x9f, 0xe6,
+	0x88, 0xb7, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0x52, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x12,
+	0x36, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe4, 0xbb, 0x85, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
+	0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe4, 0xb8, 0x8d, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75,
+	0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4,
+	0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe5, 0x8f, 0x98, 0xe6,
+	0x9b, 0xb4, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x22, 0xaf, 0x01, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32,
+	0x18, 0xe5, 0xb9, 0xb3, 0xe5, 0x8f, 0xb0, 0xe5, 0xa3, 0xb0, 0xe6, 0x98, 0x8e, 0xe6, 0xb8, 0x85,
+	0xe5, 0x8d, 0x95, 0x28, 0x59, 0x41, 0x4d, 0x4c, 0x29, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x47, 0x92, 0x41, 0x44, 0x32, 0x42, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5,
+	0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0xe4, 0xb9, 0x8b, 0xe5, 0xa4,
+	0x96, 0xe7, 0x9a, 0x84, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe3, 0x80, 0x81, 0xe6, 0x8f, 0x92,
+	0xe4, 0xbb, 0xb6, 0xe5, 0x92, 0x8c, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0xaf, 0xe7,
+	0x94, 0xa8, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x52, 0x05, 0x70, 0x72, 0x75,
+	0x6e, 0x65, 0x22, 0x74, 0x0a, 0x14, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x71, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x36, 0xe5, 0xb9, 0xb3, 0xe5, 0x8f,
+	0xb0, 0xe5, 0xa3, 0xb0, 0xe6, 0x98, 0x8e, 0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0x28, 0x59, 0x41,
+	0x4d, 0x4c, 0x29, 0xef, 0xbc, 0x8c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x95, 0x8f, 0xe6,
+	0x84, 0x9f, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe4, 0xb8, 0x8d, 0xe5, 0xaf, 0xbc, 0xe5, 0x87,
+	0xba, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x32, 0xec, 0x06, 0x0a, 0x08,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0xb5, 0x02, 0x0a, 0x0d, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x35, 0x2e, 0x69, 0x6f, 0x2e,
+	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x36, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64,
+	0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb4, 0x01, 0x92, 0x41, 0x96, 0x01,
+	0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x1e, 0xe5, 0xba, 0x94, 0xe7,
+	0x94, 0xa8, 0xe5, 0xb9, 0xb3, 0xe5, 0x8f, 0xb0, 0xe5, 0xa3, 0xb0, 0xe6, 0x98, 0x8e, 0xe6, 0xb8,
+	0x85, 0xe5, 0x8d, 0x95, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0d, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a,
+	0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e,
+	0x54, 0x4a, 0x1a, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x13, 0x0a, 0x11, 0x50, 0x45, 0x52, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x4a, 0x17, 0x0a,
+	0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22,
+	0x0f, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79,
+	0x12, 0xb0, 0x02, 0x0a, 0x0c, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x12, 0x34, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64,
+	0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65,
+	0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb2,
+	0x01, 0x92, 0x41, 0x95, 0x01, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0xe5, 0xaf, 0xb9, 0xe6, 0xaf, 0x94, 0xe5, 0xb9, 0xb3, 0xe5, 0x8f, 0xb0, 0xe5, 0xa3, 0xb0,
+	0xe6, 0x98, 0x8e, 0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a,
+	0x0c, 0x44, 0x69, 0x66, 0x66, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47,
+	0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x1a, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x13, 0x0a, 0x11,
+	0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45,
+	0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2f, 0x64,
+	0x69, 0x66, 0x66, 0x12, 0xf4, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x37,
+	0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x90, 0x01, 0x92, 0x41, 0x7c, 0x0a, 0x08, 0x6d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x1e, 0xe5, 0xaf, 0xbc, 0xe5, 0x87, 0xba, 0xe5,
+	0xb9, 0xb3, 0xe5, 0x8f, 0xb0, 0xe5, 0xa3, 0xb0, 0xe6, 0x98, 0x8e, 0xe6, 0xb8, 0x85, 0xe5, 0x8d,
+	0x95, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04,
+	0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x1a, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x13, 0x0a, 0x11, 0x50,
+	0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44,
+	0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12,
+	0x09, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x42, 0x51, 0x0a, 0x1f, 0x69, 0x6f,
+	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a,
+	0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65,
+	0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_manifest_v1_manifest_proto_rawDescOnce sync.Once
+	file_api_manifest_v1_manifest_proto_rawDescData = file_api_manifest_v1_manifest_proto_rawDesc
+)
+
+func file_api_manifest_v1_manifest_proto_rawDescGZIP() []byte {
+	file_api_manifest_v1_manifest_proto_rawDescOnce.Do(func() {
+		file_api_manifest_v1_manifest_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_manifest_v1_manifest_proto_rawDescData)
+	})
+	return file_api_manifest_v1_manifest_proto_rawDescData
+}
+
+var file_api_manifest_v1_manifest_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_manifest_v1_manifest_proto_goTypes = []interface{}{
+	(*ManifestChange)(nil),         // 0: io.tkeel.rudder.api.manifest.v1.ManifestChange
+	(*ApplyManifestRequest)(nil),   // 1: io.tkeel.rudder.api.manifest.v1.ApplyManifestRequest
+	(*ApplyManifestResponse)(nil),  // 2: io.tkeel.rudder.api.manifest.v1.ApplyManifestResponse
+	(*DiffManifestRequest)(nil),    // 3: io.tkeel.rudder.api.manifest.v1.DiffManifestRequest
+	(*DiffManifestResponse)(nil),   // 4: io.tkeel.rudder.api.manifest.v1.DiffManifestResponse
+	(*ExportManifestResponse)(nil), // 5: io.tkeel.rudder.api.manifest.v1.ExportManifestResponse
+	(*emptypb.Empty)(nil),          // 6: google.protobuf.Empty
+}
+var file_api_manifest_v1_manifest_proto_depIdxs = []int32{
+	0, // 0: io.tkeel.rudder.api.manifest.v1.ApplyManifestResponse.changes:type_name -> io.tkeel.rudder.api.manifest.v1.ManifestChange
+	0, // 1: io.tkeel.rudder.api.manifest.v1.DiffManifestResponse.changes:type_name -> io.tkeel.rudder.api.manifest.v1.ManifestChange
+	1, // 2: io.tkeel.rudder.api.manifest.v1.Manifest.ApplyManifest:input_type -> io.tkeel.rudder.api.manifest.v1.ApplyManifestRequest
+	3, // 3: io.tkeel.rudder.api.manifest.v1.Manifest.DiffManifest:input_type -> io.tkeel.rudder.api.manifest.v1.DiffManifestRequest
+	6, // 4: io.tkeel.rudder.api.manifest.v1.Manifest.ExportManifest:input_type -> google.protobuf.Empty
+	2, // 5: io.tkeel.rudder.api.manifest.v1.Manifest.ApplyManifest:output_type -> io.tkeel.rudder.api.manifest.v1.ApplyManifestResponse
+	4, // 6: io.tkeel.rudder.api.manifest.v1.Manifest.DiffManifest:output_type -> io.tkeel.rudder.api.manifest.v1.DiffManifestResponse
+	5, // 7: io.tkeel.rudder.api.manifest.v1.Manifest.ExportManifest:output_type -> io.tkeel.rudder.api.manifest.v1.ExportManifestResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_manifest_v1_manifest_proto_init() }
+func file_api_manifest_v1_manifest_proto_init() {
+	if File_api_manifest_v1_manifest_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_manifest_v1_manifest_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManifestChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_manifest_v1_manifest_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_manifest_v1_manifest_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyManifestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_manifest_v1_manifest_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_manifest_v1_manifest_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffManifestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_manifest_v1_manifest_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportManifestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_manifest_v1_manifest_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_manifest_v1_manifest_proto_goTypes,
+		DependencyIndexes: file_api_manifest_v1_manifest_proto_depIdxs,
+		MessageInfos:      file_api_manifest_v1_manifest_proto_msgTypes,
+	}.Build()
+	File_api_manifest_v1_manifest_proto = out.File
+	file_api_manifest_v1_manifest_proto_rawDesc = nil
+	file_api_manifest_v1_manifest_proto_goTypes = nil
+	file_api_manifest_v1_manifest_proto_depIdxs = nil
+}
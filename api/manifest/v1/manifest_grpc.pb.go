@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ManifestClient is the client API for Manifest service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ManifestClient interface {
+	ApplyManifest(ctx context.Context, in *ApplyManifestRequest, opts ...grpc.CallOption) (*ApplyManifestResponse, error)
+	DiffManifest(ctx context.Context, in *DiffManifestRequest, opts ...grpc.CallOption) (*DiffManifestResponse, error)
+	ExportManifest(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExportManifestResponse, error)
+}
+
+type manifestClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewManifestClient(cc grpc.ClientConnInterface) ManifestClient {
+	return &manifestClient{cc}
+}
+
+func (c *manifestClient) ApplyManifest(ctx context.Context, in *ApplyManifestRequest, opts ...grpc.CallOption) (*ApplyManifestResponse, error) {
+	out := new(ApplyManifestResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.manifest.v1.Manifest/ApplyManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestClient) DiffManifest(ctx context.Context, in *DiffManifestRequest, opts ...grpc.CallOption) (*DiffManifestResponse, error) {
+	out := new(DiffManifestResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.manifest.v1.Manifest/DiffManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestClient) ExportManifest(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExportManifestResponse, error) {
+	out := new(ExportManifestResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.manifest.v1.Manifest/ExportManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManifestServer is the server API for Manifest service.
+// All implementations must embed UnimplementedManifestServer
+// for forward compatibility
+type ManifestServer interface {
+	ApplyManifest(context.Context, *ApplyManifestRequest) (*ApplyManifestResponse, error)
+	DiffManifest(context.Context, *DiffManifestRequest) (*DiffManifestResponse, error)
+	ExportManifest(context.Context, *emptypb.Empty) (*ExportManifestResponse, error)
+	mustEmbedUnimplementedManifestServer()
+}
+
+// UnimplementedManifestServer must be embedded to have forward compatible implementations.
+type UnimplementedManifestServer struct {
+}
+
+func (UnimplementedManifestServer) ApplyManifest(context.Context, *ApplyManifestRequest) (*ApplyManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyManifest not implemented")
+}
+func (UnimplementedManifestServer) DiffManifest(context.Context, *DiffManifestRequest) (*DiffManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffManifest not implemented")
+}
+func (UnimplementedManifestServer) ExportManifest(context.Context, *emptypb.Empty) (*ExportManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportManifest not implemented")
+}
+func (UnimplementedManifestServer) mustEmbedUnimplementedManifestServer() {}
+
+// UnsafeManifestServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ManifestServer will
+// result in compilation errors.
+type UnsafeManifestServer interface {
+	mustEmbedUnimplementedManifestServer()
+}
+
+func RegisterManifestServer(s grpc.ServiceRegistrar, srv ManifestServer) {
+	s.RegisterService(&Manifest_ServiceDesc, srv)
+}
+
+func _Manifest_ApplyManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServer).ApplyManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.manifest.v1.Manifest/ApplyManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServer).ApplyManifest(ctx, req.(*ApplyManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manifest_DiffManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServer).DiffManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.manifest.v1.Manifest/DiffManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServer).DiffManifest(ctx, req.(*DiffManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manifest_ExportManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServer).ExportManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.manifest.v1.Manifest/ExportManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServer).ExportManifest(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Manifest_ServiceDesc is the grpc.ServiceDesc for Manifest service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Manifest_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "io.tkeel.rudder.api.manifest.v1.Manifest",
+	HandlerType: (*ManifestServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApplyManifest",
+			Handler:    _Manifest_ApplyManifest_Handler,
+		},
+		{
+			MethodName: "DiffManifest",
+			Handler:    _Manifest_DiffManifest_Handler,
+		},
+		{
+			MethodName: "ExportManifest",
+			Handler:    _Manifest_ExportManifest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/manifest/v1/manifest.proto",
+}
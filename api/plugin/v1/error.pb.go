@@ -87,6 +87,45 @@ const (
 	// @msg=租户停用访问OPENAPI错误
 	// @code=INVALID_ARGUMENT
 	Error_PLUGIN_ERR_OPENAPI_DISABLE_TENANT Error = 15
+	// @msg=回滚插件错误
+	// @code=INTERNAL
+	Error_PLUGIN_ERR_ROLLBACK_PLUGIN Error = 16
+	// @msg=找不到插件操作
+	// @code=INVALID_ARGUMENT
+	Error_PLUGIN_ERR_OPERATION_NOT_FOUND Error = 17
+	// @msg=解析插件依赖错误
+	// @code=INVALID_ARGUMENT
+	Error_PLUGIN_ERR_RESOLVE_DEPENDENCES Error = 18
+	// @msg=插件版本约束冲突
+	// @code=INVALID_ARGUMENT
+	Error_PLUGIN_ERR_VERSION_CONSTRAINT_CONFLICT Error = 19
+	// @msg=重新配置插件错误
+	// @code=INTERNAL
+	Error_PLUGIN_ERR_RECONFIGURE_PLUGIN Error = 20
+	// @msg=查询插件发布信息错误
+	// @code=INTERNAL
+	Error_PLUGIN_ERR_GET_RELEASE Error = 21
+	// @msg=轮换插件密钥错误
+	// @code=INTERNAL
+	Error_PLUGIN_ERR_ROTATE_SECRET Error = 22
+	// @msg=批量租户操作错误
+	// @code=INTERNAL
+	Error_PLUGIN_ERR_BATCH_TENANT Error = 23
+	// @msg=找不到插件候选版本
+	// @code=INVALID_ARGUMENT
+	Error_PLUGIN_ERR_CANDIDATE_NOT_FOUND Error = 24
+	// @msg=插件候选版本已存在
+	// @code=ALREADY_EXISTS
+	Error_PLUGIN_ERR_CANDIDATE_EXISTS Error = 25
+	// @msg=插件候选版本未就绪
+	// @code=INVALID_ARGUMENT
+	Error_PLUGIN_ERR_CANDIDATE_NOT_READY Error = 26
+	// @msg=切换插件候选版本错误
+	// @code=INTERNAL
+	Error_PLUGIN_ERR_PROMOTE_CANDIDATE Error = 27
+	// @msg=插件安装包来源校验失败
+	// @code=INVALID_ARGUMENT
+	Error_PLUGIN_ERR_PROVENANCE_VERIFICATION Error = 28
 )
 
 // Enum value maps for Error.
@@ -108,6 +147,19 @@ var (
 		13: "PLUGIN_ERR_OPENAPI_ENABLETENANT",
 		14: "PLUGIN_ERR_PLUGIN_HAS_TENANT_ENABLED",
 		15: "PLUGIN_ERR_OPENAPI_DISABLE_TENANT",
+		16: "PLUGIN_ERR_ROLLBACK_PLUGIN",
+		17: "PLUGIN_ERR_OPERATION_NOT_FOUND",
+		18: "PLUGIN_ERR_RESOLVE_DEPENDENCES",
+		19: "PLUGIN_ERR_VERSION_CONSTRAINT_CONFLICT",
+		20: "PLUGIN_ERR_RECONFIGURE_PLUGIN",
+		21: "PLUGIN_ERR_GET_RELEASE",
+		22: "PLUGIN_ERR_ROTATE_SECRET",
+		23: "PLUGIN_ERR_BATCH_TENANT",
+		24: "PLUGIN_ERR_CANDIDATE_NOT_FOUND",
+		25: "PLUGIN_ERR_CANDIDATE_EXISTS",
+		26: "PLUGIN_ERR_CANDIDATE_NOT_READY",
+		27: "PLUGIN_ERR_PROMOTE_CANDIDATE",
+		28: "PLUGIN_ERR_PROVENANCE_VERIFICATION",
 	}
 	Error_value = map[string]int32{
 		"PLUGIN_ERR_UNKNOWN":                            0,
@@ -126,6 +178,19 @@ var (
 		"PLUGIN_ERR_OPENAPI_ENABLETENANT":               13,
 		"PLUGIN_ERR_PLUGIN_HAS_TENANT_ENABLED":          14,
 		"PLUGIN_ERR_OPENAPI_DISABLE_TENANT":             15,
+		"PLUGIN_ERR_ROLLBACK_PLUGIN":                    16,
+		"PLUGIN_ERR_OPERATION_NOT_FOUND":                17,
+		"PLUGIN_ERR_RESOLVE_DEPENDENCES":                18,
+		"PLUGIN_ERR_VERSION_CONSTRAINT_CONFLICT":        19,
+		"PLUGIN_ERR_RECONFIGURE_PLUGIN":                 20,
+		"PLUGIN_ERR_GET_RELEASE":                        21,
+		"PLUGIN_ERR_ROTATE_SECRET":                      22,
+		"PLUGIN_ERR_BATCH_TENANT":                       23,
+		"PLUGIN_ERR_CANDIDATE_NOT_FOUND":                24,
+		"PLUGIN_ERR_CANDIDATE_EXISTS":                   25,
+		"PLUGIN_ERR_CANDIDATE_NOT_READY":                26,
+		"PLUGIN_ERR_PROMOTE_CANDIDATE":                  27,
+		"PLUGIN_ERR_PROVENANCE_VERIFICATION":            28,
 	}
 )
 
@@ -162,7 +227,7 @@ var file_api_plugin_v1_error_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x69, 0x6f, 0x2e,
 	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2a, 0xcf, 0x04, 0x0a, 0x05, 0x45,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2a, 0x90, 0x08, 0x0a, 0x05, 0x45,
 	0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45,
 	0x52, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b,
 	0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x50, 0x4c, 0x55, 0x47, 0x49,
@@ -199,14 +264,42 @@ var file_api_plugin_v1_error_proto_rawDesc = []byte{
 	0x5f, 0x48, 0x41, 0x53, 0x5f, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x54, 0x5f, 0x45, 0x4e, 0x41, 0x42,
 	0x4c, 0x45, 0x44, 0x10, 0x0e, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f,
 	0x45, 0x52, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x41, 0x50, 0x49, 0x5f, 0x44, 0x49, 0x53, 0x41,
-	0x42, 0x4c, 0x45, 0x5f, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x54, 0x10, 0x0f, 0x42, 0x5d, 0x0a, 0x1d,
-	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x4f,
-	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x31, 0x50, 0x01, 0x5a,
-	0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65,
-	0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x42, 0x4c, 0x45, 0x5f, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x54, 0x10, 0x0f, 0x12, 0x1e, 0x0a, 0x1a,
+	0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x42,
+	0x41, 0x43, 0x4b, 0x5f, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x10, 0x10, 0x12, 0x22, 0x0a, 0x1e,
+	0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x11,
+	0x12, 0x22, 0x0a, 0x1e, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x52,
+	0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43,
+	0x45, 0x53, 0x10, 0x12, 0x12, 0x2a, 0x0a, 0x26, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45,
+	0x52, 0x52, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x54,
+	0x52, 0x41, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x13,
+	0x12, 0x21, 0x0a, 0x1d, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x52,
+	0x45, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x5f, 0x50, 0x4c, 0x55, 0x47, 0x49,
+	0x4e, 0x10, 0x14, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52,
+	0x52, 0x5f, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x10, 0x15, 0x12,
+	0x1c, 0x0a, 0x18, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x52, 0x4f,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x16, 0x12, 0x1b, 0x0a,
+	0x17, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x54, 0x10, 0x17, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x4c,
+	0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x41,
+	0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x18, 0x12, 0x1f,
+	0x0a, 0x1b, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x43, 0x41, 0x4e,
+	0x44, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x19, 0x12,
+	0x22, 0x0a, 0x1e, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x43, 0x41,
+	0x4e, 0x44, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44,
+	0x59, 0x10, 0x1a, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x45, 0x52,
+	0x52, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x44, 0x49, 0x44,
+	0x41, 0x54, 0x45, 0x10, 0x1b, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f,
+	0x45, 0x52, 0x52, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x56,
+	0x45, 0x52, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x1c, 0x42, 0x5d, 0x0a,
+	0x1d, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0e,
+	0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x31, 0x50, 0x01,
+	0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65,
+	0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -27,6 +27,19 @@ var pluginErrUninstallPlugin *errors.TError
 var pluginErrOpenapiEnabletenant *errors.TError
 var pluginErrPluginHasTenantEnabled *errors.TError
 var pluginErrOpenapiDisableTenant *errors.TError
+var pluginErrRollbackPlugin *errors.TError
+var pluginErrOperationNotFound *errors.TError
+var pluginErrResolveDependences *errors.TError
+var pluginErrVersionConstraintConflict *errors.TError
+var pluginErrReconfigurePlugin *errors.TError
+var pluginErrGetRelease *errors.TError
+var pluginErrRotateSecret *errors.TError
+var pluginErrBatchTenant *errors.TError
+var pluginErrCandidateNotFound *errors.TError
+var pluginErrCandidateExists *errors.TError
+var pluginErrCandidateNotReady *errors.TError
+var pluginErrPromoteCandidate *errors.TError
+var pluginErrProvenanceVerification *errors.TError
 
 func init() {
 	pluginErrUnknown = errors.New(int(codes.Unknown), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_UNKNOWN", "未知类型")
@@ -61,6 +74,32 @@ func init() {
 	errors.Register(pluginErrPluginHasTenantEnabled)
 	pluginErrOpenapiDisableTenant = errors.New(int(codes.InvalidArgument), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_OPENAPI_DISABLE_TENANT", "租户停用访问OPENAPI错误")
 	errors.Register(pluginErrOpenapiDisableTenant)
+	pluginErrRollbackPlugin = errors.New(int(codes.Internal), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_ROLLBACK_PLUGIN", "回滚插件错误")
+	errors.Register(pluginErrRollbackPlugin)
+	pluginErrOperationNotFound = errors.New(int(codes.InvalidArgument), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_OPERATION_NOT_FOUND", "找不到插件操作")
+	errors.Register(pluginErrOperationNotFound)
+	pluginErrResolveDependences = errors.New(int(codes.InvalidArgument), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_RESOLVE_DEPENDENCES", "解析插件依赖错误")
+	errors.Register(pluginErrResolveDependences)
+	pluginErrVersionConstraintConflict = errors.New(int(codes.InvalidArgument), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_VERSION_CONSTRAINT_CONFLICT", "插件版本约束冲突")
+	errors.Register(pluginErrVersionConstraintConflict)
+	pluginErrReconfigurePlugin = errors.New(int(codes.Internal), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_RECONFIGURE_PLUGIN", "重新配置插件错误")
+	errors.Register(pluginErrReconfigurePlugin)
+	pluginErrGetRelease = errors.New(int(codes.Internal), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_GET_RELEASE", "查询插件发布信息错误")
+	errors.Register(pluginErrGetRelease)
+	pluginErrRotateSecret = errors.New(int(codes.Internal), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_ROTATE_SECRET", "轮换插件密钥错误")
+	errors.Register(pluginErrRotateSecret)
+	pluginErrBatchTenant = errors.New(int(codes.Internal), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_BATCH_TENANT", "批量租户操作错误")
+	errors.Register(pluginErrBatchTenant)
+	pluginErrCandidateNotFound = errors.New(int(codes.InvalidArgument), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_CANDIDATE_NOT_FOUND", "找不到插件候选版本")
+	errors.Register(pluginErrCandidateNotFound)
+	pluginErrCandidateExists = errors.New(int(codes.AlreadyExists), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_CANDIDATE_EXISTS", "插件候选版本已存在")
+	errors.Register(pluginErrCandidateExists)
+	pluginErrCandidateNotReady = errors.New(int(codes.InvalidArgument), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_CANDIDATE_NOT_READY", "插件候选版本未就绪")
+	errors.Register(pluginErrCandidateNotReady)
+	pluginErrPromoteCandidate = errors.New(int(codes.Internal), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_PROMOTE_CANDIDATE", "切换插件候选版本错误")
+	errors.Register(pluginErrPromoteCandidate)
+	pluginErrProvenanceVerification = errors.New(int(codes.InvalidArgument), "io.tkeel.rudder.api.plugin.v1.PLUGIN_ERR_PROVENANCE_VERIFICATION", "插件安装包来源校验失败")
+	errors.Register(pluginErrProvenanceVerification)
 }
 
 func PluginErrUnknown() errors.Error {
@@ -126,3 +165,55 @@ func PluginErrPluginHasTenantEnabled() errors.Error {
 func PluginErrOpenapiDisableTenant() errors.Error {
 	return pluginErrOpenapiDisableTenant
 }
+
+func PluginErrRollbackPlugin() errors.Error {
+	return pluginErrRollbackPlugin
+}
+
+func PluginErrOperationNotFound() errors.Error {
+	return pluginErrOperationNotFound
+}
+
+func PluginErrResolveDependences() errors.Error {
+	return pluginErrResolveDependences
+}
+
+func PluginErrVersionConstraintConflict() errors.Error {
+	return pluginErrVersionConstraintConflict
+}
+
+func PluginErrReconfigurePlugin() errors.Error {
+	return pluginErrReconfigurePlugin
+}
+
+func PluginErrGetRelease() errors.Error {
+	return pluginErrGetRelease
+}
+
+func PluginErrRotateSecret() errors.Error {
+	return pluginErrRotateSecret
+}
+
+func PluginErrBatchTenant() errors.Error {
+	return pluginErrBatchTenant
+}
+
+func PluginErrCandidateNotFound() errors.Error {
+	return pluginErrCandidateNotFound
+}
+
+func PluginErrCandidateExists() errors.Error {
+	return pluginErrCandidateExists
+}
+
+func PluginErrCandidateNotReady() errors.Error {
+	return pluginErrCandidateNotReady
+}
+
+func PluginErrPromoteCandidate() errors.Error {
+	return pluginErrPromoteCandidate
+}
+
+func PluginErrProvenanceVerification() errors.Error {
+	return pluginErrProvenanceVerification
+}
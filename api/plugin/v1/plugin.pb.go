@@ -344,6 +344,7 @@ type PluginBrief struct {
 	TenantEnable      bool            `protobuf:"varint,6,opt,name=tenant_enable,json=tenantEnable,proto3" json:"tenant_enable,omitempty"`                // tenant enable.
 	Status            v1.PluginStatus `protobuf:"varint,7,opt,name=status,proto3,enum=openapi.v1.PluginStatus" json:"status,omitempty"`                   // register plugin status.
 	Switchable        bool            `protobuf:"varint,8,opt,name=switchable,proto3" json:"switchable,omitempty"`                                        // plugin status switchable. false is not allow manual enable, true is allow manual enable.
+	Health            string          `protobuf:"bytes,9,opt,name=health,proto3" json:"health,omitempty"`                                                 // plugin health state.
 }
 
 func (x *PluginBrief) Reset() {
@@ -434,6 +435,155 @@ func (x *PluginBrief) GetSwitchable() bool {
 	return false
 }
 
+func (x *PluginBrief) GetHealth() string {
+	if x != nil {
+		return x.Health
+	}
+	return ""
+}
+
+type HealthTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From      string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Timestamp int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *HealthTransition) Reset() {
+	*x = HealthTransition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthTransition) ProtoMessage() {}
+
+func (x *HealthTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthTransition.ProtoReflect.Descriptor instead.
+func (*HealthTransition) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HealthTransition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *HealthTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *HealthTransition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HealthTransition) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type PluginHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State       string              `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Reason      string              `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Since       int64               `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	Transitions []*HealthTransition `protobuf:"bytes,4,rep,name=transitions,proto3" json:"transitions,omitempty"`
+}
+
+func (x *PluginHealth) Reset() {
+	*x = PluginHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginHealth) ProtoMessage() {}
+
+func (x *PluginHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginHealth.ProtoReflect.Descriptor instead.
+func (*PluginHealth) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PluginHealth) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PluginHealth) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PluginHealth) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *PluginHealth) GetTransitions() []*HealthTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
 //*
 // plugin object.
 type PluginObject struct {
@@ -448,12 +598,16 @@ type PluginObject struct {
 	EnableTenantes    []*EnabledTenant        `protobuf:"bytes,5,rep,name=enable_tenantes,json=enableTenantes,proto3" json:"enable_tenantes,omitempty"`          // enable tenant's id list.
 	RegisterAddons    []*RegisterAddons       `protobuf:"bytes,6,rep,name=register_addons,json=registerAddons,proto3" json:"register_addons,omitempty"`          // register addons router.
 	ConsoleEntries    []*v1.ConsoleEntry      `protobuf:"bytes,7,rep,name=console_entries,json=consoleEntries,proto3" json:"console_entries,omitempty"`
+	Health            *PluginHealth           `protobuf:"bytes,8,opt,name=health,proto3" json:"health,omitempty"`
+	UpgradePolicy     string                  `protobuf:"bytes,9,opt,name=upgrade_policy,json=upgradePolicy,proto3" json:"upgrade_policy,omitempty"`
+	Maintenance       *PluginMaintenance      `protobuf:"bytes,10,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
+	Candidate         *PluginCandidate        `protobuf:"bytes,11,opt,name=candidate,proto3" json:"candidate,omitempty"`
 }
 
 func (x *PluginObject) Reset() {
 	*x = PluginObject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_plugin_v1_plugin_proto_msgTypes[4]
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -466,7 +620,7 @@ func (x *PluginObject) String() string {
 func (*PluginObject) ProtoMessage() {}
 
 func (x *PluginObject) ProtoReflect() protoreflect.Message {
-	mi := &file_api_plugin_v1_plugin_proto_msgTypes[4]
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,7 +633,7 @@ func (x *PluginObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PluginObject.ProtoReflect.Descriptor instead.
 func (*PluginObject) Descriptor() ([]byte, []int) {
-	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{4}
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PluginObject) GetPlugin() *PluginBrief {
@@ -531,32 +685,68 @@ func (x *PluginObject) GetConsoleEntries() []*v1.ConsoleEntry {
 	return nil
 }
 
-type InstallPluginRequest struct {
+func (x *PluginObject) GetHealth() *PluginHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *PluginObject) GetUpgradePolicy() string {
+	if x != nil {
+		return x.UpgradePolicy
+	}
+	return ""
+}
+
+func (x *PluginObject) GetMaintenance() *PluginMaintenance {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
+func (x *PluginObject) GetCandidate() *PluginCandidate {
+	if x != nil {
+		return x.Candidate
+	}
+	return nil
+}
+
+type PluginCandidate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Installer *Installer `protobuf:"bytes,2,opt,name=installer,proto3" json:"installer,omitempty"`
+	AppId          string   `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Repo           string   `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Installer      string   `protobuf:"bytes,3,opt,name=installer,proto3" json:"installer,omitempty"`
+	Version        string   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Tenants        []string `protobuf:"bytes,5,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	Percent        int32    `protobuf:"varint,6,opt,name=percent,proto3" json:"percent,omitempty"`
+	Status         string   `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Message        string   `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	OperatorId     string   `protobuf:"bytes,9,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	StartTimestamp int64    `protobuf:"varint,10,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
 }
 
-func (x *InstallPluginRequest) Reset() {
-	*x = InstallPluginRequest{}
+func (x *PluginCandidate) Reset() {
+	*x = PluginCandidate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_plugin_v1_plugin_proto_msgTypes[5]
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstallPluginRequest) String() string {
+func (x *PluginCandidate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallPluginRequest) ProtoMessage() {}
+func (*PluginCandidate) ProtoMessage() {}
 
-func (x *InstallPluginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_plugin_v1_plugin_proto_msgTypes[5]
+func (x *PluginCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,98 +757,109 @@ func (x *InstallPluginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallPluginRequest.ProtoReflect.Descriptor instead.
-func (*InstallPluginRequest) Descriptor() ([]byte, []int) {
-	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PluginCandidate.ProtoReflect.Descriptor instead.
+func (*PluginCandidate) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *InstallPluginRequest) GetId() string {
+func (x *PluginCandidate) GetAppId() string {
 	if x != nil {
-		return x.Id
+		return x.AppId
 	}
 	return ""
 }
 
-func (x *InstallPluginRequest) GetInstaller() *Installer {
+func (x *PluginCandidate) GetRepo() string {
 	if x != nil {
-		return x.Installer
+		return x.Repo
 	}
-	return nil
+	return ""
 }
 
-type InstallPluginResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *PluginCandidate) GetInstaller() string {
+	if x != nil {
+		return x.Installer
+	}
+	return ""
+}
 
-	Plugin *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+func (x *PluginCandidate) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
 }
 
-func (x *InstallPluginResponse) Reset() {
-	*x = InstallPluginResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_plugin_v1_plugin_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PluginCandidate) GetTenants() []string {
+	if x != nil {
+		return x.Tenants
 	}
+	return nil
 }
 
-func (x *InstallPluginResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PluginCandidate) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
 }
 
-func (*InstallPluginResponse) ProtoMessage() {}
+func (x *PluginCandidate) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
 
-func (x *InstallPluginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_plugin_v1_plugin_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PluginCandidate) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use InstallPluginResponse.ProtoReflect.Descriptor instead.
-func (*InstallPluginResponse) Descriptor() ([]byte, []int) {
-	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{6}
+func (x *PluginCandidate) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
 }
 
-func (x *InstallPluginResponse) GetPlugin() *PluginObject {
+func (x *PluginCandidate) GetStartTimestamp() int64 {
 	if x != nil {
-		return x.Plugin
+		return x.StartTimestamp
 	}
-	return nil
+	return 0
 }
 
-type UpgradePluginRequest struct {
+type PluginMaintenance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Installer *Installer `protobuf:"bytes,2,opt,name=installer,proto3" json:"installer,omitempty"`
+	Message        string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	StartTimestamp int64  `protobuf:"varint,2,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp   int64  `protobuf:"varint,3,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	OperatorId     string `protobuf:"bytes,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
 }
 
-func (x *UpgradePluginRequest) Reset() {
-	*x = UpgradePluginRequest{}
+func (x *PluginMaintenance) Reset() {
+	*x = PluginMaintenance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_plugin_v1_plugin_proto_msgTypes[7]
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpgradePluginRequest) String() string {
+func (x *PluginMaintenance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradePluginRequest) ProtoMessage() {}
+func (*PluginMaintenance) ProtoMessage() {}
 
-func (x *UpgradePluginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_plugin_v1_plugin_proto_msgTypes[7]
+func (x *PluginMaintenance) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,50 +870,67 @@ func (x *UpgradePluginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradePluginRequest.ProtoReflect.Descriptor instead.
-func (*UpgradePluginRequest) Descriptor() ([]byte, []int) {
-	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PluginMaintenance.ProtoReflect.Descriptor instead.
+func (*PluginMaintenance) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpgradePluginRequest) GetId() string {
+func (x *PluginMaintenance) GetMessage() string {
 	if x != nil {
-		return x.Id
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UpgradePluginRequest) GetInstaller() *Installer {
+func (x *PluginMaintenance) GetStartTimestamp() int64 {
 	if x != nil {
-		return x.Installer
+		return x.StartTimestamp
 	}
-	return nil
+	return 0
 }
 
-type UpgradePluginResponse struct {
+func (x *PluginMaintenance) GetEndTimestamp() int64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+func (x *PluginMaintenance) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+type DryRunResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Plugin *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Manifest string   `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Values   []byte   `protobuf:"bytes,2,opt,name=values,proto3" json:"values,omitempty"`
+	Notes    string   `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Problems []string `protobuf:"bytes,4,rep,name=problems,proto3" json:"problems,omitempty"`
 }
 
-func (x *UpgradePluginResponse) Reset() {
-	*x = UpgradePluginResponse{}
+func (x *DryRunResult) Reset() {
+	*x = DryRunResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_plugin_v1_plugin_proto_msgTypes[8]
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpgradePluginResponse) String() string {
+func (x *DryRunResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradePluginResponse) ProtoMessage() {}
+func (*DryRunResult) ProtoMessage() {}
 
-func (x *UpgradePluginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_plugin_v1_plugin_proto_msgTypes[8]
+func (x *DryRunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,43 +941,68 @@ func (x *UpgradePluginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpgradePluginResponse.ProtoReflect.Descriptor instead.
-func (*UpgradePluginResponse) Descriptor() ([]byte, []int) {
-	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DryRunResult.ProtoReflect.Descriptor instead.
+func (*DryRunResult) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpgradePluginResponse) GetPlugin() *PluginObject {
+func (x *DryRunResult) GetManifest() string {
 	if x != nil {
-		return x.Plugin
+		return x.Manifest
+	}
+	return ""
+}
+
+func (x *DryRunResult) GetValues() []byte {
+	if x != nil {
+		return x.Values
 	}
 	return nil
 }
 
-type UninstallPluginRequest struct {
+func (x *DryRunResult) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *DryRunResult) GetProblems() []string {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+type InstallPluginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id                 string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Installer          *Installer `protobuf:"bytes,2,opt,name=installer,proto3" json:"installer,omitempty"`
+	DryRun             bool       `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Async              bool       `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`
+	InstallDependences bool       `protobuf:"varint,5,opt,name=install_dependences,json=installDependences,proto3" json:"install_dependences,omitempty"`
 }
 
-func (x *UninstallPluginRequest) Reset() {
-	*x = UninstallPluginRequest{}
+func (x *InstallPluginRequest) Reset() {
+	*x = InstallPluginRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_plugin_v1_plugin_proto_msgTypes[9]
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UninstallPluginRequest) String() string {
+func (x *InstallPluginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UninstallPluginRequest) ProtoMessage() {}
+func (*InstallPluginRequest) ProtoMessage() {}
 
-func (x *UninstallPluginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_plugin_v1_plugin_proto_msgTypes[9]
+func (x *InstallPluginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,75 +1013,61 @@ func (x *UninstallPluginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UninstallPluginRequest.ProtoReflect.Descriptor instead.
-func (*UninstallPluginRequest) Descriptor() ([]byte, []int) {
-	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use InstallPluginRequest.ProtoReflect.Descriptor instead.
+func (*InstallPluginRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UninstallPluginRequest) GetId() string {
+func (x *InstallPluginRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UninstallPluginResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Plugin *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
-}
-
-func (x *UninstallPluginResponse) Reset() {
-	*x = UninstallPluginResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_plugin_v1_plugin_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *InstallPluginRequest) GetInstaller() *Installer {
+	if x != nil {
+		return x.Installer
 	}
+	return nil
 }
 
-func (x *UninstallPluginResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UninstallPluginResponse) ProtoMessage() {}
-
-func (x *UninstallPluginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_plugin_v1_plugin_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *InstallPluginRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use UninstallPluginResponse.ProtoReflect.Descriptor instead.
-func (*UninstallPluginResponse) Descriptor() ([]byte, []int) {
-	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{10}
+func (x *InstallPluginRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
 }
 
-func (x *UninstallPluginResponse) GetPlugin() *PluginObject {
+func (x *InstallPluginRequest) GetInstallDependences() bool {
 	if x != nil {
-		return x.Plugin
+		return x.InstallDependences
 	}
-	return nil
+	return false
 }
 
-type GetPluginRequest struct {
+type InstallPlanItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Repo        string   `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Name        string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Version     string   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Installed   bool     `protobuf:"varint,5,opt,name=installed,proto3" json:"installed,omitempty"`
+	Dependences []string `protobuf:"bytes,6,rep,name=dependences,proto3" json:"dependences,omitempty"`
 }
 
-func (x *GetPluginRequest) Reset() {
-	*x = GetPluginRequest{}
+func (x *InstallPlanItem) Reset() {
+	*x = InstallPlanItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -846,13 +1075,13 @@ func (x *GetPluginRequest) Reset() {
 	}
 }
 
-func (x *GetPluginRequest) String() string {
+func (x *InstallPlanItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPluginRequest) ProtoMessage() {}
+func (*InstallPlanItem) ProtoMessage() {}
 
-func (x *GetPluginRequest) ProtoReflect() protoreflect.Message {
+func (x *InstallPlanItem) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -864,28 +1093,66 @@ func (x *GetPluginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPluginRequest.ProtoReflect.Descriptor instead.
-func (*GetPluginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InstallPlanItem.ProtoReflect.Descriptor instead.
+func (*InstallPlanItem) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetPluginRequest) GetId() string {
+func (x *InstallPlanItem) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetPluginResponse struct {
+func (x *InstallPlanItem) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *InstallPlanItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstallPlanItem) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *InstallPlanItem) GetInstalled() bool {
+	if x != nil {
+		return x.Installed
+	}
+	return false
+}
+
+func (x *InstallPlanItem) GetDependences() []string {
+	if x != nil {
+		return x.Dependences
+	}
+	return nil
+}
+
+type InstallPluginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Plugin *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Plugin      *PluginObject      `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	DryRun      *DryRunResult      `protobuf:"bytes,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	OperationId string             `protobuf:"bytes,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	InstallPlan []*InstallPlanItem `protobuf:"bytes,4,rep,name=install_plan,json=installPlan,proto3" json:"install_plan,omitempty"`
 }
 
-func (x *GetPluginResponse) Reset() {
-	*x = GetPluginResponse{}
+func (x *InstallPluginResponse) Reset() {
+	*x = InstallPluginResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -893,13 +1160,13 @@ func (x *GetPluginResponse) Reset() {
 	}
 }
 
-func (x *GetPluginResponse) String() string {
+func (x *InstallPluginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPluginResponse) ProtoMessage() {}
+func (*InstallPluginResponse) ProtoMessage() {}
 
-func (x *GetPluginResponse) ProtoReflect() protoreflect.Message {
+func (x *InstallPluginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -911,33 +1178,55 @@ func (x *GetPluginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPluginResponse.ProtoReflect.Descriptor instead.
-func (*GetPluginResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InstallPluginResponse.ProtoReflect.Descriptor instead.
+func (*InstallPluginResponse) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetPluginResponse) GetPlugin() *PluginObject {
+func (x *InstallPluginResponse) GetPlugin() *PluginObject {
 	if x != nil {
 		return x.Plugin
 	}
 	return nil
 }
 
-type ListPluginRequest struct {
+func (x *InstallPluginResponse) GetDryRun() *DryRunResult {
+	if x != nil {
+		return x.DryRun
+	}
+	return nil
+}
+
+func (x *InstallPluginResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *InstallPluginResponse) GetInstallPlan() []*InstallPlanItem {
+	if x != nil {
+		return x.InstallPlan
+	}
+	return nil
+}
+
+type UpgradePluginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageNum          int32  `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize         int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	OrderBy          string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	IsDescending     bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
-	KeyWords         string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
-	DisplayAllPlugin bool   `protobuf:"varint,10,opt,name=display_all_plugin,json=displayAllPlugin,proto3" json:"display_all_plugin,omitempty"`
+	Id               string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Installer        *Installer `protobuf:"bytes,2,opt,name=installer,proto3" json:"installer,omitempty"`
+	DryRun           bool       `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Async            bool       `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`
+	BlueGreen        bool       `protobuf:"varint,5,opt,name=blue_green,json=blueGreen,proto3" json:"blue_green,omitempty"`
+	CandidateTenants []string   `protobuf:"bytes,6,rep,name=candidate_tenants,json=candidateTenants,proto3" json:"candidate_tenants,omitempty"`
+	CandidatePercent int32      `protobuf:"varint,7,opt,name=candidate_percent,json=candidatePercent,proto3" json:"candidate_percent,omitempty"`
 }
 
-func (x *ListPluginRequest) Reset() {
-	*x = ListPluginRequest{}
+func (x *UpgradePluginRequest) Reset() {
+	*x = UpgradePluginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -945,13 +1234,13 @@ func (x *ListPluginRequest) Reset() {
 	}
 }
 
-func (x *ListPluginRequest) String() string {
+func (x *UpgradePluginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPluginRequest) ProtoMessage() {}
+func (*UpgradePluginRequest) ProtoMessage() {}
 
-func (x *ListPluginRequest) ProtoReflect() protoreflect.Message {
+func (x *UpgradePluginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -963,67 +1252,72 @@ func (x *ListPluginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPluginRequest.ProtoReflect.Descriptor instead.
-func (*ListPluginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpgradePluginRequest.ProtoReflect.Descriptor instead.
+func (*UpgradePluginRequest) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListPluginRequest) GetPageNum() int32 {
+func (x *UpgradePluginRequest) GetId() string {
 	if x != nil {
-		return x.PageNum
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListPluginRequest) GetPageSize() int32 {
+func (x *UpgradePluginRequest) GetInstaller() *Installer {
 	if x != nil {
-		return x.PageSize
+		return x.Installer
 	}
-	return 0
+	return nil
 }
 
-func (x *ListPluginRequest) GetOrderBy() string {
+func (x *UpgradePluginRequest) GetDryRun() bool {
 	if x != nil {
-		return x.OrderBy
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *ListPluginRequest) GetIsDescending() bool {
+func (x *UpgradePluginRequest) GetAsync() bool {
 	if x != nil {
-		return x.IsDescending
+		return x.Async
 	}
 	return false
 }
 
-func (x *ListPluginRequest) GetKeyWords() string {
+func (x *UpgradePluginRequest) GetBlueGreen() bool {
 	if x != nil {
-		return x.KeyWords
+		return x.BlueGreen
 	}
-	return ""
+	return false
 }
 
-func (x *ListPluginRequest) GetDisplayAllPlugin() bool {
+func (x *UpgradePluginRequest) GetCandidateTenants() []string {
 	if x != nil {
-		return x.DisplayAllPlugin
+		return x.CandidateTenants
 	}
-	return false
+	return nil
 }
 
-type ListPluginResponse struct {
+func (x *UpgradePluginRequest) GetCandidatePercent() int32 {
+	if x != nil {
+		return x.CandidatePercent
+	}
+	return 0
+}
+
+type UpgradePluginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total      int32          `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	PageNum    int32          `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize   int32          `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PluginList []*PluginBrief `protobuf:"bytes,10,rep,name=plugin_list,json=pluginList,proto3" json:"plugin_list,omitempty"`
-	EnableNum  int32          `protobuf:"varint,11,opt,name=enable_num,json=enableNum,proto3" json:"enable_num,omitempty"`
+	Plugin      *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	DryRun      *DryRunResult `protobuf:"bytes,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	OperationId string        `protobuf:"bytes,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 }
 
-func (x *ListPluginResponse) Reset() {
-	*x = ListPluginResponse{}
+func (x *UpgradePluginResponse) Reset() {
+	*x = UpgradePluginResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1031,13 +1325,13 @@ func (x *ListPluginResponse) Reset() {
 	}
 }
 
-func (x *ListPluginResponse) String() string {
+func (x *UpgradePluginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPluginResponse) ProtoMessage() {}
+func (*UpgradePluginResponse) ProtoMessage() {}
 
-func (x *ListPluginResponse) ProtoReflect() protoreflect.Message {
+func (x *UpgradePluginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1049,57 +1343,43 @@ func (x *ListPluginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPluginResponse.ProtoReflect.Descriptor instead.
-func (*ListPluginResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpgradePluginResponse.ProtoReflect.Descriptor instead.
+func (*UpgradePluginResponse) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListPluginResponse) GetTotal() int32 {
+func (x *UpgradePluginResponse) GetPlugin() *PluginObject {
 	if x != nil {
-		return x.Total
+		return x.Plugin
 	}
-	return 0
+	return nil
 }
 
-func (x *ListPluginResponse) GetPageNum() int32 {
+func (x *UpgradePluginResponse) GetDryRun() *DryRunResult {
 	if x != nil {
-		return x.PageNum
+		return x.DryRun
 	}
-	return 0
+	return nil
 }
 
-func (x *ListPluginResponse) GetPageSize() int32 {
+func (x *UpgradePluginResponse) GetOperationId() string {
 	if x != nil {
-		return x.PageSize
+		return x.OperationId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListPluginResponse) GetPluginList() []*PluginBrief {
-	if x != nil {
-		return x.PluginList
-	}
-	return nil
+type RollbackPluginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision int32  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (x *ListPluginResponse) GetEnableNum() int32 {
-	if x != nil {
-		return x.EnableNum
-	}
-	return 0
-}
-
-type TenantEnableRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id    string                               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Extra *TenantEnableRequest_EnableExtraData `protobuf:"bytes,2,opt,name=extra,proto3" json:"extra,omitempty"`
-}
-
-func (x *TenantEnableRequest) Reset() {
-	*x = TenantEnableRequest{}
+func (x *RollbackPluginRequest) Reset() {
+	*x = RollbackPluginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1107,13 +1387,13 @@ func (x *TenantEnableRequest) Reset() {
 	}
 }
 
-func (x *TenantEnableRequest) String() string {
+func (x *RollbackPluginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantEnableRequest) ProtoMessage() {}
+func (*RollbackPluginRequest) ProtoMessage() {}
 
-func (x *TenantEnableRequest) ProtoReflect() protoreflect.Message {
+func (x *RollbackPluginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1125,37 +1405,36 @@ func (x *TenantEnableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantEnableRequest.ProtoReflect.Descriptor instead.
-func (*TenantEnableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackPluginRequest.ProtoReflect.Descriptor instead.
+func (*RollbackPluginRequest) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *TenantEnableRequest) GetId() string {
+func (x *RollbackPluginRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *TenantEnableRequest) GetExtra() *TenantEnableRequest_EnableExtraData {
+func (x *RollbackPluginRequest) GetRevision() int32 {
 	if x != nil {
-		return x.Extra
+		return x.Revision
 	}
-	return nil
+	return 0
 }
 
-type TMTenantEnableRequest struct {
+type RollbackPluginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PluginId string `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
-	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Extra    []byte `protobuf:"bytes,3,opt,name=extra,proto3" json:"extra,omitempty"`
+	Plugin      *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	OperationId string        `protobuf:"bytes,2,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 }
 
-func (x *TMTenantEnableRequest) Reset() {
-	*x = TMTenantEnableRequest{}
+func (x *RollbackPluginResponse) Reset() {
+	*x = RollbackPluginResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1163,13 +1442,13 @@ func (x *TMTenantEnableRequest) Reset() {
 	}
 }
 
-func (x *TMTenantEnableRequest) String() string {
+func (x *RollbackPluginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TMTenantEnableRequest) ProtoMessage() {}
+func (*RollbackPluginResponse) ProtoMessage() {}
 
-func (x *TMTenantEnableRequest) ProtoReflect() protoreflect.Message {
+func (x *RollbackPluginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1181,43 +1460,38 @@ func (x *TMTenantEnableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TMTenantEnableRequest.ProtoReflect.Descriptor instead.
-func (*TMTenantEnableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RollbackPluginResponse.ProtoReflect.Descriptor instead.
+func (*RollbackPluginResponse) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *TMTenantEnableRequest) GetPluginId() string {
+func (x *RollbackPluginResponse) GetPlugin() *PluginObject {
 	if x != nil {
-		return x.PluginId
+		return x.Plugin
 	}
-	return ""
+	return nil
 }
 
-func (x *TMTenantEnableRequest) GetTenantId() string {
+func (x *RollbackPluginResponse) GetOperationId() string {
 	if x != nil {
-		return x.TenantId
+		return x.OperationId
 	}
 	return ""
 }
 
-func (x *TMTenantEnableRequest) GetExtra() []byte {
-	if x != nil {
-		return x.Extra
-	}
-	return nil
-}
-
-type TenantDisableRequest struct {
+type ReconfigurePluginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Extra []byte `protobuf:"bytes,2,opt,name=extra,proto3" json:"extra,omitempty"`
+	Id            string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Configuration []byte            `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	Type          ConfigurationType `protobuf:"varint,3,opt,name=type,proto3,enum=io.tkeel.rudder.api.plugin.v1.ConfigurationType" json:"type,omitempty"`
+	Async         bool              `protobuf:"varint,4,opt,name=async,proto3" json:"async,omitempty"`
 }
 
-func (x *TenantDisableRequest) Reset() {
-	*x = TenantDisableRequest{}
+func (x *ReconfigurePluginRequest) Reset() {
+	*x = ReconfigurePluginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1225,13 +1499,13 @@ func (x *TenantDisableRequest) Reset() {
 	}
 }
 
-func (x *TenantDisableRequest) String() string {
+func (x *ReconfigurePluginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantDisableRequest) ProtoMessage() {}
+func (*ReconfigurePluginRequest) ProtoMessage() {}
 
-func (x *TenantDisableRequest) ProtoReflect() protoreflect.Message {
+func (x *ReconfigurePluginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1243,37 +1517,50 @@ func (x *TenantDisableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantDisableRequest.ProtoReflect.Descriptor instead.
-func (*TenantDisableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReconfigurePluginRequest.ProtoReflect.Descriptor instead.
+func (*ReconfigurePluginRequest) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TenantDisableRequest) GetId() string {
+func (x *ReconfigurePluginRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *TenantDisableRequest) GetExtra() []byte {
+func (x *ReconfigurePluginRequest) GetConfiguration() []byte {
 	if x != nil {
-		return x.Extra
+		return x.Configuration
 	}
 	return nil
 }
 
-type TMTenantDisableRequest struct {
+func (x *ReconfigurePluginRequest) GetType() ConfigurationType {
+	if x != nil {
+		return x.Type
+	}
+	return ConfigurationType_JSON
+}
+
+func (x *ReconfigurePluginRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type ReconfigurePluginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PluginId string `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
-	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Extra    []byte `protobuf:"bytes,3,opt,name=extra,proto3" json:"extra,omitempty"`
+	Plugin      *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	OperationId string        `protobuf:"bytes,2,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
 }
 
-func (x *TMTenantDisableRequest) Reset() {
-	*x = TMTenantDisableRequest{}
+func (x *ReconfigurePluginResponse) Reset() {
+	*x = ReconfigurePluginResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1281,13 +1568,13 @@ func (x *TMTenantDisableRequest) Reset() {
 	}
 }
 
-func (x *TMTenantDisableRequest) String() string {
+func (x *ReconfigurePluginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TMTenantDisableRequest) ProtoMessage() {}
+func (*ReconfigurePluginResponse) ProtoMessage() {}
 
-func (x *TMTenantDisableRequest) ProtoReflect() protoreflect.Message {
+func (x *ReconfigurePluginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1299,47 +1586,36 @@ func (x *TMTenantDisableRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TMTenantDisableRequest.ProtoReflect.Descriptor instead.
-func (*TMTenantDisableRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReconfigurePluginResponse.ProtoReflect.Descriptor instead.
+func (*ReconfigurePluginResponse) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *TMTenantDisableRequest) GetPluginId() string {
+func (x *ReconfigurePluginResponse) GetPlugin() *PluginObject {
 	if x != nil {
-		return x.PluginId
+		return x.Plugin
 	}
-	return ""
+	return nil
 }
 
-func (x *TMTenantDisableRequest) GetTenantId() string {
+func (x *ReconfigurePluginResponse) GetOperationId() string {
 	if x != nil {
-		return x.TenantId
+		return x.OperationId
 	}
 	return ""
 }
 
-func (x *TMTenantDisableRequest) GetExtra() []byte {
-	if x != nil {
-		return x.Extra
-	}
-	return nil
-}
-
-type ListEnabledTenantsRequest struct {
+type RotatePluginSecretRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageNum      int32  `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize     int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
-	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
-	Id           string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GracePeriod int64  `protobuf:"varint,2,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
 }
 
-func (x *ListEnabledTenantsRequest) Reset() {
-	*x = ListEnabledTenantsRequest{}
+func (x *RotatePluginSecretRequest) Reset() {
+	*x = RotatePluginSecretRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1347,13 +1623,13 @@ func (x *ListEnabledTenantsRequest) Reset() {
 	}
 }
 
-func (x *ListEnabledTenantsRequest) String() string {
+func (x *RotatePluginSecretRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEnabledTenantsRequest) ProtoMessage() {}
+func (*RotatePluginSecretRequest) ProtoMessage() {}
 
-func (x *ListEnabledTenantsRequest) ProtoReflect() protoreflect.Message {
+func (x *RotatePluginSecretRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1365,66 +1641,36 @@ func (x *ListEnabledTenantsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEnabledTenantsRequest.ProtoReflect.Descriptor instead.
-func (*ListEnabledTenantsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RotatePluginSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotatePluginSecretRequest) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListEnabledTenantsRequest) GetPageNum() int32 {
-	if x != nil {
-		return x.PageNum
-	}
-	return 0
-}
-
-func (x *ListEnabledTenantsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListEnabledTenantsRequest) GetOrderBy() string {
-	if x != nil {
-		return x.OrderBy
-	}
-	return ""
-}
-
-func (x *ListEnabledTenantsRequest) GetIsDescending() bool {
-	if x != nil {
-		return x.IsDescending
-	}
-	return false
-}
-
-func (x *ListEnabledTenantsRequest) GetKeyWords() string {
+func (x *RotatePluginSecretRequest) GetId() string {
 	if x != nil {
-		return x.KeyWords
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListEnabledTenantsRequest) GetId() string {
+func (x *RotatePluginSecretRequest) GetGracePeriod() int64 {
 	if x != nil {
-		return x.Id
+		return x.GracePeriod
 	}
-	return ""
+	return 0
 }
 
-type ListEnabledTenantsResponse struct {
+type RotatePluginSecretResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total    int32            `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	PageNum  int32            `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize int32            `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Tenants  []*EnabledTenant `protobuf:"bytes,10,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	Plugin               *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	PreviousSecretExpire int64         `protobuf:"varint,2,opt,name=previous_secret_expire,json=previousSecretExpire,proto3" json:"previous_secret_expire,omitempty"`
 }
 
-func (x *ListEnabledTenantsResponse) Reset() {
-	*x = ListEnabledTenantsResponse{}
+func (x *RotatePluginSecretResponse) Reset() {
+	*x = RotatePluginSecretResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1432,13 +1678,13 @@ func (x *ListEnabledTenantsResponse) Reset() {
 	}
 }
 
-func (x *ListEnabledTenantsResponse) String() string {
+func (x *RotatePluginSecretResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEnabledTenantsResponse) ProtoMessage() {}
+func (*RotatePluginSecretResponse) ProtoMessage() {}
 
-func (x *ListEnabledTenantsResponse) ProtoReflect() protoreflect.Message {
+func (x *RotatePluginSecretResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1450,49 +1696,38 @@ func (x *ListEnabledTenantsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEnabledTenantsResponse.ProtoReflect.Descriptor instead.
-func (*ListEnabledTenantsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RotatePluginSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotatePluginSecretResponse) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListEnabledTenantsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *ListEnabledTenantsResponse) GetPageNum() int32 {
+func (x *RotatePluginSecretResponse) GetPlugin() *PluginObject {
 	if x != nil {
-		return x.PageNum
+		return x.Plugin
 	}
-	return 0
+	return nil
 }
 
-func (x *ListEnabledTenantsResponse) GetPageSize() int32 {
+func (x *RotatePluginSecretResponse) GetPreviousSecretExpire() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.PreviousSecretExpire
 	}
 	return 0
 }
 
-func (x *ListEnabledTenantsResponse) GetTenants() []*EnabledTenant {
-	if x != nil {
-		return x.Tenants
-	}
-	return nil
-}
-
-type TMUpdatePluginIdentifyRequest struct {
+type UninstallPluginRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Async   bool   `protobuf:"varint,2,opt,name=async,proto3" json:"async,omitempty"`
+	Cascade bool   `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	Preview bool   `protobuf:"varint,4,opt,name=preview,proto3" json:"preview,omitempty"`
 }
 
-func (x *TMUpdatePluginIdentifyRequest) Reset() {
-	*x = TMUpdatePluginIdentifyRequest{}
+func (x *UninstallPluginRequest) Reset() {
+	*x = UninstallPluginRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1500,13 +1735,13 @@ func (x *TMUpdatePluginIdentifyRequest) Reset() {
 	}
 }
 
-func (x *TMUpdatePluginIdentifyRequest) String() string {
+func (x *UninstallPluginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TMUpdatePluginIdentifyRequest) ProtoMessage() {}
+func (*UninstallPluginRequest) ProtoMessage() {}
 
-func (x *TMUpdatePluginIdentifyRequest) ProtoReflect() protoreflect.Message {
+func (x *UninstallPluginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1518,28 +1753,52 @@ func (x *TMUpdatePluginIdentifyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TMUpdatePluginIdentifyRequest.ProtoReflect.Descriptor instead.
-func (*TMUpdatePluginIdentifyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UninstallPluginRequest.ProtoReflect.Descriptor instead.
+func (*UninstallPluginRequest) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TMUpdatePluginIdentifyRequest) GetId() string {
+func (x *UninstallPluginRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type TMRegisterPluginRequest struct {
+func (x *UninstallPluginRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *UninstallPluginRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+func (x *UninstallPluginRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+type UninstallPluginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Plugin      *PluginObject          `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	OperationId string                 `protobuf:"bytes,2,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Preview     *UninstallPreview      `protobuf:"bytes,3,opt,name=preview,proto3" json:"preview,omitempty"`
+	Report      []*OperationReportItem `protobuf:"bytes,4,rep,name=report,proto3" json:"report,omitempty"`
 }
 
-func (x *TMRegisterPluginRequest) Reset() {
-	*x = TMRegisterPluginRequest{}
+func (x *UninstallPluginResponse) Reset() {
+	*x = UninstallPluginResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1547,13 +1806,13 @@ func (x *TMRegisterPluginRequest) Reset() {
 	}
 }
 
-func (x *TMRegisterPluginRequest) String() string {
+func (x *UninstallPluginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TMRegisterPluginRequest) ProtoMessage() {}
+func (*UninstallPluginResponse) ProtoMessage() {}
 
-func (x *TMRegisterPluginRequest) ProtoReflect() protoreflect.Message {
+func (x *UninstallPluginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1565,30 +1824,50 @@ func (x *TMRegisterPluginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TMRegisterPluginRequest.ProtoReflect.Descriptor instead.
-func (*TMRegisterPluginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UninstallPluginResponse.ProtoReflect.Descriptor instead.
+func (*UninstallPluginResponse) Descriptor() ([]byte, []int) {
 	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *TMRegisterPluginRequest) GetId() string {
+func (x *UninstallPluginResponse) GetPlugin() *PluginObject {
 	if x != nil {
-		return x.Id
+		return x.Plugin
+	}
+	return nil
+}
+
+func (x *UninstallPluginResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
 	}
 	return ""
 }
 
-type InstallerMaintainer struct {
+func (x *UninstallPluginResponse) GetPreview() *UninstallPreview {
+	if x != nil {
+		return x.Preview
+	}
+	return nil
+}
+
+func (x *UninstallPluginResponse) GetReport() []*OperationReportItem {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type UninstallAffectedPlugin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Url   string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Id           string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AddonsPoints []string `protobuf:"bytes,2,rep,name=addons_points,json=addonsPoints,proto3" json:"addons_points,omitempty"`
 }
 
-func (x *InstallerMaintainer) Reset() {
-	*x = InstallerMaintainer{}
+func (x *UninstallAffectedPlugin) Reset() {
+	*x = UninstallAffectedPlugin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_plugin_v1_plugin_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1596,13 +1875,13 @@ func (x *InstallerMaintainer) Reset() {
 	}
 }
 
-func (x *InstallerMaintainer) String() string {
+func (x *UninstallAffectedPlugin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallerMaintainer) ProtoMessage() {}
+func (*UninstallAffectedPlugin) ProtoMessage() {}
 
-func (x *InstallerMaintainer) ProtoReflect() protoreflect.Message {
+func (x *UninstallAffectedPlugin) ProtoReflect() protoreflect.Message {
 	mi := &file_api_plugin_v1_plugin_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1614,58 +1893,168 @@ func (x *InstallerMaintainer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallerMaintainer.ProtoReflect.Descriptor instead.
-func (*InstallerMaintainer) Descriptor() ([]byte, []int) {
-	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use UninstallAffectedPlugin.ProtoReflect.Descriptor instead.
+func (*UninstallAffectedPlugin) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *InstallerMaintainer) GetName() string {
+func (x *UninstallAffectedPlugin) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *InstallerMaintainer) GetEmail() string {
+func (x *UninstallAffectedPlugin) GetAddonsPoints() []string {
 	if x != nil {
-		return x.Email
+		return x.AddonsPoints
 	}
-	return ""
+	return nil
 }
 
-func (x *InstallerMaintainer) GetUrl() string {
+type UninstallPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenants   []string                   `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	Plugins   []*UninstallAffectedPlugin `protobuf:"bytes,2,rep,name=plugins,proto3" json:"plugins,omitempty"`
+	Conflicts []string                   `protobuf:"bytes,3,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Policies  int32                      `protobuf:"varint,4,opt,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (x *UninstallPreview) Reset() {
+	*x = UninstallPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UninstallPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninstallPreview) ProtoMessage() {}
+
+func (x *UninstallPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UninstallPreview.ProtoReflect.Descriptor instead.
+func (*UninstallPreview) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UninstallPreview) GetTenants() []string {
 	if x != nil {
-		return x.Url
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *UninstallPreview) GetPlugins() []*UninstallAffectedPlugin {
+	if x != nil {
+		return x.Plugins
+	}
+	return nil
+}
+
+func (x *UninstallPreview) GetConflicts() []string {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *UninstallPreview) GetPolicies() int32 {
+	if x != nil {
+		return x.Policies
+	}
+	return 0
+}
+
+type GetPluginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetPluginRequest) Reset() {
+	*x = GetPluginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPluginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPluginRequest) ProtoMessage() {}
+
+func (x *GetPluginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPluginRequest.ProtoReflect.Descriptor instead.
+func (*GetPluginRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPluginRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type TenantEnableRequest_EnableExtraData struct {
+type GetPluginResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Desc  string `protobuf:"bytes,1,opt,name=desc,proto3" json:"desc,omitempty"`
-	Extra []byte `protobuf:"bytes,2,opt,name=extra,proto3" json:"extra,omitempty"`
+	Plugin *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
 }
 
-func (x *TenantEnableRequest_EnableExtraData) Reset() {
-	*x = TenantEnableRequest_EnableExtraData{}
+func (x *GetPluginResponse) Reset() {
+	*x = GetPluginResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_plugin_v1_plugin_proto_msgTypes[24]
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TenantEnableRequest_EnableExtraData) String() string {
+func (x *GetPluginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantEnableRequest_EnableExtraData) ProtoMessage() {}
+func (*GetPluginResponse) ProtoMessage() {}
 
-func (x *TenantEnableRequest_EnableExtraData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_plugin_v1_plugin_proto_msgTypes[24]
+func (x *GetPluginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1676,685 +2065,5412 @@ func (x *TenantEnableRequest_EnableExtraData) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantEnableRequest_EnableExtraData.ProtoReflect.Descriptor instead.
-func (*TenantEnableRequest_EnableExtraData) Descriptor() ([]byte, []int) {
-	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use GetPluginResponse.ProtoReflect.Descriptor instead.
+func (*GetPluginResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TenantEnableRequest_EnableExtraData) GetDesc() string {
+func (x *GetPluginResponse) GetPlugin() *PluginObject {
 	if x != nil {
-		return x.Desc
+		return x.Plugin
+	}
+	return nil
+}
+
+type PluginRelease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision         int32  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Status           string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Chart            string `protobuf:"bytes,3,opt,name=chart,proto3" json:"chart,omitempty"`
+	ChartVersion     string `protobuf:"bytes,4,opt,name=chart_version,json=chartVersion,proto3" json:"chart_version,omitempty"`
+	AppVersion       string `protobuf:"bytes,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	Description      string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	UpdatedTimestamp int64  `protobuf:"varint,7,opt,name=updated_timestamp,json=updatedTimestamp,proto3" json:"updated_timestamp,omitempty"`
+	Values           []byte `protobuf:"bytes,8,opt,name=values,proto3" json:"values,omitempty"`
+	Manifest         string `protobuf:"bytes,9,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Notes            string `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *PluginRelease) Reset() {
+	*x = PluginRelease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginRelease) ProtoMessage() {}
+
+func (x *PluginRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginRelease.ProtoReflect.Descriptor instead.
+func (*PluginRelease) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PluginRelease) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PluginRelease) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *TenantEnableRequest_EnableExtraData) GetExtra() []byte {
+func (x *PluginRelease) GetChart() string {
 	if x != nil {
-		return x.Extra
+		return x.Chart
+	}
+	return ""
+}
+
+func (x *PluginRelease) GetChartVersion() string {
+	if x != nil {
+		return x.ChartVersion
+	}
+	return ""
+}
+
+func (x *PluginRelease) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *PluginRelease) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PluginRelease) GetUpdatedTimestamp() int64 {
+	if x != nil {
+		return x.UpdatedTimestamp
+	}
+	return 0
+}
+
+func (x *PluginRelease) GetValues() []byte {
+	if x != nil {
+		return x.Values
 	}
 	return nil
 }
 
-var File_api_plugin_v1_plugin_proto protoreflect.FileDescriptor
+func (x *PluginRelease) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
 
-var file_api_plugin_v1_plugin_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x69, 0x6f,
-	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
-	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x6d, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x6f,
-	0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe7,
-	0x82, 0xb9, 0xe5, 0x90, 0x8d, 0xe5, 0xad, 0x97, 0x52, 0x06, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73,
-	0x12, 0x2d, 0x0a, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xb8, 0x8a, 0xe6, 0xb8, 0xb8, 0xe8,
-	0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0x52, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22,
-	0xec, 0x04, 0x0a, 0x09, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x28, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11,
-	0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7,
-	0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5,
-	0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5,
-	0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x28,
-	0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41,
-	0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x9b, 0xbe, 0xe6,
-	0xa0, 0x87, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89,
-	0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x64, 0x65,
-	0x73, 0x63, 0x12, 0x37, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x0d, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
-	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x32, 0x0c, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x12, 0x6b, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
-	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x14,
-	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe8, 0x80, 0x85, 0xe5, 0x88,
-	0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x73, 0x1a, 0x8a, 0x01, 0x0a, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
-	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe8, 0x80, 0x85, 0xe5, 0x90,
-	0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f,
-	0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe8, 0x80, 0x85, 0xe9, 0x82, 0xae, 0xe7, 0xae, 0xb1, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x26, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4,
-	0xe8, 0x80, 0x85, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xa8,
-	0x02, 0x0a, 0x0d, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe7, 0xa9, 0xba, 0xe9,
-	0x97, 0xb4, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x72, 0x65, 0x6d,
-	0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06,
-	0xe5, 0xa4, 0x87, 0xe6, 0xb3, 0xa8, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x2a,
-	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0x49, 0x44,
-	0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0b, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0x49,
-	0x44, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x3c, 0x0a,
-	0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x90,
-	0xaf, 0xe7, 0x94, 0xa8, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92,
-	0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
-	0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x22, 0x93, 0x04, 0x0a, 0x0b, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x42, 0x72, 0x69, 0x65, 0x66, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4,
-	0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe6, 0x97,
-	0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x11, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x6b, 0x65, 0x65, 0x6c,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0x92, 0x41, 0x15, 0x32, 0x13, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96, 0xe7, 0x9a, 0x84, 0x6b, 0x65,
-	0x65, 0x6c, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0c, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x64, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x5f, 0x62, 0x72, 0x69, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
-	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0e, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x72, 0x69, 0x65, 0x66, 0x12, 0x36, 0x0a, 0x0d,
-	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
-	0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0x52, 0x0c, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x11,
-	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
-	0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x54, 0x0a, 0x0a, 0x73, 0x77, 0x69,
-	0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x34, 0x92,
-	0x41, 0x31, 0x32, 0x2f, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x8f, 0xaf, 0xe4, 0xbb, 0xa5,
-	0xe6, 0x89, 0x8b, 0xe5, 0x8a, 0xa8, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0x5b, 0x74, 0x72, 0x75,
-	0x65, 0x3d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x2c, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x3d, 0x64, 0x65,
-	0x6e, 0x79, 0x5d, 0x52, 0x0a, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x22,
-	0xaa, 0x05, 0x0a, 0x0c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x12, 0x5b, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64,
-	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x42, 0x72, 0x69, 0x65, 0x66, 0x42, 0x17, 0x92, 0x41,
-	0x14, 0x32, 0x12, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xae, 0x80, 0xe8, 0xa6, 0x81, 0xe4,
-	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x50, 0x0a,
-	0x0c, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x14, 0x92, 0x41,
-	0x11, 0x32, 0x0f, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe7, 0x82, 0xb9, 0xe5, 0xa3, 0xb0, 0xe6,
-	0x98, 0x8e, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
-	0x74, 0x0a, 0x12, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32,
-	0x21, 0xe5, 0xae, 0x9e, 0xe7, 0x8e, 0xb0, 0xe8, 0xaf, 0xa5, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95,
-	0xe7, 0x82, 0xb9, 0xe7, 0x9a, 0x84, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x88, 0x97, 0xe8,
-	0xa1, 0xa8, 0x52, 0x11, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x8f, 0x92, 0xe4,
-	0xbb, 0xb6, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0x52, 0x06,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x6e, 0x0a, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
-	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x42, 0x17, 0x92,
-	0x41, 0x14, 0x32, 0x12, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7,
-	0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x65, 0x73, 0x12, 0x75, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
-	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x42, 0x1d,
-	0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe7, 0x9a, 0x84, 0xe6, 0x89,
-	0xa9, 0xe5, 0xb1, 0x95, 0xe7, 0x82, 0xb9, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0x52, 0x0e, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x12, 0x5d, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x8e, 0xa7,
-	0xe5, 0x88, 0xb6, 0xe5, 0x8f, 0xb0, 0xe8, 0x8f, 0x9c, 0xe5, 0x8d, 0x95, 0x52, 0x0e, 0x63, 0x6f,
-	0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a,
-	0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x59, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65,
-	0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf,
-	0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x22,
-	0x6f, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
-	0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4,
-	0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x22, 0x90, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4,
-	0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x59, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6f,
-	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4,
-	0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x22, 0x6f, 0x0a, 0x15, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69,
-	0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x22, 0x37, 0x0a, 0x16, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
-	0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x71, 0x0a,
-	0x17, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
-	0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4,
-	0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x22, 0x31, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x6b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
-	0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4,
-	0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x22, 0xd1, 0x02, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e,
-	0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9,
-	0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95,
-	0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c,
-	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe5, 0xad, 0x97,
-	0xe6, 0xae, 0xb5, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x36, 0x0a, 0x0d,
-	0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
-	0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x90,
-	0x9c, 0xe7, 0xb4, 0xa2, 0xe5, 0x85, 0xb3, 0xe9, 0x94, 0xae, 0xe5, 0xad, 0x97, 0x52, 0x08, 0x6b,
-	0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x4b, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x08, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
-	0xe6, 0x98, 0xbe, 0xe7, 0xa4, 0xba, 0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe6, 0x8f, 0x92, 0xe4,
-	0xbb, 0xb6, 0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x22, 0xaa, 0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32,
-	0x09, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x26, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81,
-	0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41,
-	0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x5e, 0x0a, 0x0b, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
-	0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x42, 0x72, 0x69, 0x65, 0x66, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
-	0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x0a, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x17, 0x92,
-	0x41, 0x14, 0x32, 0x12, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6,
-	0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x75,
-	0x6d, 0x22, 0x84, 0x02, 0x0a, 0x13, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4,
-	0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x6b, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65,
-	0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x05,
-	0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0x61, 0x0a, 0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45,
-	0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x89, 0xa9,
-	0xe5, 0xb1, 0x95, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12,
-	0x27, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11,
-	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
-	0xae, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x98, 0x01, 0x0a, 0x15, 0x54, 0x4d, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4,
-	0xbb, 0xb6, 0x49, 0x44, 0x52, 0x08, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2a,
-	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0x49, 0x44,
-	0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x22, 0x5e, 0x0a, 0x14, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f,
-	0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x05, 0x65, 0x78,
-	0x74, 0x72, 0x61, 0x22, 0x99, 0x01, 0x0a, 0x16, 0x54, 0x4d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a,
-	0x0a, 0x09, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44,
-	0x52, 0x08, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x09, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92,
-	0x41, 0x0a, 0x32, 0x08, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0x49, 0x44, 0x52, 0x08, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5,
-	0xb1, 0x95, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22,
-	0xab, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61,
-	0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6,
-	0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67,
-	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8e,
-	0x92, 0xe5, 0xba, 0x8f, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x12, 0x36, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
-	0x0c, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x0c, 0x69,
-	0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x09, 0x6b,
-	0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
-	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe5, 0x85, 0xb3, 0xe9, 0x94,
-	0xae, 0xe5, 0xad, 0x97, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1d,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
-	0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x84, 0x02,
-	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0x92, 0x41, 0x0b,
-	0x32, 0x09, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x26, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0,
-	0x81, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92,
-	0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x68, 0x0a, 0x07, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6f,
-	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b,
-	0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0xe7,
-	0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x07, 0x74, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x73, 0x22, 0x2f, 0x0a, 0x1d, 0x54, 0x4d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x54, 0x4d, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x2a, 0x27, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x00, 0x12,
-	0x08, 0x0a, 0x04, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x01, 0x32, 0xb0, 0x1a, 0x0a, 0x06, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x12, 0xd4, 0x02, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x33, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
-	0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x69, 0x6f,
-	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xd7, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x0d, 0x2f, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x65, 0x72, 0x92, 0x41, 0xb3, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x12, 0x12, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x8e,
-	0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55,
-	0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x2a, 0x0a,
-	0x03, 0x34, 0x30, 0x34, 0x12, 0x23, 0x0a, 0x21, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f,
-	0x52, 0x59, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03, 0x34, 0x30, 0x39,
-	0x12, 0x10, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53,
-	0x54, 0x53, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54,
-	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x12, 0xd4, 0x02, 0x0a, 0x0d,
-	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x33, 0x2e,
-	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75,
-	0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd7, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1a, 0x1a, 0x0d, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x3a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x92, 0x41, 0xb3, 0x01, 0x0a,
-	0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6,
-	0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0d, 0x55, 0x70, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30,
-	0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30,
-	0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55,
-	0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x2a, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x23, 0x0a, 0x21, 0x52,
-	0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x53,
-	0x54, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
-	0x4a, 0x17, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12, 0x10, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41,
-	0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30,
-	0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f,
-	0x52, 0x45, 0x12, 0xa5, 0x02, 0x0a, 0x0f, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x35, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
-	0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
-	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x2a, 0x0d,
-	0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x89,
-	0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12, 0xe5, 0x8d, 0xb8, 0xe8, 0xbd,
-	0xbd, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0f, 0x55,
-	0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4a, 0x0b,
-	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34,
-	0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52,
-	0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a,
-	0x10, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45,
-	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x12, 0x8d, 0x02, 0x0a, 0x09, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x2f, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
-	0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
-	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x92, 0x41, 0x83, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12,
-	0x12, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x8e, 0xa5,
-	0xe5, 0x8f, 0xa3, 0x2a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4a, 0x0b,
-	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34,
-	0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52,
-	0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a,
-	0x10, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45,
-	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x12, 0xf6, 0x01, 0x0a, 0x0a, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x30, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
-	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x69, 0x6f,
-	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82,
-	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x73, 0x92, 0x41, 0x6f, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0xe8, 0x8e,
-	0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8,
-	0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a,
-	0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
-	0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30,
-	0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x12, 0xf5, 0x01, 0x0a, 0x0c, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
-	0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x98, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x15, 0x2f, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
-	0x3a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x92, 0x41, 0x71, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x12, 0x18, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7,
-	0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0c, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30,
-	0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12,
-	0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45,
-	0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54,
-	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x12, 0xf1, 0x01, 0x0a, 0x0d,
-	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x2e,
-	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x92, 0x01, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x92, 0x41, 0x72, 0x0a, 0x06, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xa7, 0x9f,
-	0xe6, 0x88, 0xb7, 0xe5, 0x81, 0x9c, 0xe7, 0x94, 0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a,
-	0x0d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x0b,
-	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34,
-	0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52,
-	0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a,
-	0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x12,
-	0xa8, 0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
-	0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x39, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64,
-	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x92, 0x41, 0x7c, 0x0a, 0x06,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x8f,
-	0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x91, 0xe5, 0xae, 0x9a, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7,
-	0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
-	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a,
-	0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e,
-	0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45,
-	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x12, 0x92, 0x02, 0x0a, 0x16, 0x54,
-	0x4d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x79, 0x12, 0x3c, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
-	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x4d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xa1, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x74, 0x6d, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x92, 0x41, 0x81, 0x01, 0x0a, 0x06,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x8f,
-	0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x91, 0xe5, 0xae, 0x9a, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7,
-	0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x16, 0x54, 0x4d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x4a, 0x0b,
-	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34,
-	0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52,
-	0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a,
-	0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x12,
-	0xf9, 0x01, 0x0a, 0x10, 0x54, 0x4d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x12, 0x36, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
-	0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x4d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x94, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f,
-	0x74, 0x6d, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x92, 0x41, 0x75, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x18,
-	0xe6, 0x89, 0x8b, 0xe5, 0x8a, 0xa8, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe6, 0x8f, 0x92, 0xe4,
-	0xbb, 0xb6, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x10, 0x54, 0x4d, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30,
-	0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12,
-	0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45,
-	0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54,
-	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x12, 0x90, 0x02, 0x0a, 0x0e,
-	0x54, 0x4d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x34,
-	0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x4d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xaf, 0x01, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22, 0x2b, 0x2f, 0x74, 0x6d, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x73, 0x2f, 0x7b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x7d, 0x3a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x92, 0x41, 0x72, 0x0a, 0x06, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xa7, 0x9f, 0xe6,
-	0x88, 0xb7, 0xe5, 0x81, 0x9c, 0xe7, 0x94, 0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0d,
-	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x0b, 0x0a,
-	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30,
-	0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47,
-	0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x12, 0x8b,
-	0x02, 0x0a, 0x0f, 0x54, 0x4d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x35, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75,
-	0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x4d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0xa8, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x2a, 0x2b, 0x2f, 0x74, 0x6d, 0x2f,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x74, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x92, 0x41, 0x72, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x12, 0x18, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7,
-	0xe5, 0x81, 0x9c, 0xe7, 0x94, 0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0d, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32,
-	0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12,
-	0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d,
-	0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e,
-	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x42, 0x4d, 0x0a, 0x1d,
-	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a,
-	0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65,
-	0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+func (x *PluginRelease) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
 }
 
-var (
-	file_api_plugin_v1_plugin_proto_rawDescOnce sync.Once
-	file_api_plugin_v1_plugin_proto_rawDescData = file_api_plugin_v1_plugin_proto_rawDesc
-)
+type GetPluginReleaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_api_plugin_v1_plugin_proto_rawDescGZIP() []byte {
-	file_api_plugin_v1_plugin_proto_rawDescOnce.Do(func() {
-		file_api_plugin_v1_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_plugin_v1_plugin_proto_rawDescData)
-	})
-	return file_api_plugin_v1_plugin_proto_rawDescData
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision int32  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-var file_api_plugin_v1_plugin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_plugin_v1_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
-var file_api_plugin_v1_plugin_proto_goTypes = []interface{}{
-	(ConfigurationType)(0),                      // 0: io.tkeel.rudder.api.plugin.v1.ConfigurationType
-	(*RegisterAddons)(nil),                      // 1: io.tkeel.rudder.api.plugin.v1.RegisterAddons
-	(*Installer)(nil),                           // 2: io.tkeel.rudder.api.plugin.v1.Installer
-	(*EnabledTenant)(nil),                       // 3: io.tkeel.rudder.api.plugin.v1.EnabledTenant
-	(*PluginBrief)(nil),                         // 4: io.tkeel.rudder.api.plugin.v1.PluginBrief
-	(*PluginObject)(nil),                        // 5: io.tkeel.rudder.api.plugin.v1.PluginObject
-	(*InstallPluginRequest)(nil),                // 6: io.tkeel.rudder.api.plugin.v1.InstallPluginRequest
-	(*InstallPluginResponse)(nil),               // 7: io.tkeel.rudder.api.plugin.v1.InstallPluginResponse
-	(*UpgradePluginRequest)(nil),                // 8: io.tkeel.rudder.api.plugin.v1.UpgradePluginRequest
-	(*UpgradePluginResponse)(nil),               // 9: io.tkeel.rudder.api.plugin.v1.UpgradePluginResponse
-	(*UninstallPluginRequest)(nil),              // 10: io.tkeel.rudder.api.plugin.v1.UninstallPluginRequest
-	(*UninstallPluginResponse)(nil),             // 11: io.tkeel.rudder.api.plugin.v1.UninstallPluginResponse
-	(*GetPluginRequest)(nil),                    // 12: io.tkeel.rudder.api.plugin.v1.GetPluginRequest
-	(*GetPluginResponse)(nil),                   // 13: io.tkeel.rudder.api.plugin.v1.GetPluginResponse
-	(*ListPluginRequest)(nil),                   // 14: io.tkeel.rudder.api.plugin.v1.ListPluginRequest
-	(*ListPluginResponse)(nil),                  // 15: io.tkeel.rudder.api.plugin.v1.ListPluginResponse
-	(*TenantEnableRequest)(nil),                 // 16: io.tkeel.rudder.api.plugin.v1.TenantEnableRequest
-	(*TMTenantEnableRequest)(nil),               // 17: io.tkeel.rudder.api.plugin.v1.TMTenantEnableRequest
-	(*TenantDisableRequest)(nil),                // 18: io.tkeel.rudder.api.plugin.v1.TenantDisableRequest
-	(*TMTenantDisableRequest)(nil),              // 19: io.tkeel.rudder.api.plugin.v1.TMTenantDisableRequest
-	(*ListEnabledTenantsRequest)(nil),           // 20: io.tkeel.rudder.api.plugin.v1.ListEnabledTenantsRequest
-	(*ListEnabledTenantsResponse)(nil),          // 21: io.tkeel.rudder.api.plugin.v1.ListEnabledTenantsResponse
-	(*TMUpdatePluginIdentifyRequest)(nil),       // 22: io.tkeel.rudder.api.plugin.v1.TMUpdatePluginIdentifyRequest
-	(*TMRegisterPluginRequest)(nil),             // 23: io.tkeel.rudder.api.plugin.v1.TMRegisterPluginRequest
-	(*InstallerMaintainer)(nil),                 // 24: io.tkeel.rudder.api.plugin.v1.Installer.maintainer
-	(*TenantEnableRequest_EnableExtraData)(nil), // 25: io.tkeel.rudder.api.plugin.v1.TenantEnableRequest.EnableExtraData
-	(v1.PluginStatus)(0),                        // 26: openapi.v1.PluginStatus
-	(*v1.AddonsPoint)(nil),                      // 27: openapi.v1.AddonsPoint
-	(*v1.ImplementedPlugin)(nil),                // 28: openapi.v1.ImplementedPlugin
-	(*v1.ConsoleEntry)(nil),                     // 29: openapi.v1.ConsoleEntry
-	(*emptypb.Empty)(nil),                       // 30: google.protobuf.Empty
+func (x *GetPluginReleaseRequest) Reset() {
+	*x = GetPluginReleaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_api_plugin_v1_plugin_proto_depIdxs = []int32{
-	0,  // 0: io.tkeel.rudder.api.plugin.v1.Installer.type:type_name -> io.tkeel.rudder.api.plugin.v1.ConfigurationType
-	24, // 1: io.tkeel.rudder.api.plugin.v1.Installer.maintainers:type_name -> io.tkeel.rudder.api.plugin.v1.Installer.maintainer
-	2,  // 2: io.tkeel.rudder.api.plugin.v1.PluginBrief.installer_brief:type_name -> io.tkeel.rudder.api.plugin.v1.Installer
-	26, // 3: io.tkeel.rudder.api.plugin.v1.PluginBrief.status:type_name -> openapi.v1.PluginStatus
-	4,  // 4: io.tkeel.rudder.api.plugin.v1.PluginObject.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginBrief
-	27, // 5: io.tkeel.rudder.api.plugin.v1.PluginObject.addons_point:type_name -> openapi.v1.AddonsPoint
-	28, // 6: io.tkeel.rudder.api.plugin.v1.PluginObject.implemented_plugin:type_name -> openapi.v1.ImplementedPlugin
-	3,  // 7: io.tkeel.rudder.api.plugin.v1.PluginObject.enable_tenantes:type_name -> io.tkeel.rudder.api.plugin.v1.EnabledTenant
-	1,  // 8: io.tkeel.rudder.api.plugin.v1.PluginObject.register_addons:type_name -> io.tkeel.rudder.api.plugin.v1.RegisterAddons
-	29, // 9: io.tkeel.rudder.api.plugin.v1.PluginObject.console_entries:type_name -> openapi.v1.ConsoleEntry
-	2,  // 10: io.tkeel.rudder.api.plugin.v1.InstallPluginRequest.installer:type_name -> io.tkeel.rudder.api.plugin.v1.Installer
-	5,  // 11: io.tkeel.rudder.api.plugin.v1.InstallPluginResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
-	2,  // 12: io.tkeel.rudder.api.plugin.v1.UpgradePluginRequest.installer:type_name -> io.tkeel.rudder.api.plugin.v1.Installer
-	5,  // 13: io.tkeel.rudder.api.plugin.v1.UpgradePluginResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
-	5,  // 14: io.tkeel.rudder.api.plugin.v1.UninstallPluginResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
-	5,  // 15: io.tkeel.rudder.api.plugin.v1.GetPluginResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
-	4,  // 16: io.tkeel.rudder.api.plugin.v1.ListPluginResponse.plugin_list:type_name -> io.tkeel.rudder.api.plugin.v1.PluginBrief
-	25, // 17: io.tkeel.rudder.api.plugin.v1.TenantEnableRequest.extra:type_name -> io.tkeel.rudder.api.plugin.v1.TenantEnableRequest.EnableExtraData
-	3,  // 18: io.tkeel.rudder.api.plugin.v1.ListEnabledTenantsResponse.tenants:type_name -> io.tkeel.rudder.api.plugin.v1.EnabledTenant
-	6,  // 19: io.tkeel.rudder.api.plugin.v1.Plugin.InstallPlugin:input_type -> io.tkeel.rudder.api.plugin.v1.InstallPluginRequest
-	8,  // 20: io.tkeel.rudder.api.plugin.v1.Plugin.UpgradePlugin:input_type -> io.tkeel.rudder.api.plugin.v1.UpgradePluginRequest
-	10, // 21: io.tkeel.rudder.api.plugin.v1.Plugin.UninstallPlugin:input_type -> io.tkeel.rudder.api.plugin.v1.UninstallPluginRequest
-	12, // 22: io.tkeel.rudder.api.plugin.v1.Plugin.GetPlugin:input_type -> io.tkeel.rudder.api.plugin.v1.GetPluginRequest
-	14, // 23: io.tkeel.rudder.api.plugin.v1.Plugin.ListPlugin:input_type -> io.tkeel.rudder.api.plugin.v1.ListPluginRequest
-	16, // 24: io.tkeel.rudder.api.plugin.v1.Plugin.TenantEnable:input_type -> io.tkeel.rudder.api.plugin.v1.TenantEnableRequest
-	18, // 25: io.tkeel.rudder.api.plugin.v1.Plugin.TenantDisable:input_type -> io.tkeel.rudder.api.plugin.v1.TenantDisableRequest
-	20, // 26: io.tkeel.rudder.api.plugin.v1.Plugin.ListEnabledTenants:input_type -> io.tkeel.rudder.api.plugin.v1.ListEnabledTenantsRequest
-	22, // 27: io.tkeel.rudder.api.plugin.v1.Plugin.TMUpdatePluginIdentify:input_type -> io.tkeel.rudder.api.plugin.v1.TMUpdatePluginIdentifyRequest
-	23, // 28: io.tkeel.rudder.api.plugin.v1.Plugin.TMRegisterPlugin:input_type -> io.tkeel.rudder.api.plugin.v1.TMRegisterPluginRequest
-	17, // 29: io.tkeel.rudder.api.plugin.v1.Plugin.TMTenantEnable:input_type -> io.tkeel.rudder.api.plugin.v1.TMTenantEnableRequest
-	19, // 30: io.tkeel.rudder.api.plugin.v1.Plugin.TMTenantDisable:input_type -> io.tkeel.rudder.api.plugin.v1.TMTenantDisableRequest
-	7,  // 31: io.tkeel.rudder.api.plugin.v1.Plugin.InstallPlugin:output_type -> io.tkeel.rudder.api.plugin.v1.InstallPluginResponse
-	9,  // 32: io.tkeel.rudder.api.plugin.v1.Plugin.UpgradePlugin:output_type -> io.tkeel.rudder.api.plugin.v1.UpgradePluginResponse
-	11, // 33: io.tkeel.rudder.api.plugin.v1.Plugin.UninstallPlugin:output_type -> io.tkeel.rudder.api.plugin.v1.UninstallPluginResponse
-	13, // 34: io.tkeel.rudder.api.plugin.v1.Plugin.GetPlugin:output_type -> io.tkeel.rudder.api.plugin.v1.GetPluginResponse
-	15, // 35: io.tkeel.rudder.api.plugin.v1.Plugin.ListPlugin:output_type -> io.tkeel.rudder.api.plugin.v1.ListPluginResponse
-	30, // 36: io.tkeel.rudder.api.plugin.v1.Plugin.TenantEnable:output_type -> google.protobuf.Empty
-	30, // 37: io.tkeel.rudder.api.plugin.v1.Plugin.TenantDisable:output_type -> google.protobuf.Empty
-	21, // 38: io.tkeel.rudder.api.plugin.v1.Plugin.ListEnabledTenants:output_type -> io.tkeel.rudder.api.plugin.v1.ListEnabledTenantsResponse
-	30, // 39: io.tkeel.rudder.api.plugin.v1.Plugin.TMUpdatePluginIdentify:output_type -> google.protobuf.Empty
-	30, // 40: io.tkeel.rudder.api.plugin.v1.Plugin.TMRegisterPlugin:output_type -> google.protobuf.Empty
-	30, // 41: io.tkeel.rudder.api.plugin.v1.Plugin.TMTenantEnable:output_type -> google.protobuf.Empty
-	30, // 42: io.tkeel.rudder.api.plugin.v1.Plugin.TMTenantDisable:output_type -> google.protobuf.Empty
-	31, // [31:43] is the sub-list for method output_type
-	19, // [19:31] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+
+func (x *GetPluginReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_api_plugin_v1_plugin_proto_init() }
-func file_api_plugin_v1_plugin_proto_init() {
-	if File_api_plugin_v1_plugin_proto != nil {
-		return
+func (*GetPluginReleaseRequest) ProtoMessage() {}
+
+func (x *GetPluginReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_plugin_v1_plugin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterAddons); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPluginReleaseRequest.ProtoReflect.Descriptor instead.
+func (*GetPluginReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPluginReleaseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetPluginReleaseRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetPluginReleaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Release *PluginRelease `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
+}
+
+func (x *GetPluginReleaseResponse) Reset() {
+	*x = GetPluginReleaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPluginReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPluginReleaseResponse) ProtoMessage() {}
+
+func (x *GetPluginReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPluginReleaseResponse.ProtoReflect.Descriptor instead.
+func (*GetPluginReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetPluginReleaseResponse) GetRelease() *PluginRelease {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
+
+type ListPluginReleasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListPluginReleasesRequest) Reset() {
+	*x = ListPluginReleasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPluginReleasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPluginReleasesRequest) ProtoMessage() {}
+
+func (x *ListPluginReleasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPluginReleasesRequest.ProtoReflect.Descriptor instead.
+func (*ListPluginReleasesRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListPluginReleasesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPluginReleasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Releases []*PluginRelease `protobuf:"bytes,1,rep,name=releases,proto3" json:"releases,omitempty"`
+}
+
+func (x *ListPluginReleasesResponse) Reset() {
+	*x = ListPluginReleasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPluginReleasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPluginReleasesResponse) ProtoMessage() {}
+
+func (x *ListPluginReleasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPluginReleasesResponse.ProtoReflect.Descriptor instead.
+func (*ListPluginReleasesResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListPluginReleasesResponse) GetReleases() []*PluginRelease {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+type ListPluginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageNum          int32  `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize         int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy          string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	IsDescending     bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
+	KeyWords         string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
+	DisplayAllPlugin bool   `protobuf:"varint,10,opt,name=display_all_plugin,json=displayAllPlugin,proto3" json:"display_all_plugin,omitempty"`
+}
+
+func (x *ListPluginRequest) Reset() {
+	*x = ListPluginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPluginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPluginRequest) ProtoMessage() {}
+
+func (x *ListPluginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPluginRequest.ProtoReflect.Descriptor instead.
+func (*ListPluginRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListPluginRequest) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListPluginRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPluginRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListPluginRequest) GetIsDescending() bool {
+	if x != nil {
+		return x.IsDescending
+	}
+	return false
+}
+
+func (x *ListPluginRequest) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
+	}
+	return ""
+}
+
+func (x *ListPluginRequest) GetDisplayAllPlugin() bool {
+	if x != nil {
+		return x.DisplayAllPlugin
+	}
+	return false
+}
+
+type ListPluginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total      int32          `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum    int32          `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize   int32          `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PluginList []*PluginBrief `protobuf:"bytes,10,rep,name=plugin_list,json=pluginList,proto3" json:"plugin_list,omitempty"`
+	EnableNum  int32          `protobuf:"varint,11,opt,name=enable_num,json=enableNum,proto3" json:"enable_num,omitempty"`
+}
+
+func (x *ListPluginResponse) Reset() {
+	*x = ListPluginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPluginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPluginResponse) ProtoMessage() {}
+
+func (x *ListPluginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPluginResponse.ProtoReflect.Descriptor instead.
+func (*ListPluginResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPluginResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListPluginResponse) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListPluginResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPluginResponse) GetPluginList() []*PluginBrief {
+	if x != nil {
+		return x.PluginList
+	}
+	return nil
+}
+
+func (x *ListPluginResponse) GetEnableNum() int32 {
+	if x != nil {
+		return x.EnableNum
+	}
+	return 0
+}
+
+type TenantEnableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string                               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Extra *TenantEnableRequest_EnableExtraData `protobuf:"bytes,2,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *TenantEnableRequest) Reset() {
+	*x = TenantEnableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantEnableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantEnableRequest) ProtoMessage() {}
+
+func (x *TenantEnableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantEnableRequest.ProtoReflect.Descriptor instead.
+func (*TenantEnableRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TenantEnableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TenantEnableRequest) GetExtra() *TenantEnableRequest_EnableExtraData {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type TMTenantEnableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PluginId string `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Extra    []byte `protobuf:"bytes,3,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *TMTenantEnableRequest) Reset() {
+	*x = TMTenantEnableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TMTenantEnableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TMTenantEnableRequest) ProtoMessage() {}
+
+func (x *TMTenantEnableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TMTenantEnableRequest.ProtoReflect.Descriptor instead.
+func (*TMTenantEnableRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TMTenantEnableRequest) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *TMTenantEnableRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TMTenantEnableRequest) GetExtra() []byte {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type TenantDisableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Extra []byte `protobuf:"bytes,2,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *TenantDisableRequest) Reset() {
+	*x = TenantDisableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantDisableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantDisableRequest) ProtoMessage() {}
+
+func (x *TenantDisableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantDisableRequest.ProtoReflect.Descriptor instead.
+func (*TenantDisableRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TenantDisableRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TenantDisableRequest) GetExtra() []byte {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type TMTenantDisableRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PluginId string `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Extra    []byte `protobuf:"bytes,3,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *TMTenantDisableRequest) Reset() {
+	*x = TMTenantDisableRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TMTenantDisableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TMTenantDisableRequest) ProtoMessage() {}
+
+func (x *TMTenantDisableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TMTenantDisableRequest.ProtoReflect.Descriptor instead.
+func (*TMTenantDisableRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TMTenantDisableRequest) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *TMTenantDisableRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TMTenantDisableRequest) GetExtra() []byte {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type TenantSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	All      bool   `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`
+	KeyWords string `protobuf:"bytes,2,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
+}
+
+func (x *TenantSelector) Reset() {
+	*x = TenantSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantSelector) ProtoMessage() {}
+
+func (x *TenantSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantSelector.ProtoReflect.Descriptor instead.
+func (*TenantSelector) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TenantSelector) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *TenantSelector) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
+	}
+	return ""
+}
+
+type BatchTenantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tenants          []string        `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	Selector         *TenantSelector `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	Concurrency      int32           `protobuf:"varint,4,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Extra            []byte          `protobuf:"bytes,5,opt,name=extra,proto3" json:"extra,omitempty"`
+	RetryOperationId string          `protobuf:"bytes,6,opt,name=retry_operation_id,json=retryOperationId,proto3" json:"retry_operation_id,omitempty"`
+	Async            bool            `protobuf:"varint,7,opt,name=async,proto3" json:"async,omitempty"`
+}
+
+func (x *BatchTenantRequest) Reset() {
+	*x = BatchTenantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTenantRequest) ProtoMessage() {}
+
+func (x *BatchTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTenantRequest.ProtoReflect.Descriptor instead.
+func (*BatchTenantRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchTenantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchTenantRequest) GetTenants() []string {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *BatchTenantRequest) GetSelector() *TenantSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *BatchTenantRequest) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *BatchTenantRequest) GetExtra() []byte {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *BatchTenantRequest) GetRetryOperationId() string {
+	if x != nil {
+		return x.RetryOperationId
+	}
+	return ""
+}
+
+func (x *BatchTenantRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type BatchTenantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OperationId string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Total       int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Succeeded   int32                  `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed      int32                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	Report      []*OperationReportItem `protobuf:"bytes,5,rep,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *BatchTenantResponse) Reset() {
+	*x = BatchTenantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTenantResponse) ProtoMessage() {}
+
+func (x *BatchTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTenantResponse.ProtoReflect.Descriptor instead.
+func (*BatchTenantResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BatchTenantResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *BatchTenantResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BatchTenantResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchTenantResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchTenantResponse) GetReport() []*OperationReportItem {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type ListEnabledTenantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageNum      int32  `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize     int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	OrderBy      string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	IsDescending bool   `protobuf:"varint,4,opt,name=is_descending,json=isDescending,proto3" json:"is_descending,omitempty"`
+	KeyWords     string `protobuf:"bytes,5,opt,name=key_words,json=keyWords,proto3" json:"key_words,omitempty"`
+	Id           string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListEnabledTenantsRequest) Reset() {
+	*x = ListEnabledTenantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEnabledTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnabledTenantsRequest) ProtoMessage() {}
+
+func (x *ListEnabledTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnabledTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListEnabledTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListEnabledTenantsRequest) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListEnabledTenantsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEnabledTenantsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListEnabledTenantsRequest) GetIsDescending() bool {
+	if x != nil {
+		return x.IsDescending
+	}
+	return false
+}
+
+func (x *ListEnabledTenantsRequest) GetKeyWords() string {
+	if x != nil {
+		return x.KeyWords
+	}
+	return ""
+}
+
+func (x *ListEnabledTenantsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListEnabledTenantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total    int32            `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum  int32            `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize int32            `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Tenants  []*EnabledTenant `protobuf:"bytes,10,rep,name=tenants,proto3" json:"tenants,omitempty"`
+}
+
+func (x *ListEnabledTenantsResponse) Reset() {
+	*x = ListEnabledTenantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEnabledTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEnabledTenantsResponse) ProtoMessage() {}
+
+func (x *ListEnabledTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEnabledTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListEnabledTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListEnabledTenantsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListEnabledTenantsResponse) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListEnabledTenantsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEnabledTenantsResponse) GetTenants() []*EnabledTenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type TMUpdatePluginIdentifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TMUpdatePluginIdentifyRequest) Reset() {
+	*x = TMUpdatePluginIdentifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TMUpdatePluginIdentifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TMUpdatePluginIdentifyRequest) ProtoMessage() {}
+
+func (x *TMUpdatePluginIdentifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TMUpdatePluginIdentifyRequest.ProtoReflect.Descriptor instead.
+func (*TMUpdatePluginIdentifyRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TMUpdatePluginIdentifyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TMRegisterPluginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TMRegisterPluginRequest) Reset() {
+	*x = TMRegisterPluginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TMRegisterPluginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TMRegisterPluginRequest) ProtoMessage() {}
+
+func (x *TMRegisterPluginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TMRegisterPluginRequest.ProtoReflect.Descriptor instead.
+func (*TMRegisterPluginRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TMRegisterPluginRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type OperationPhase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status         string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message        string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	StartTimestamp int64  `protobuf:"varint,4,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp   int64  `protobuf:"varint,5,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+}
+
+func (x *OperationPhase) Reset() {
+	*x = OperationPhase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationPhase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationPhase) ProtoMessage() {}
+
+func (x *OperationPhase) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationPhase.ProtoReflect.Descriptor instead.
+func (*OperationPhase) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *OperationPhase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OperationPhase) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OperationPhase) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *OperationPhase) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *OperationPhase) GetEndTimestamp() int64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+type OperationObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	PluginId        string                 `protobuf:"bytes,3,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Message         string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Phases          []*OperationPhase      `protobuf:"bytes,6,rep,name=phases,proto3" json:"phases,omitempty"`
+	CreateTimestamp int64                  `protobuf:"varint,7,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
+	UpdateTimestamp int64                  `protobuf:"varint,8,opt,name=update_timestamp,json=updateTimestamp,proto3" json:"update_timestamp,omitempty"`
+	Report          []*OperationReportItem `protobuf:"bytes,9,rep,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *OperationObject) Reset() {
+	*x = OperationObject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationObject) ProtoMessage() {}
+
+func (x *OperationObject) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationObject.ProtoReflect.Descriptor instead.
+func (*OperationObject) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *OperationObject) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OperationObject) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OperationObject) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *OperationObject) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OperationObject) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *OperationObject) GetPhases() []*OperationPhase {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *OperationObject) GetCreateTimestamp() int64 {
+	if x != nil {
+		return x.CreateTimestamp
+	}
+	return 0
+}
+
+func (x *OperationObject) GetUpdateTimestamp() int64 {
+	if x != nil {
+		return x.UpdateTimestamp
+	}
+	return 0
+}
+
+func (x *OperationObject) GetReport() []*OperationReportItem {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type OperationReportItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action    string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Target    string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Detail    string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Message   string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *OperationReportItem) Reset() {
+	*x = OperationReportItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationReportItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationReportItem) ProtoMessage() {}
+
+func (x *OperationReportItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationReportItem.ProtoReflect.Descriptor instead.
+func (*OperationReportItem) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *OperationReportItem) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *OperationReportItem) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *OperationReportItem) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *OperationReportItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OperationReportItem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *OperationReportItem) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type SetPluginUpgradePolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *SetPluginUpgradePolicyRequest) Reset() {
+	*x = SetPluginUpgradePolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPluginUpgradePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPluginUpgradePolicyRequest) ProtoMessage() {}
+
+func (x *SetPluginUpgradePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPluginUpgradePolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetPluginUpgradePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetPluginUpgradePolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetPluginUpgradePolicyRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+type SetPluginUpgradePolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+}
+
+func (x *SetPluginUpgradePolicyResponse) Reset() {
+	*x = SetPluginUpgradePolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPluginUpgradePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPluginUpgradePolicyResponse) ProtoMessage() {}
+
+func (x *SetPluginUpgradePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPluginUpgradePolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetPluginUpgradePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetPluginUpgradePolicyResponse) GetPlugin() *PluginObject {
+	if x != nil {
+		return x.Plugin
+	}
+	return nil
+}
+
+type ListAvailableUpgradesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Refresh bool `protobuf:"varint,1,opt,name=refresh,proto3" json:"refresh,omitempty"`
+}
+
+func (x *ListAvailableUpgradesRequest) Reset() {
+	*x = ListAvailableUpgradesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAvailableUpgradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableUpgradesRequest) ProtoMessage() {}
+
+func (x *ListAvailableUpgradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableUpgradesRequest.ProtoReflect.Descriptor instead.
+func (*ListAvailableUpgradesRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListAvailableUpgradesRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type AvailableUpgrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Repo            string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Installer       string `protobuf:"bytes,3,opt,name=installer,proto3" json:"installer,omitempty"`
+	CurrentVersion  string `protobuf:"bytes,4,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	LatestVersion   string `protobuf:"bytes,5,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	Policy          string `protobuf:"bytes,6,opt,name=policy,proto3" json:"policy,omitempty"`
+	AutoVersion     string `protobuf:"bytes,7,opt,name=auto_version,json=autoVersion,proto3" json:"auto_version,omitempty"`
+	DetectTimestamp int64  `protobuf:"varint,8,opt,name=detect_timestamp,json=detectTimestamp,proto3" json:"detect_timestamp,omitempty"`
+}
+
+func (x *AvailableUpgrade) Reset() {
+	*x = AvailableUpgrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AvailableUpgrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailableUpgrade) ProtoMessage() {}
+
+func (x *AvailableUpgrade) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailableUpgrade.ProtoReflect.Descriptor instead.
+func (*AvailableUpgrade) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *AvailableUpgrade) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AvailableUpgrade) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *AvailableUpgrade) GetInstaller() string {
+	if x != nil {
+		return x.Installer
+	}
+	return ""
+}
+
+func (x *AvailableUpgrade) GetCurrentVersion() string {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return ""
+}
+
+func (x *AvailableUpgrade) GetLatestVersion() string {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return ""
+}
+
+func (x *AvailableUpgrade) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *AvailableUpgrade) GetAutoVersion() string {
+	if x != nil {
+		return x.AutoVersion
+	}
+	return ""
+}
+
+func (x *AvailableUpgrade) GetDetectTimestamp() int64 {
+	if x != nil {
+		return x.DetectTimestamp
+	}
+	return 0
+}
+
+type ListAvailableUpgradesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Upgrades          []*AvailableUpgrade `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+	RefreshTimestamp  int64               `protobuf:"varint,2,opt,name=refresh_timestamp,json=refreshTimestamp,proto3" json:"refresh_timestamp,omitempty"`
+	MaintenanceWindow string              `protobuf:"bytes,3,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+}
+
+func (x *ListAvailableUpgradesResponse) Reset() {
+	*x = ListAvailableUpgradesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAvailableUpgradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAvailableUpgradesResponse) ProtoMessage() {}
+
+func (x *ListAvailableUpgradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAvailableUpgradesResponse.ProtoReflect.Descriptor instead.
+func (*ListAvailableUpgradesResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListAvailableUpgradesResponse) GetUpgrades() []*AvailableUpgrade {
+	if x != nil {
+		return x.Upgrades
+	}
+	return nil
+}
+
+func (x *ListAvailableUpgradesResponse) GetRefreshTimestamp() int64 {
+	if x != nil {
+		return x.RefreshTimestamp
+	}
+	return 0
+}
+
+func (x *ListAvailableUpgradesResponse) GetMaintenanceWindow() string {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return ""
+}
+
+type SetPluginMaintenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Enabled      bool   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Message      string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	EndTimestamp int64  `protobuf:"varint,4,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+}
+
+func (x *SetPluginMaintenanceRequest) Reset() {
+	*x = SetPluginMaintenanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPluginMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPluginMaintenanceRequest) ProtoMessage() {}
+
+func (x *SetPluginMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPluginMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*SetPluginMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetPluginMaintenanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetPluginMaintenanceRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetPluginMaintenanceRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetPluginMaintenanceRequest) GetEndTimestamp() int64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+type SetPluginMaintenanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+}
+
+func (x *SetPluginMaintenanceResponse) Reset() {
+	*x = SetPluginMaintenanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPluginMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPluginMaintenanceResponse) ProtoMessage() {}
+
+func (x *SetPluginMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPluginMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*SetPluginMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetPluginMaintenanceResponse) GetPlugin() *PluginObject {
+	if x != nil {
+		return x.Plugin
+	}
+	return nil
+}
+
+type SetPluginCandidateRouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tenants []string `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	Percent int32    `protobuf:"varint,3,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (x *SetPluginCandidateRouteRequest) Reset() {
+	*x = SetPluginCandidateRouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPluginCandidateRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPluginCandidateRouteRequest) ProtoMessage() {}
+
+func (x *SetPluginCandidateRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPluginCandidateRouteRequest.ProtoReflect.Descriptor instead.
+func (*SetPluginCandidateRouteRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetPluginCandidateRouteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetPluginCandidateRouteRequest) GetTenants() []string {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+func (x *SetPluginCandidateRouteRequest) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type SetPluginCandidateRouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+}
+
+func (x *SetPluginCandidateRouteResponse) Reset() {
+	*x = SetPluginCandidateRouteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPluginCandidateRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPluginCandidateRouteResponse) ProtoMessage() {}
+
+func (x *SetPluginCandidateRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPluginCandidateRouteResponse.ProtoReflect.Descriptor instead.
+func (*SetPluginCandidateRouteResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetPluginCandidateRouteResponse) GetPlugin() *PluginObject {
+	if x != nil {
+		return x.Plugin
+	}
+	return nil
+}
+
+type PromotePluginCandidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PromotePluginCandidateRequest) Reset() {
+	*x = PromotePluginCandidateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromotePluginCandidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotePluginCandidateRequest) ProtoMessage() {}
+
+func (x *PromotePluginCandidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotePluginCandidateRequest.ProtoReflect.Descriptor instead.
+func (*PromotePluginCandidateRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PromotePluginCandidateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PromotePluginCandidateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+}
+
+func (x *PromotePluginCandidateResponse) Reset() {
+	*x = PromotePluginCandidateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromotePluginCandidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotePluginCandidateResponse) ProtoMessage() {}
+
+func (x *PromotePluginCandidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotePluginCandidateResponse.ProtoReflect.Descriptor instead.
+func (*PromotePluginCandidateResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PromotePluginCandidateResponse) GetPlugin() *PluginObject {
+	if x != nil {
+		return x.Plugin
+	}
+	return nil
+}
+
+type AbortPluginCandidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AbortPluginCandidateRequest) Reset() {
+	*x = AbortPluginCandidateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbortPluginCandidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortPluginCandidateRequest) ProtoMessage() {}
+
+func (x *AbortPluginCandidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortPluginCandidateRequest.ProtoReflect.Descriptor instead.
+func (*AbortPluginCandidateRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AbortPluginCandidateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AbortPluginCandidateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Plugin *PluginObject `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+}
+
+func (x *AbortPluginCandidateResponse) Reset() {
+	*x = AbortPluginCandidateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbortPluginCandidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortPluginCandidateResponse) ProtoMessage() {}
+
+func (x *AbortPluginCandidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortPluginCandidateResponse.ProtoReflect.Descriptor instead.
+func (*AbortPluginCandidateResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AbortPluginCandidateResponse) GetPlugin() *PluginObject {
+	if x != nil {
+		return x.Plugin
+	}
+	return nil
+}
+
+type GetPluginGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format   string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	TenantId string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PluginId string `protobuf:"bytes,3,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+}
+
+func (x *GetPluginGraphRequest) Reset() {
+	*x = GetPluginGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPluginGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPluginGraphRequest) ProtoMessage() {}
+
+func (x *GetPluginGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPluginGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetPluginGraphRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetPluginGraphRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetPluginGraphRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetPluginGraphRequest) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+type PluginGraphNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Status  string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Tenants []string `protobuf:"bytes,4,rep,name=tenants,proto3" json:"tenants,omitempty"`
+}
+
+func (x *PluginGraphNode) Reset() {
+	*x = PluginGraphNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginGraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginGraphNode) ProtoMessage() {}
+
+func (x *PluginGraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginGraphNode.ProtoReflect.Descriptor instead.
+func (*PluginGraphNode) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PluginGraphNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PluginGraphNode) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PluginGraphNode) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PluginGraphNode) GetTenants() []string {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type PluginGraphEdge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From   string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Type   string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Detail string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+}
+
+func (x *PluginGraphEdge) Reset() {
+	*x = PluginGraphEdge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginGraphEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginGraphEdge) ProtoMessage() {}
+
+func (x *PluginGraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginGraphEdge.ProtoReflect.Descriptor instead.
+func (*PluginGraphEdge) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PluginGraphEdge) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PluginGraphEdge) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PluginGraphEdge) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PluginGraphEdge) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type GetPluginGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes []*PluginGraphNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges []*PluginGraphEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	Dot   string             `protobuf:"bytes,3,opt,name=dot,proto3" json:"dot,omitempty"`
+}
+
+func (x *GetPluginGraphResponse) Reset() {
+	*x = GetPluginGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPluginGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPluginGraphResponse) ProtoMessage() {}
+
+func (x *GetPluginGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPluginGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetPluginGraphResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetPluginGraphResponse) GetNodes() []*PluginGraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetPluginGraphResponse) GetEdges() []*PluginGraphEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *GetPluginGraphResponse) GetDot() string {
+	if x != nil {
+		return x.Dot
+	}
+	return ""
+}
+
+type ReconcilePluginsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repair bool     `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"`
+	Kinds  []string `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"`
+}
+
+func (x *ReconcilePluginsRequest) Reset() {
+	*x = ReconcilePluginsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcilePluginsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcilePluginsRequest) ProtoMessage() {}
+
+func (x *ReconcilePluginsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcilePluginsRequest.ProtoReflect.Descriptor instead.
+func (*ReconcilePluginsRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReconcilePluginsRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+func (x *ReconcilePluginsRequest) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type PluginDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind     string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Target   string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Detail   string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	Repaired bool   `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	Error    string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PluginDrift) Reset() {
+	*x = PluginDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluginDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginDrift) ProtoMessage() {}
+
+func (x *PluginDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginDrift.ProtoReflect.Descriptor instead.
+func (*PluginDrift) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PluginDrift) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PluginDrift) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *PluginDrift) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *PluginDrift) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+func (x *PluginDrift) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReconcilePluginsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Drifts    []*PluginDrift `protobuf:"bytes,1,rep,name=drifts,proto3" json:"drifts,omitempty"`
+	Timestamp int64          `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ReconcilePluginsResponse) Reset() {
+	*x = ReconcilePluginsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcilePluginsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcilePluginsResponse) ProtoMessage() {}
+
+func (x *ReconcilePluginsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcilePluginsResponse.ProtoReflect.Descriptor instead.
+func (*ReconcilePluginsResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReconcilePluginsResponse) GetDrifts() []*PluginDrift {
+	if x != nil {
+		return x.Drifts
+	}
+	return nil
+}
+
+func (x *ReconcilePluginsResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetOperationRequest) Reset() {
+	*x = GetOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationRequest) ProtoMessage() {}
+
+func (x *GetOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetOperationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation *OperationObject `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (x *GetOperationResponse) Reset() {
+	*x = GetOperationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationResponse) ProtoMessage() {}
+
+func (x *GetOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationResponse.ProtoReflect.Descriptor instead.
+func (*GetOperationResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetOperationResponse) GetOperation() *OperationObject {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+type ListOperationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageNum  int32  `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PluginId string `protobuf:"bytes,3,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	Type     string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Status   string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ListOperationsRequest) Reset() {
+	*x = ListOperationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsRequest) ProtoMessage() {}
+
+func (x *ListOperationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListOperationsRequest) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListOperationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOperationsRequest) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListOperationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListOperationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total      int32              `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum    int32              `protobuf:"varint,2,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize   int32              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Operations []*OperationObject `protobuf:"bytes,10,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *ListOperationsResponse) Reset() {
+	*x = ListOperationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsResponse) ProtoMessage() {}
+
+func (x *ListOperationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListOperationsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListOperationsResponse) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListOperationsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOperationsResponse) GetOperations() []*OperationObject {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type WatchOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WatchOperationRequest) Reset() {
+	*x = WatchOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOperationRequest) ProtoMessage() {}
+
+func (x *WatchOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOperationRequest.ProtoReflect.Descriptor instead.
+func (*WatchOperationRequest) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *WatchOperationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type InstallerMaintainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Url   string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *InstallerMaintainer) Reset() {
+	*x = InstallerMaintainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallerMaintainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallerMaintainer) ProtoMessage() {}
+
+func (x *InstallerMaintainer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallerMaintainer.ProtoReflect.Descriptor instead.
+func (*InstallerMaintainer) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *InstallerMaintainer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstallerMaintainer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InstallerMaintainer) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type TenantEnableRequest_EnableExtraData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Desc  string `protobuf:"bytes,1,opt,name=desc,proto3" json:"desc,omitempty"`
+	Extra []byte `protobuf:"bytes,2,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *TenantEnableRequest_EnableExtraData) Reset() {
+	*x = TenantEnableRequest_EnableExtraData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_plugin_v1_plugin_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantEnableRequest_EnableExtraData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantEnableRequest_EnableExtraData) ProtoMessage() {}
+
+func (x *TenantEnableRequest_EnableExtraData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_plugin_v1_plugin_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantEnableRequest_EnableExtraData.ProtoReflect.Descriptor instead.
+func (*TenantEnableRequest_EnableExtraData) Descriptor() ([]byte, []int) {
+	return file_api_plugin_v1_plugin_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *TenantEnableRequest_EnableExtraData) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *TenantEnableRequest_EnableExtraData) GetExtra() []byte {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+var File_api_plugin_v1_plugin_proto protoreflect.FileDescriptor
+
+var file_api_plugin_v1_plugin_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x69, 0x6f,
+	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x6d, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x6f,
+	0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe7,
+	0x82, 0xb9, 0xe5, 0x90, 0x8d, 0xe5, 0xad, 0x97, 0x52, 0x06, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73,
+	0x12, 0x2d, 0x0a, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xb8, 0x8a, 0xe6, 0xb8, 0xb8, 0xe8,
+	0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0x52, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22,
+	0xec, 0x04, 0x0a, 0x09, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x28, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11,
+	0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7,
+	0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5,
+	0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5,
+	0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x28,
+	0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41,
+	0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x9b, 0xbe, 0xe6,
+	0xa0, 0x87, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89,
+	0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x64, 0x65,
+	0x73, 0x63, 0x12, 0x37, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x52, 0x0d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
+	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x32, 0x0c, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x6b, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
+	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x14,
+	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe8, 0x80, 0x85, 0xe5, 0x88,
+	0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x1a, 0x8a, 0x01, 0x0a, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
+	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe8, 0x80, 0x85, 0xe5, 0x90,
+	0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f,
+	0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe8, 0x80, 0x85, 0xe9, 0x82, 0xae, 0xe7, 0xae, 0xb1, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x26, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4,
+	0xe8, 0x80, 0x85, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xa8,
+	0x02, 0x0a, 0x0d, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x12, 0x27, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe7, 0xa9, 0xba, 0xe9,
+	0x97, 0xb4, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x72, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06,
+	0xe5, 0xa4, 0x87, 0xe6, 0xb3, 0xa8, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x2a,
+	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0x49, 0x44,
+	0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0b, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0x49,
+	0x44, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x3c, 0x0a,
+	0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x90,
+	0xaf, 0xe7, 0x94, 0xa8, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
+	0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x22, 0xe2, 0x04, 0x0a, 0x0b, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x42, 0x72, 0x69, 0x65, 0x66, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4,
+	0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x11, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0x92, 0x41, 0x15, 0x32, 0x13, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96, 0xe7, 0x9a, 0x84, 0x6b, 0x65,
+	0x65, 0x6c, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0c, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x64, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x5f, 0x62, 0x72, 0x69, 0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0e, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x72, 0x69, 0x65, 0x66, 0x12, 0x36, 0x0a, 0x0d,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
+	0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0x52, 0x0c, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
+	0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x54, 0x0a, 0x0a, 0x73, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x34, 0x92,
+	0x41, 0x31, 0x32, 0x2f, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x8f, 0xaf, 0xe4, 0xbb, 0xa5,
+	0xe6, 0x89, 0x8b, 0xe5, 0x8a, 0xa8, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0x5b, 0x74, 0x72, 0x75,
+	0x65, 0x3d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x2c, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x3d, 0x64, 0x65,
+	0x6e, 0x79, 0x5d, 0x52, 0x0a, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x4d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x35, 0x92, 0x41, 0x32, 0x32, 0x30, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x81, 0xa5, 0xe5,
+	0xba, 0xb7, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x5b, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x2c, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x2c, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43,
+	0x48, 0x41, 0x42, 0x4c, 0x45, 0x5d, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0xbe,
+	0x01, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe5, 0x89,
+	0x8d, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x24, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f,
+	0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe5, 0x90, 0x8e, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4,
+	0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2f,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0xb3, 0x02, 0x0a, 0x0c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x45, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe5, 0x81, 0xa5, 0xe5, 0xba, 0xb7, 0xe7, 0x8a, 0xb6, 0xe6,
+	0x80, 0x81, 0x5b, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x2c, 0x44, 0x45, 0x47, 0x52, 0x41,
+	0x44, 0x45, 0x44, 0x2c, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x5d,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0xbd,
+	0x93, 0xe5, 0x89, 0x8d, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe8, 0xbf,
+	0x9b, 0xe5, 0x85, 0xa5, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81,
+	0xe7, 0x9a, 0x84, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x73, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe6, 0x9c, 0x80,
+	0xe8, 0xbf, 0x91, 0xe7, 0x9a, 0x84, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe5, 0x8f, 0x98, 0xe6,
+	0x9b, 0xb4, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe6, 0x08, 0x0a, 0x0c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x5b, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
+	0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x42, 0x72, 0x69,
+	0x65, 0x66, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7,
+	0xae, 0x80, 0xe8, 0xa6, 0x81, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x12, 0x50, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe7,
+	0x82, 0xb9, 0xe5, 0xa3, 0xb0, 0xe6, 0x98, 0x8e, 0x52, 0x0b, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x74, 0x0a, 0x12, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe5, 0xae, 0x9e, 0xe7, 0x8e, 0xb0, 0xe8, 0xaf, 0xa5,
+	0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe7, 0x82, 0xb9, 0xe7, 0x9a, 0x84, 0xe6, 0x8f, 0x92, 0xe4,
+	0xbb, 0xb6, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x11, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14,
+	0x32, 0x12, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe5, 0xaf,
+	0x86, 0xe9, 0x92, 0xa5, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x6e, 0x0a, 0x0f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8,
+	0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x0e, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x65, 0x73, 0x12, 0x75, 0x0a, 0x0f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x6f, 0x6e, 0x73, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0xb3, 0xa8, 0xe5, 0x86,
+	0x8c, 0xe7, 0x9a, 0x84, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe7, 0x82, 0xb9, 0xe8, 0xb7, 0xaf,
+	0xe7, 0x94, 0xb1, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x6f, 0x6e, 0x73, 0x12, 0x5d, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x8f, 0x92,
+	0xe4, 0xbb, 0xb6, 0xe6, 0x8e, 0xa7, 0xe5, 0x88, 0xb6, 0xe5, 0x8f, 0xb0, 0xe8, 0x8f, 0x9c, 0xe5,
+	0x8d, 0x95, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x5c, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75,
+	0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x81, 0xa5, 0xe5,
+	0xba, 0xb7, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x63, 0x0a, 0x0e, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x32, 0x37, 0xe6,
+	0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x8d, 0x87, 0xe7, 0xba, 0xa7, 0xe7, 0xad, 0x96, 0xe7, 0x95,
+	0xa5, 0x5b, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x2c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2c,
+	0x70, 0x61, 0x74, 0x63, 0x68, 0x2d, 0x61, 0x75, 0x74, 0x6f, 0x2c, 0x6d, 0x69, 0x6e, 0x6f, 0x72,
+	0x2d, 0x61, 0x75, 0x74, 0x6f, 0x5d, 0x52, 0x0d, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6f,
+	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x2f, 0x92,
+	0x41, 0x2c, 0x32, 0x2a, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4,
+	0xe6, 0xa8, 0xa1, 0xe5, 0xbc, 0x8f, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6,
+	0x97, 0xb6, 0xe6, 0x9c, 0xaa, 0xe5, 0x9c, 0xa8, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0x52, 0x0b,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x09, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x23,
+	0x92, 0x41, 0x20, 0x32, 0x1e, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe8, 0x93, 0x9d, 0xe7, 0xbb,
+	0xbf, 0xe5, 0x8d, 0x87, 0xe7, 0xba, 0xa7, 0xe5, 0x80, 0x99, 0xe9, 0x80, 0x89, 0xe7, 0x89, 0x88,
+	0xe6, 0x9c, 0xac, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x22, 0xd3,
+	0x04, 0x0a, 0x0f, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x14, 0xe5, 0x80, 0x99, 0xe9, 0x80, 0x89, 0xe7,
+	0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0xba, 0x94, 0xe7, 0x94, 0xa8, 0x49, 0x44, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5,
+	0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x32, 0x0a, 0x09, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
+	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x90,
+	0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x2b, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x80, 0x99, 0xe9, 0x80, 0x89, 0xe7, 0x89, 0x88,
+	0xe6, 0x9c, 0xac, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x07,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x23, 0x92,
+	0x41, 0x20, 0x32, 0x1e, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x88, 0xb0, 0xe5, 0x80, 0x99,
+	0xe9, 0x80, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe7, 0x9a, 0x84, 0xe7, 0xa7, 0x9f, 0xe6,
+	0x88, 0xb7, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x07, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x32, 0x92, 0x41,
+	0x2f, 0x32, 0x2d, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x88, 0xb0, 0xe5, 0x80, 0x99, 0xe9,
+	0x80, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe7, 0x9a, 0x84, 0xe5, 0x85, 0xb6, 0xe4, 0xbb,
+	0x96, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe7, 0x99, 0xbe, 0xe5, 0x88, 0x86, 0xe6, 0xaf, 0x94,
+	0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a,
+	0xe5, 0x80, 0x99, 0xe9, 0x80, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe7, 0x8a, 0xb6, 0xe6,
+	0x80, 0x81, 0x5b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x69, 0x6e, 0x67, 0x2c, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x2c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0x80, 0x99, 0xe9, 0x80, 0x89,
+	0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe4, 0xbf, 0xa1, 0xe6,
+	0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85,
+	0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0x80, 0x99, 0xe9,
+	0x80, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe9, 0x83, 0xa8, 0xe7, 0xbd, 0xb2, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x8e, 0x02, 0x0a, 0x11, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x32, 0x0c, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe5, 0xbc, 0x80,
+	0xe5, 0xa7, 0x8b, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x53, 0x0a, 0x0d, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x2e, 0x92, 0x41, 0x2b, 0x32, 0x29, 0xe9, 0xa2, 0x84, 0xe8, 0xae, 0xa1, 0xe7, 0xbb, 0xb4,
+	0xe6, 0x8a, 0xa4, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef,
+	0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0x9c, 0xaa, 0xe7, 0x9f, 0xa5,
+	0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35,
+	0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4,
+	0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe8, 0x80, 0x85, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0xe4, 0x01, 0x0a, 0x0c, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6,
+	0xb8, 0xb2, 0xe6, 0x9f, 0x93, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0x84, 0xe6, 0xba,
+	0x90, 0xe6, 0xb8, 0x85, 0xe5, 0x8d, 0x95, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x12, 0x38, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe6, 0x9c, 0x80, 0xe7, 0xbb, 0x88, 0xe7, 0x94,
+	0x9f, 0xe6, 0x95, 0x88, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x28, 0x59, 0x41,
+	0x4d, 0x4c, 0x29, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe8, 0xaf, 0xb4, 0xe6, 0x98, 0x8e, 0x52, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0xa3, 0x80,
+	0xe6, 0x9f, 0xa5, 0xe5, 0x8f, 0x91, 0xe7, 0x8e, 0xb0, 0xe7, 0x9a, 0x84, 0xe9, 0x97, 0xae, 0xe9,
+	0xa2, 0x98, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x22, 0xe5, 0x02, 0x0a,
+	0x14, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x59, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65,
+	0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf,
+	0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x42, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24, 0xe4, 0xbb, 0x85, 0xe6, 0xb8, 0xb2, 0xe6, 0x9f, 0x93,
+	0xe5, 0xb9, 0xb6, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe6,
+	0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5,
+	0xbc, 0x82, 0xe6, 0xad, 0xa5, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0x52, 0x05, 0x61, 0x73, 0x79,
+	0x6e, 0x63, 0x12, 0x60, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x90, 0x8c, 0xe6,
+	0x97, 0xb6, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe6, 0x9c, 0xaa, 0xe5, 0xae, 0x89, 0xe8, 0xa3,
+	0x85, 0xe7, 0x9a, 0x84, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6,
+	0x52, 0x12, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x22, 0xa5, 0x02, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb,
+	0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5,
+	0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x28,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41,
+	0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0x8d, 0xe7,
+	0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f,
+	0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0x92, 0x41, 0x11,
+	0x32, 0x0f, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xb7, 0xb2, 0xe5, 0xae, 0x89, 0xe8, 0xa3,
+	0x85, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0b,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x32, 0x17, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96, 0xe7, 0x9a,
+	0x84, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52,
+	0x0b, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xfa, 0x02, 0x0a,
+	0x15, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
+	0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6,
+	0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x5a,
+	0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x14, 0x92, 0x41,
+	0x11, 0x32, 0x0f, 0xe9, 0xa2, 0x84, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe7, 0xbb, 0x93, 0xe6,
+	0x9e, 0x9c, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x30, 0x0a, 0x0c, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x49, 0x44, 0x52,
+	0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x7b, 0x0a, 0x0c,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75,
+	0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x74,
+	0x65, 0x6d, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe8,
+	0xae, 0xa1, 0xe5, 0x88, 0x92, 0x28, 0xe6, 0x8c, 0x89, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe9,
+	0xa1, 0xba, 0xe5, 0xba, 0x8f, 0xe6, 0x8e, 0x92, 0xe5, 0x88, 0x97, 0x29, 0x52, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0x99, 0x04, 0x0a, 0x14, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x59, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
+	0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
+	0xaf, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x07,
+	0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x29, 0x92,
+	0x41, 0x26, 0x32, 0x24, 0xe4, 0xbb, 0x85, 0xe6, 0xb8, 0xb2, 0xe6, 0x9f, 0x93, 0xe5, 0xb9, 0xb6,
+	0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe6, 0x89, 0xa7, 0xe8,
+	0xa1, 0x8c, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x2d, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xbc, 0x82, 0xe6,
+	0xad, 0xa5, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x12,
+	0x5a, 0x0a, 0x0a, 0x62, 0x6c, 0x75, 0x65, 0x5f, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x36, 0xe8, 0x93, 0x9d, 0xe7, 0xbb, 0xbf,
+	0xe5, 0x8d, 0x87, 0xe7, 0xba, 0xa7, 0xef, 0xbc, 0x8c, 0xe6, 0x96, 0xb0, 0xe7, 0x89, 0x88, 0xe6,
+	0x9c, 0xac, 0xe4, 0xbd, 0x9c, 0xe4, 0xb8, 0xba, 0xe5, 0x80, 0x99, 0xe9, 0x80, 0x89, 0xe7, 0x89,
+	0x88, 0xe6, 0x9c, 0xac, 0xe7, 0x8b, 0xac, 0xe7, 0xab, 0x8b, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85,
+	0x52, 0x09, 0x62, 0x6c, 0x75, 0x65, 0x47, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x50, 0x0a, 0x11, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe8, 0xb7, 0xaf,
+	0xe7, 0x94, 0xb1, 0xe5, 0x88, 0xb0, 0xe5, 0x80, 0x99, 0xe9, 0x80, 0x89, 0xe7, 0x89, 0x88, 0xe6,
+	0x9c, 0xac, 0xe7, 0x9a, 0x84, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0x52, 0x10, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x66, 0x0a,
+	0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x39, 0x92, 0x41, 0x36, 0x32, 0x34, 0xe8,
+	0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x88, 0xb0, 0xe5, 0x80, 0x99, 0xe9, 0x80, 0x89, 0xe7, 0x89,
+	0x88, 0xe6, 0x9c, 0xac, 0xe7, 0x9a, 0x84, 0xe5, 0x85, 0xb6, 0xe4, 0xbb, 0x96, 0xe7, 0xa7, 0x9f,
+	0xe6, 0x88, 0xb7, 0xe7, 0x99, 0xbe, 0xe5, 0x88, 0x86, 0xe6, 0xaf, 0x94, 0x5b, 0x30, 0x2c, 0x31,
+	0x30, 0x30, 0x5d, 0x52, 0x10, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xfd, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52,
+	0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x5a, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
+	0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe9, 0xa2, 0x84, 0xe6,
+	0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x30, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x49, 0x44, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x15, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4a,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x2e, 0x92, 0x41, 0x2b, 0x32, 0x29, 0xe5, 0x9b, 0x9e, 0xe6, 0xbb, 0x9a, 0xe7, 0x9a, 0x84,
+	0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0x8f, 0xb7, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe4, 0xb8,
+	0xba, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8, 0x80, 0xe4, 0xb8, 0xaa, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa2, 0x01, 0x0a, 0x16, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4,
+	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x30, 0x0a,
+	0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c,
+	0x49, 0x44, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x92, 0x02, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6,
+	0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe9,
+	0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8e, 0xe5, 0xbd, 0x93, 0xe5, 0x89,
+	0x8d, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe5, 0x90, 0x88, 0xe5, 0xb9, 0xb6, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x69, 0x6f, 0x2e,
+	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+	0xa6, 0xe5, 0xbc, 0x82, 0xe6, 0xad, 0xa5, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0x52, 0x05, 0x61,
+	0x73, 0x79, 0x6e, 0x63, 0x22, 0xa5, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75,
+	0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6,
+	0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x30, 0x0a, 0x0c, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x49, 0x44, 0x52,
+	0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x98, 0x01, 0x0a,
+	0x19, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92,
+	0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5c, 0x0a, 0x0c, 0x67, 0x72, 0x61,
+	0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x39, 0x92, 0x41, 0x36, 0x32, 0x34, 0xe6, 0x97, 0xa7, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe7,
+	0xbb, 0xa7, 0xe7, 0xbb, 0xad, 0xe6, 0x9c, 0x89, 0xe6, 0x95, 0x88, 0xe7, 0x9a, 0x84, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0x28, 0xe7, 0xa7, 0x92, 0x29, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe4, 0xb8,
+	0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe5, 0x80, 0xbc, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x63,
+	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x1a, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
+	0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6,
+	0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x50,
+	0x0a, 0x16, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1a,
+	0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x97, 0xa7, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe5, 0xa4,
+	0xb1, 0xe6, 0x95, 0x88, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x14, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x22, 0xb2, 0x02, 0x0a, 0x16, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f,
+	0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x61, 0x73,
+	0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12,
+	0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xbc, 0x82, 0xe6, 0xad, 0xa5, 0xe6, 0x89, 0xa7, 0xe8,
+	0xa1, 0x8c, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x6a, 0x0a, 0x07, 0x63, 0x61, 0x73,
+	0x63, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32,
+	0x4b, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe7, 0xba, 0xa7, 0xe8, 0x81, 0x94, 0xe5, 0x8d, 0xb8,
+	0xe8, 0xbd, 0xbd, 0xef, 0xbc, 0x8c, 0xe5, 0x81, 0x9c, 0xe7, 0x94, 0xa8, 0xe6, 0x89, 0x80, 0xe6,
+	0x9c, 0x89, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe5, 0xb9, 0xb6, 0xe7, 0xa7, 0xbb, 0xe9, 0x99,
+	0xa4, 0xe5, 0x85, 0xb6, 0xe4, 0xbb, 0x96, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0xb3, 0xa8,
+	0xe5, 0x86, 0x8c, 0xe7, 0x9a, 0x84, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0x52, 0x07, 0x63, 0x61,
+	0x73, 0x63, 0x61, 0x64, 0x65, 0x12, 0x5e, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x44, 0x92, 0x41, 0x41, 0x32, 0x3f, 0xe4, 0xbb, 0x85,
+	0xe9, 0xa2, 0x84, 0xe8, 0xa7, 0x88, 0xe7, 0xba, 0xa7, 0xe8, 0x81, 0x94, 0xe5, 0x8d, 0xb8, 0xe8,
+	0xbd, 0xbd, 0xe5, 0xbd, 0xb1, 0xe5, 0x93, 0x8d, 0xe7, 0x9a, 0x84, 0xe7, 0xa7, 0x9f, 0xe6, 0x88,
+	0xb7, 0xe5, 0x92, 0x8c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d,
+	0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe5, 0x8d, 0xb8, 0xe8, 0xbd, 0xbd, 0x52, 0x07, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x22, 0xf2, 0x02, 0x0a, 0x17, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64,
+	0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
+	0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x30, 0x0a, 0x0c, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x49, 0x44, 0x52, 0x0b,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x62, 0x0a, 0x07, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x17, 0x92,
+	0x41, 0x14, 0x32, 0x12, 0xe5, 0x8d, 0xb8, 0xe8, 0xbd, 0xbd, 0xe5, 0xbd, 0xb1, 0xe5, 0x93, 0x8d,
+	0xe9, 0xa2, 0x84, 0xe8, 0xa7, 0x88, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x69, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe7, 0xba, 0xa7, 0xe8, 0x81, 0x94,
+	0xe5, 0x8d, 0xb8, 0xe8, 0xbd, 0xbd, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe6, 0x8a, 0xa5, 0xe5,
+	0x91, 0x8a, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x55,
+	0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4e, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x5f,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x29, 0x92, 0x41,
+	0x26, 0x32, 0x24, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe5, 0x9c, 0xa8, 0xe5, 0x8d, 0xb8, 0xe8,
+	0xbd, 0xbd, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xb8, 0x8a, 0xe7, 0x9a, 0x84, 0xe6, 0x89,
+	0xa9, 0xe5, 0xb1, 0x95, 0xe7, 0x82, 0xb9, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xc9, 0x02, 0x0a, 0x10, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x34, 0x0a, 0x07, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17,
+	0x32, 0x15, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a,
+	0x84, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x12, 0x6f, 0x0a, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x36, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64,
+	0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x41, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18,
+	0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe4, 0xba, 0x86, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe7,
+	0x9a, 0x84, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x52, 0x07, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x12, 0x4a, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x27, 0xe5, 0xa3, 0xb0, 0xe6, 0x98,
+	0x8e, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96, 0xe5, 0x8d, 0xb8, 0xe8, 0xbd, 0xbd, 0xe6, 0x8f, 0x92,
+	0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe7, 0xba, 0xa6, 0xe6,
+	0x9d, 0x9f, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x42, 0x0a,
+	0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe5, 0xb0, 0x86, 0xe8, 0xa2, 0xab, 0xe7, 0xa7, 0xbb, 0xe9,
+	0x99, 0xa4, 0xe7, 0x9a, 0x84, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe7, 0xad, 0x96, 0xe7, 0x95,
+	0xa5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x22, 0x31, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x6b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
+	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92,
+	0xe4, 0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x22, 0xb2, 0x04, 0x0a, 0x0d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0x8f, 0x91, 0xe5,
+	0xb8, 0x83, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0x8f, 0xb7, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x91, 0xe5,
+	0xb8, 0x83, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2a, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5,
+	0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x0d,
+	0x63, 0x68, 0x61, 0x72, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85,
+	0xe5, 0x8c, 0x85, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0xba, 0x94, 0xe7, 0x94, 0xa8, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52,
+	0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x8f, 0x8f,
+	0xe8, 0xbf, 0xb0, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3e, 0x0a, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x32, 0x0c, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x10,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x50, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x33, 0xe6, 0x9c, 0x80, 0xe7, 0xbb, 0x88, 0xe7, 0x94, 0x9f,
+	0xe6, 0x95, 0x88, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0x28, 0x59, 0x41, 0x4d,
+	0x4c, 0x29, 0xef, 0xbc, 0x8c, 0xe6, 0x95, 0x8f, 0xe6, 0x84, 0x9f, 0xe9, 0x85, 0x8d, 0xe7, 0xbd,
+	0xae, 0xe5, 0xb7, 0xb2, 0xe9, 0x9a, 0x90, 0xe8, 0x97, 0x8f, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0xb8, 0xb2, 0xe6, 0x9f,
+	0x93, 0xe5, 0x90, 0x8e, 0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe6, 0xb8, 0x85,
+	0xe5, 0x8d, 0x95, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe8, 0xaf, 0xb4, 0xe6, 0x98, 0x8e, 0x52,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x7e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe7,
+	0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0x8f, 0xb7, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe4, 0xb8, 0xba,
+	0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72,
+	0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x8f,
+	0x91, 0xe5, 0xb8, 0x83, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x94, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76,
+	0x0a, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x42, 0x2c,
+	0x92, 0x41, 0x29, 0x32, 0x27, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x8f, 0x91, 0xe5, 0xb8,
+	0x83, 0xe5, 0x8e, 0x86, 0xe5, 0x8f, 0xb2, 0xef, 0xbc, 0x8c, 0xe6, 0x8c, 0x89, 0xe7, 0x89, 0x88,
+	0xe6, 0x9c, 0xac, 0xe5, 0x8f, 0xb7, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x08, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x22, 0xd1, 0x02, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b,
+	0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61, 0x67,
+	0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf,
+	0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8e, 0x92,
+	0xe5, 0xba, 0x8f, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x12, 0x36, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x0c, 0x69, 0x73,
+	0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x09, 0x6b, 0x65,
+	0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92,
+	0x41, 0x11, 0x32, 0x0f, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe5, 0x85, 0xb3, 0xe9, 0x94, 0xae,
+	0xe5, 0xad, 0x97, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x4b, 0x0a,
+	0x12, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18,
+	0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe6, 0x98, 0xbe, 0xe7, 0xa4, 0xba, 0xe5, 0x85, 0xa8, 0xe9,
+	0x83, 0xa8, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x41, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0xaa, 0x02, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06,
+	0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12,
+	0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6,
+	0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x5e, 0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
+	0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x42, 0x72, 0x69, 0x65, 0x66,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x88, 0x97,
+	0xe8, 0xa1, 0xa8, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8,
+	0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x09, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x75, 0x6d, 0x22, 0x84, 0x02, 0x0a, 0x13, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x6b,
+	0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e,
+	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe6, 0x95,
+	0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0x61, 0x0a, 0x0f, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25,
+	0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52,
+	0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1,
+	0x95, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x98,
+	0x01, 0x0a, 0x15, 0x54, 0x4d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x08, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0xa7,
+	0x9f, 0xe6, 0x88, 0xb7, 0x49, 0x44, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x27, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe6, 0x95, 0xb0, 0xe6,
+	0x8d, 0xae, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x5e, 0x0a, 0x14, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x27, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe6, 0x95, 0xb0, 0xe6,
+	0x8d, 0xae, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x99, 0x01, 0x0a, 0x16, 0x54, 0x4d,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f,
+	0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x08, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7,
+	0x49, 0x44, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe9, 0x80, 0x89, 0xe6,
+	0x8b, 0xa9, 0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0x52, 0x03,
+	0x61, 0x6c, 0x6c, 0x12, 0x45, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0xe7, 0xa7, 0x9f,
+	0xe6, 0x88, 0xb7, 0x49, 0x44, 0xe3, 0x80, 0x81, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xe6, 0x88,
+	0x96, 0xe5, 0xa4, 0x87, 0xe6, 0xb3, 0xa8, 0xe5, 0x85, 0xb3, 0xe9, 0x94, 0xae, 0xe5, 0xad, 0x97,
+	0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xb3, 0x04, 0x0a, 0x12, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2d, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0x49, 0x44,
+	0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x79, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64,
+	0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x42, 0x2e, 0x92, 0x41, 0x2b, 0x32, 0x29, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe9, 0x80, 0x89,
+	0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8e, 0xe7, 0xa7, 0x9f, 0xe6,
+	0x88, 0xb7, 0x49, 0x44, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe5, 0x90, 0x88, 0xe5, 0xb9, 0xb6,
+	0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x25, 0x92, 0x41, 0x22, 0x32, 0x20, 0xe5, 0xb9, 0xb6, 0xe5, 0x8f, 0x91, 0xe6, 0x95, 0xb0, 0xef,
+	0xbc, 0x8c, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x20, 0x38, 0xef, 0xbc, 0x8c, 0xe6, 0x9c, 0x80,
+	0xe5, 0xa4, 0xa7, 0x20, 0x33, 0x32, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe6,
+	0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12, 0x8c, 0x01, 0x0a,
+	0x12, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5e, 0x92, 0x41, 0x5b, 0x32, 0x59,
+	0xe9, 0x87, 0x8d, 0xe8, 0xaf, 0x95, 0xe8, 0xaf, 0xa5, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe6,
+	0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe4, 0xb8, 0xad, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe7, 0x9a,
+	0x84, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xef, 0xbc, 0x8c, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae,
+	0xe5, 0x90, 0x8e, 0xe5, 0xbf, 0xbd, 0xe7, 0x95, 0xa5, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0x49,
+	0x44, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe5, 0x92, 0x8c, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7,
+	0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x79,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x53, 0x0a, 0x05, 0x61,
+	0x73, 0x79, 0x6e, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32,
+	0x38, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xbc, 0x82, 0xe6, 0xad, 0xa5, 0xe6, 0x89, 0xa7,
+	0xe8, 0xa1, 0x8c, 0xef, 0xbc, 0x8c, 0xe5, 0xbc, 0x82, 0xe6, 0xad, 0xa5, 0xe6, 0x97, 0xb6, 0xe9,
+	0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x49, 0x44, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63,
+	0x22, 0xb7, 0x02, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x49, 0x44, 0x52, 0x0b, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x88, 0x90,
+	0xe5, 0x8a, 0x9f, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe6, 0x95, 0xb0, 0x52, 0x09, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xa4,
+	0xb1, 0xe8, 0xb4, 0xa5, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe6, 0x95, 0xb0, 0x52, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x63, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12,
+	0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe7, 0xbb, 0x93, 0xe6,
+	0x9e, 0x9c, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xab, 0x02, 0x0a, 0x19, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32,
+	0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d,
+	0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5,
+	0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe5,
+	0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x36,
+	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xaf, 0xe5,
+	0x90, 0xa6, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f,
+	0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe5, 0x85, 0xb3, 0xe9, 0x94, 0xae, 0xe5, 0xad, 0x97, 0x52,
+	0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4,
+	0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x84, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x80, 0xbb,
+	0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61,
+	0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6,
+	0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x68, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
+	0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe5, 0x90, 0xaf, 0xe7, 0x94,
+	0xa8, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7,
+	0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x22,
+	0x2f, 0x0a, 0x1d, 0x54, 0x4d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x29, 0x0a, 0x17, 0x54, 0x4d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x83, 0x02, 0x0a, 0x0e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe9, 0x98, 0xb6, 0xe6,
+	0xae, 0xb5, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe9, 0x98, 0xb6, 0xe6, 0xae, 0xb5, 0xe4, 0xbf,
+	0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a,
+	0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xbc, 0x80,
+	0xe5, 0xa7, 0x8b, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a, 0x0d, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x96, 0x04, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7,
+	0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x08, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x93,
+	0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c,
+	0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x58, 0x0a, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe9, 0x98, 0xb6, 0xe6, 0xae,
+	0xb5, 0x52, 0x06, 0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x10, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba,
+	0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3c, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x63, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12,
+	0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe6, 0x8a, 0xa5, 0xe5,
+	0x91, 0x8a, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x13, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x23, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x8a, 0xa8, 0xe4, 0xbd, 0x9c, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x20, 0xe5, 0x8a,
+	0xa8, 0xe4, 0xbd, 0x9c, 0xe5, 0xaf, 0xb9, 0xe8, 0xb1, 0xa1, 0xef, 0xbc, 0x8c, 0xe7, 0xa7, 0x9f,
+	0xe6, 0x88, 0xb7, 0xe6, 0x88, 0x96, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8a, 0xa8,
+	0xe4, 0xbd, 0x9c, 0xe8, 0xaf, 0xa6, 0xe6, 0x83, 0x85, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8a, 0xa8, 0xe4, 0xbd, 0x9c, 0xe7, 0x8a,
+	0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8a, 0xa8, 0xe4, 0xbd, 0x9c, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0x8a, 0xa8, 0xe4, 0xbd, 0x9c, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x8e, 0x01, 0x0a, 0x1d, 0x53,
+	0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6,
+	0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4e, 0x0a, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x33,
+	0x32, 0x31, 0xe5, 0x8d, 0x87, 0xe7, 0xba, 0xa7, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x5b, 0x6d,
+	0x61, 0x6e, 0x75, 0x61, 0x6c, 0x2c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2c, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x2d, 0x61, 0x75, 0x74, 0x6f, 0x2c, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x2d, 0x61, 0x75,
+	0x74, 0x6f, 0x5d, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x78, 0x0a, 0x1e, 0x53,
+	0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x5d, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe7, 0xab, 0x8b,
+	0xe5, 0x8d, 0xb3, 0xe9, 0x87, 0x8d, 0xe6, 0x96, 0xb0, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe4,
+	0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95, 0x52, 0x07, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x22, 0xf2, 0x03, 0x0a, 0x10, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4,
+	0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93,
+	0xe5, 0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12,
+	0x32, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5,
+	0x8c, 0x85, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52,
+	0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x44, 0x0a, 0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe4, 0xbb,
+	0x93, 0xe5, 0xba, 0x93, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x80, 0xe6, 0x96, 0xb0,
+	0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8d, 0x87, 0xe7,
+	0xba, 0xa7, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x6d, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0x92, 0x41, 0x47, 0x32, 0x45, 0xe5, 0x8d, 0x87,
+	0xe7, 0xba, 0xa7, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe5, 0x85, 0x81, 0xe8, 0xae, 0xb8, 0xe8,
+	0x87, 0xaa, 0xe5, 0x8a, 0xa8, 0xe5, 0x8d, 0x87, 0xe7, 0xba, 0xa7, 0xe5, 0x88, 0xb0, 0xe7, 0x9a,
+	0x84, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba,
+	0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8d, 0xe8, 0x87, 0xaa, 0xe5, 0x8a, 0xa8, 0xe5, 0x8d, 0x87, 0xe7,
+	0xba, 0xa7, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x48, 0x0a, 0x10, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18,
+	0xe5, 0x8f, 0x91, 0xe7, 0x8e, 0xb0, 0xe6, 0x96, 0xb0, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe7,
+	0x9a, 0x84, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb9, 0x02, 0x0a, 0x1d, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x08, 0x75,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x42, 0x17,
+	0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0x8f, 0xaf, 0xe7, 0x94, 0xa8, 0xe5, 0x8d, 0x87, 0xe7, 0xba,
+	0xa7, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x08, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x12, 0x44, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0x92, 0x41,
+	0x14, 0x32, 0x12, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x10, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x6c, 0x0a, 0x12, 0x6d, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x38, 0xe8, 0x87, 0xaa, 0xe5, 0x8a, 0xa8,
+	0xe5, 0x8d, 0x87, 0xe7, 0xba, 0xa7, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe7, 0xaa, 0x97, 0xe5,
+	0x8f, 0xa3, 0x28, 0x55, 0x54, 0x43, 0x29, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba,
+	0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8d, 0xe8, 0x87, 0xaa, 0xe5, 0x8a, 0xa8, 0xe5, 0x8d, 0x87, 0xe7,
+	0xba, 0xa7, 0x52, 0x11, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xfc, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x98, 0xaf, 0xe5,
+	0x90, 0xa6, 0xe5, 0xbc, 0x80, 0xe5, 0x90, 0xaf, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe6, 0xa8,
+	0xa1, 0xe5, 0xbc, 0x8f, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2b, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe4, 0xbf, 0xa1, 0xe6, 0x81,
+	0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2e, 0xe9, 0xa2, 0x84, 0xe8, 0xae, 0xa1, 0xe7, 0xbb,
+	0xb4, 0xe6, 0x8a, 0xa4, 0xe7, 0xbb, 0x93, 0xe6, 0x9d, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0x28, 0xe7, 0xa7, 0x92, 0x29, 0xef, 0xbc, 0x8c, 0x30, 0x20, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba,
+	0xe6, 0x9c, 0xaa, 0xe7, 0x9f, 0xa5, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x22, 0x76, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
+	0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf,
+	0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0xd3, 0x01, 0x0a,
+	0x1e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3d,
+	0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x88, 0xb0, 0xe5,
+	0x80, 0x99, 0xe9, 0x80, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe7, 0x9a, 0x84, 0xe7, 0xa7,
+	0x9f, 0xe6, 0x88, 0xb7, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x53, 0x0a,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x39,
+	0x92, 0x41, 0x36, 0x32, 0x34, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe5, 0x88, 0xb0, 0xe5, 0x80,
+	0x99, 0xe9, 0x80, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe7, 0x9a, 0x84, 0xe5, 0x85, 0xb6,
+	0xe4, 0xbb, 0x96, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe7, 0x99, 0xbe, 0xe5, 0x88, 0x86, 0xe6,
+	0xaf, 0x94, 0x5b, 0x30, 0x2c, 0x31, 0x30, 0x30, 0x5d, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x22, 0x79, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4,
+	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x3e, 0x0a,
+	0x1d, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
+	0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x78, 0x0a,
+	0x1e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52,
+	0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x3c, 0x0a, 0x1b, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x22, 0x76, 0x0a, 0x1c, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4,
+	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x06, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x22, 0x86, 0x02,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x92, 0x41, 0x24, 0x32, 0x22, 0xe8, 0xbe,
+	0x93, 0xe5, 0x87, 0xba, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0x3a, 0x20, 0x6a, 0x73, 0x6f, 0x6e,
+	0x28, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x29, 0x20, 0xe6, 0x88, 0x96, 0x20, 0x64, 0x6f, 0x74,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x23,
+	0x32, 0x21, 0xe5, 0x8f, 0xaa, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe8, 0xaf, 0xa5, 0xe7, 0xa7,
+	0x9f, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe7, 0x9a, 0x84, 0xe6, 0x8f, 0x92,
+	0xe4, 0xbb, 0xb6, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x67, 0x0a,
+	0x09, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x4a, 0x92, 0x41, 0x47, 0x32, 0x45, 0xe5, 0x8f, 0xaa, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab,
+	0xe8, 0xaf, 0xa5, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe3, 0x80, 0x81, 0xe5, 0x85, 0xb6, 0xe7,
+	0x9b, 0xb4, 0xe6, 0x8e, 0xa5, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96, 0xe4, 0xbb, 0xa5, 0xe5, 0x8f,
+	0x8a, 0xe5, 0x8f, 0x97, 0xe5, 0x85, 0xb6, 0xe7, 0xa7, 0xbb, 0xe9, 0x99, 0xa4, 0xe5, 0xbd, 0xb1,
+	0xe5, 0x93, 0x8d, 0xe7, 0x9a, 0x84, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x52, 0x08, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xc7, 0x01, 0x0a, 0x0f, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92,
+	0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8f, 0x92,
+	0xe4, 0xbb, 0xb6, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x3d, 0x0a, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe8,
+	0xaf, 0xa5, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0xe7, 0xa7, 0x9f, 0xe6, 0x88,
+	0xb7, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x22, 0xab, 0x02, 0x0a, 0x0f, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x45, 0x64, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96, 0xe6,
+	0x96, 0xb9, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x26, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x92, 0x41,
+	0x13, 0x32, 0x11, 0xe8, 0xa2, 0xab, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96, 0xe6, 0x8f, 0x92, 0xe4,
+	0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x7f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6b, 0x92, 0x41, 0x68, 0x32, 0x66, 0xe5, 0x85, 0xb3,
+	0xe7, 0xb3, 0xbb, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x3a, 0x20, 0x64, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x28, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96, 0x29, 0x2c, 0x20, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0xe5, 0xae,
+	0x9e, 0xe7, 0x8e, 0xb0, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe7, 0x82, 0xb9, 0x29, 0x2c, 0x20,
+	0x61, 0x64, 0x64, 0x6f, 0x6e, 0x73, 0x28, 0xe5, 0xb7, 0xb2, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c,
+	0xe7, 0x9a, 0x84, 0xe6, 0x89, 0xa9, 0xe5, 0xb1, 0x95, 0xe7, 0x82, 0xb9, 0xe8, 0xb7, 0xaf, 0xe7,
+	0x94, 0xb1, 0x29, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0x92, 0x41, 0x28, 0x32, 0x26,
+	0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0xe8, 0xaf, 0xa6, 0xe6, 0x83, 0x85, 0x3a, 0x20, 0xe7, 0x89,
+	0x88, 0xe6, 0x9c, 0xac, 0xe7, 0xba, 0xa6, 0xe6, 0x9d, 0x9f, 0xe6, 0x88, 0x96, 0xe6, 0x89, 0xa9,
+	0xe5, 0xb1, 0x95, 0xe7, 0x82, 0xb9, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0xa8,
+	0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x05, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
+	0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6,
+	0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe8, 0x8a, 0x82, 0xe7, 0x82, 0xb9, 0xe5, 0x88, 0x97, 0xe8, 0xa1,
+	0xa8, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65,
+	0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x45, 0x64, 0x67, 0x65, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x8f,
+	0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8,
+	0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x03, 0x64, 0x6f, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x3e, 0x92, 0x41, 0x3b, 0x32, 0x39, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x76, 0x69, 0x7a, 0x20, 0x44, 0x4f, 0x54, 0x20, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe7, 0x9a,
+	0x84, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0xe5, 0x9b, 0xbe, 0x2c, 0x20, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x20, 0xe4, 0xb8, 0xba, 0x20, 0x64, 0x6f, 0x74, 0x20, 0xe6, 0x97, 0xb6, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0x52, 0x03, 0x64, 0x6f, 0x74, 0x22, 0xac, 0x02, 0x0a, 0x17, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x56, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3e, 0x92, 0x41, 0x3b, 0x32, 0x39, 0xe6, 0x98, 0xaf, 0xe5,
+	0x90, 0xa6, 0xe4, 0xbf, 0xae, 0xe5, 0xa4, 0x8d, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe5, 0x88,
+	0xb0, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0x8d, 0xe4, 0xb8, 0x80, 0xe8, 0x87, 0xb4, 0x2c, 0x20, 0xe4,
+	0xb8, 0xba, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x20, 0xe6, 0x97, 0xb6, 0xe5, 0x8f, 0xaa, 0xe6,
+	0x8a, 0xa5, 0xe5, 0x91, 0x8a, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x12, 0xb8, 0x01,
+	0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0xa1, 0x01,
+	0x92, 0x41, 0x9d, 0x01, 0x32, 0x9a, 0x01, 0xe4, 0xbf, 0xae, 0xe5, 0xa4, 0x8d, 0xe7, 0x9a, 0x84,
+	0xe4, 0xb8, 0x8d, 0xe4, 0xb8, 0x80, 0xe8, 0x87, 0xb4, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x2c,
+	0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97, 0xb6, 0xe4, 0xbf, 0xae, 0xe5, 0xa4, 0x8d,
+	0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x3a, 0x20, 0x6f, 0x72,
+	0x70, 0x68, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2c, 0x20, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2c, 0x20, 0x6f,
+	0x72, 0x70, 0x68, 0x61, 0x6e, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2c, 0x20, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2c, 0x20, 0x6f, 0x72, 0x70, 0x68,
+	0x61, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0x88, 0x02, 0x0a, 0x0b, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe4, 0xb8, 0x8d,
+	0xe4, 0xb8, 0x80, 0xe8, 0x87, 0xb4, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49,
+	0x44, 0xe6, 0x88, 0x96, 0x20, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x20, 0xe5, 0x90, 0x8d,
+	0xe7, 0xa7, 0xb0, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11,
+	0x32, 0x0f, 0xe4, 0xb8, 0x8d, 0xe4, 0xb8, 0x80, 0xe8, 0x87, 0xb4, 0xe8, 0xaf, 0xa6, 0xe6, 0x83,
+	0x85, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0x92, 0x41, 0x11,
+	0x32, 0x0f, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xb7, 0xb2, 0xe4, 0xbf, 0xae, 0xe5, 0xa4,
+	0x8d, 0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32,
+	0x1b, 0xe4, 0xbf, 0xae, 0xe5, 0xa4, 0x8d, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe7, 0x9a, 0x84,
+	0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xa5, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x58, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x66, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x42, 0x14, 0x92, 0x41,
+	0x11, 0x32, 0x0f, 0xe4, 0xb8, 0x8d, 0xe4, 0xb8, 0x80, 0xe8, 0x87, 0xb4, 0xe5, 0x88, 0x97, 0xe8,
+	0xa1, 0xa8, 0x52, 0x06, 0x64, 0x72, 0x69, 0x66, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x34, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x49, 0x44, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x77, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x09, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69,
+	0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xed, 0x01, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5,
+	0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9,
+	0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x09,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x08,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x93, 0x8d,
+	0xe4, 0xbd, 0x9c, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe7, 0x8a, 0xb6, 0xe6,
+	0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x80, 0xbb, 0xe6, 0x95,
+	0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x92,
+	0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65,
+	0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f,
+	0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x61, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65,
+	0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x93,
+	0x8d, 0xe4, 0xbd, 0x9c, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x36, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x27,
+	0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x01, 0x32, 0xb4, 0x45, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x12, 0xd4, 0x02, 0x0a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x12, 0x33, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
+	0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
+	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xd7, 0x01, 0x92, 0x41, 0xb3, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12,
+	0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x8e, 0xa5, 0xe5,
+	0x8f, 0xa3, 0x2a, 0x0d, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55, 0x43, 0x43,
+	0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x2a, 0x0a, 0x03, 0x34,
+	0x30, 0x34, 0x12, 0x23, 0x0a, 0x21, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59,
+	0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12, 0x10,
+	0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53,
+	0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a,
+	0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x0d, 0x2f, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xd4, 0x02, 0x0a, 0x0d, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x33, 0x2e, 0x69, 0x6f,
+	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x34, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd7, 0x01, 0x92, 0x41, 0xb3, 0x01, 0x0a, 0x06, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x8f, 0x92,
+	0xe4, 0xbb, 0xb6, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0d, 0x55, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
+	0x06, 0x0a, 0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12,
+	0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45,
+	0x4e, 0x54, 0x4a, 0x2a, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x23, 0x0a, 0x21, 0x52, 0x45, 0x50,
+	0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41,
+	0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17,
+	0x0a, 0x03, 0x34, 0x30, 0x39, 0x12, 0x10, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10,
+	0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x1a, 0x0d, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x12, 0xaf, 0x02, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x12, 0x34, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72,
+	0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
+	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xaf, 0x01, 0x92, 0x41, 0x8a, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12,
+	0x12, 0xe5, 0x9b, 0x9e, 0xe6, 0xbb, 0x9a, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x8e, 0xa5,
+	0xe5, 0x8f, 0xa3, 0x2a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55,
+	0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a,
+	0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a, 0x10, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12,
+	0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x12, 0xc4, 0x02, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x37, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
+	0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x38, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64,
+	0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbb, 0x01, 0x92, 0x41,
+	0x93, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0xe9, 0x87, 0x8d, 0xe6,
+	0x96, 0xb0, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x8e,
+	0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06,
+	0x0a, 0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a,
+	0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e,
+	0x54, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a, 0x10, 0x50, 0x4c, 0x55, 0x47,
+	0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03,
+	0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19,
+	0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0xca, 0x02, 0x0a, 0x12, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x38, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x69, 0x6f, 0x2e,
+	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbe, 0x01, 0x92, 0x41, 0x94, 0x01, 0x0a, 0x06, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0xe8, 0xbd, 0xae, 0xe6, 0x8d, 0xa2, 0xe6, 0x8f, 0x92, 0xe4,
+	0xbb, 0xb6, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x12,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55, 0x43,
+	0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a, 0x03,
+	0x34, 0x30, 0x34, 0x12, 0x12, 0x0a, 0x10, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10,
+	0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2f,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x12, 0xa5, 0x02, 0x0a, 0x0f, 0x55, 0x6e, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x35, 0x2e, 0x69, 0x6f, 0x2e,
+	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x36, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64,
+	0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2, 0x01, 0x92, 0x41, 0x89, 0x01,
+	0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12, 0xe5, 0x8d, 0xb8, 0xe8, 0xbd, 0xbd,
+	0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0f, 0x55, 0x6e,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47,
+	0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a, 0x10,
+	0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x2a,
+	0x0d, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8d,
+	0x02, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x2f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
+	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9c, 0x01, 0x92, 0x41, 0x83, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12,
+	0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x8e, 0xa5, 0xe5,
+	0x8f, 0xa3, 0x2a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47,
+	0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a, 0x10,
+	0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12,
+	0x0d, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xbd,
+	0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x12, 0x36, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72,
+	0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x69, 0x6f,
+	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x01, 0x92, 0x41, 0x96, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8f, 0x92, 0xe4, 0xbb,
+	0xb6, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0xe6, 0x8e, 0xa5,
+	0xe5, 0x8f, 0xa3, 0x2a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a,
+	0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a, 0x10, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12,
+	0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0xc6,
+	0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x38, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xba, 0x01, 0x92, 0x41, 0x98,
+	0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xe5, 0x8e, 0x86,
+	0xe5, 0x8f, 0xb2, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47,
+	0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a, 0x10,
+	0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12,
+	0x16, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0xf6, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x30, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
+	0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
+	0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x01, 0x92, 0x41,
+	0x6f, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f,
+	0x96, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe6, 0x8e, 0xa5,
+	0xe5, 0x8f, 0xa3, 0x2a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03,
+	0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41,
+	0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10,
+	0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x12, 0xf5, 0x01, 0x0a, 0x0c, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64,
+	0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x98, 0x01,
+	0x92, 0x41, 0x71, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0xe6, 0x8f, 0x92,
+	0xe4, 0xbb, 0xb6, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe6,
+	0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0c, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b,
+	0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35,
+	0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x05, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x22, 0x15, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0xf1, 0x01, 0x0a, 0x0d, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x2e, 0x69, 0x6f, 0x2e,
+	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x92, 0x01, 0x92, 0x41, 0x72, 0x0a, 0x06, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xa7, 0x9f,
+	0xe6, 0x88, 0xb7, 0xe5, 0x81, 0x9c, 0xe7, 0x94, 0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a,
+	0x0d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x0b,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34,
+	0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52,
+	0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a,
+	0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x17, 0x2a, 0x15, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0xa8, 0x02, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x38, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72,
+	0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e,
+	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x92, 0x41, 0x7c, 0x0a, 0x06,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x8f,
+	0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x91, 0xe5, 0xae, 0x9a, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7,
+	0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a,
+	0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e,
+	0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x12, 0x15, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x92, 0x02, 0x0a, 0x16, 0x54, 0x4d, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x79, 0x12, 0x3c, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75,
+	0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x4d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xa1, 0x01, 0x92, 0x41, 0x81, 0x01, 0x0a,
+	0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6,
+	0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x91, 0xe5, 0xae, 0x9a, 0xe7, 0xa7, 0x9f, 0xe6, 0x88,
+	0xb7, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x16, 0x54, 0x4d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03,
+	0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41,
+	0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10,
+	0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x74, 0x6d, 0x2f, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x12, 0xf9, 0x01, 0x0a,
+	0x10, 0x54, 0x4d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x12, 0x36, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64,
+	0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x4d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x94, 0x01, 0x92, 0x41, 0x75, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12,
+	0x18, 0xe6, 0x89, 0x8b, 0xe5, 0x8a, 0xa8, 0xe6, 0xb3, 0xa8, 0xe5, 0x86, 0x8c, 0xe6, 0x8f, 0x92,
+	0xe4, 0xbb, 0xb6, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x10, 0x54, 0x4d, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4a, 0x0b, 0x0a, 0x03, 0x32,
+	0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12,
+	0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x12, 0x14, 0x2f, 0x74, 0x6d, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x90, 0x02, 0x0a, 0x0e, 0x54, 0x4d, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x34, 0x2e, 0x69, 0x6f,
+	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x4d, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xaf, 0x01, 0x92, 0x41, 0x72, 0x0a,
+	0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7,
+	0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe5, 0x81, 0x9c, 0xe7, 0x94, 0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f,
+	0xa3, 0x2a, 0x0d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a,
+	0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12,
+	0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0x2b,
+	0x2f, 0x74, 0x6d, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x2f,
+	0x7b, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8b, 0x02, 0x0a, 0x0f,
+	0x54, 0x4d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x35, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x4d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xa8,
+	0x01, 0x92, 0x41, 0x72, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0xe6, 0x8f,
+	0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe5, 0x81, 0x9c, 0xe7, 0x94, 0xa8,
+	0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0d, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a,
+	0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x2a, 0x2b, 0x2f, 0x74,
+	0x6d, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa9, 0x02, 0x0a, 0x11, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x31, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75,
+	0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac, 0x01, 0x92, 0x41, 0x7c, 0x0a, 0x06, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x89, 0xb9, 0xe9,
+	0x87, 0x8f, 0xe7, 0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xe6, 0x8e,
+	0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04,
+	0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a,
+	0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01,
+	0x2a, 0x22, 0x22, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0xac, 0x02, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x31, 0x2e, 0x69,
+	0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xae, 0x01, 0x92, 0x41, 0x7d, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x12, 0x1e, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x89, 0xb9, 0xe9, 0x87, 0x8f, 0xe7,
+	0xa7, 0x9f, 0xe6, 0x88, 0xb7, 0xe5, 0x81, 0x9c, 0xe7, 0x94, 0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f,
+	0xa3, 0x2a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02,
+	0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a,
+	0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22,
+	0x23, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0xdf, 0x02, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x3c, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e,
+	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc7, 0x01, 0x92,
+	0x41, 0x9c, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe8, 0xae, 0xbe,
+	0xe7, 0xbd, 0xae, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x8d, 0x87, 0xe7, 0xba, 0xa7, 0xe7,
+	0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x16, 0x53, 0x65, 0x74,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b,
+	0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a, 0x03, 0x34,
+	0x30, 0x34, 0x12, 0x12, 0x0a, 0x10, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a,
+	0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x1a, 0x1c, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x8d, 0x02, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x12, 0x3b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e,
+	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x92, 0x41, 0x65,
+	0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2,
+	0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x8f, 0xaf, 0xe7, 0x94, 0xa8, 0xe5, 0x8d, 0x87, 0xe7,
+	0xba, 0xa7, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x17, 0x0a, 0x03,
+	0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x75, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0xd4, 0x02, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x3a, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x69, 0x6f,
+	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc2, 0x01, 0x92, 0x41, 0x9a, 0x01, 0x0a,
+	0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe6,
+	0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe6, 0xa8, 0xa1, 0xe5, 0xbc,
+	0x8f, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x14, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47,
+	0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a, 0x10,
+	0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a,
+	0x01, 0x2a, 0x1a, 0x19, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0xea, 0x02,
+	0x0a, 0x17, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x3d, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
+	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
+	0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcf, 0x01, 0x92, 0x41, 0xa3, 0x01, 0x0a,
+	0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x24, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe6,
+	0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5, 0x80, 0x99, 0xe9, 0x80, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c,
+	0xac, 0xe8, 0xb7, 0xaf, 0xe7, 0x94, 0xb1, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x17, 0x53,
+	0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a,
+	0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19,
+	0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a, 0x10, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30,
+	0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x1a, 0x1d, 0x2f, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0xe5, 0x02, 0x0a, 0x16, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72,
+	0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xcd, 0x01, 0x92, 0x41, 0x9f, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x12, 0x21, 0xe5, 0x88, 0x87, 0xe6, 0x8d, 0xa2, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe5,
+	0x88, 0xb0, 0xe5, 0x80, 0x99, 0xe9, 0x80, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe6, 0x8e,
+	0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x16, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x0b, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30,
+	0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55,
+	0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a, 0x10, 0x50,
+	0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a,
+	0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01,
+	0x2a, 0x22, 0x1f, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x65, 0x12, 0xd8, 0x02, 0x0a, 0x14, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x2e, 0x69, 0x6f,
+	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x62, 0x6f, 0x72,
+	0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65,
+	0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc6, 0x01, 0x92, 0x41, 0x9a, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe6, 0x94, 0xbe, 0xe5, 0xbc, 0x83, 0xe6, 0x8f, 0x92, 0xe4, 0xbb,
+	0xb6, 0xe5, 0x80, 0x99, 0xe9, 0x80, 0x89, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe6, 0x8e, 0xa5,
+	0xe5, 0x8f, 0xa3, 0x2a, 0x14, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a,
+	0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e,
+	0x54, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x12, 0x0a, 0x10, 0x50, 0x4c, 0x55, 0x47,
+	0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03,
+	0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d,
+	0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x12, 0xa9, 0x02,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x12, 0x34, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
+	0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa9, 0x01,
+	0x92, 0x41, 0x97, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x21, 0xe6, 0x9f,
+	0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe4, 0xbe, 0x9d, 0xe8, 0xb5, 0x96,
+	0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb, 0xe5, 0x9b, 0xbe, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a,
+	0x0e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4a,
+	0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03,
+	0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41,
+	0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x12,
+	0x0a, 0x10, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x08, 0x12, 0x06, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x12, 0xb7, 0x02, 0x0a, 0x10, 0x52, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x36,
+	0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
+	0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xb1, 0x01, 0x92, 0x41, 0x98, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x3b,
+	0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe5, 0xb9, 0xb6, 0xe4, 0xbf, 0xae, 0xe5, 0xa4, 0x8d, 0x20,
+	0x48, 0x65, 0x6c, 0x6d, 0x20, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x20, 0xe4, 0xb8, 0x8e,
+	0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xe4, 0xb8, 0x8d, 0xe4,
+	0xb8, 0x80, 0xe8, 0x87, 0xb4, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x10, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x4a, 0x0b, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47,
+	0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x12, 0x86, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
+	0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
+	0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8c, 0x01,
+	0x92, 0x41, 0x71, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x18, 0xe6, 0x9f, 0xa5,
+	0xe8, 0xaf, 0xa2, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe6,
+	0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b,
+	0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35,
+	0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xf3, 0x01, 0x0a,
+	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x34, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74, 0x92, 0x41,
+	0x5e, 0x0a, 0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf,
+	0xa2, 0xe6, 0x8f, 0x92, 0xe4, 0xbb, 0xb6, 0xe6, 0x93, 0x8d, 0xe4, 0xbd, 0x9c, 0xe5, 0x88, 0x97,
+	0xe8, 0xa1, 0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x0b, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x04, 0x0a, 0x02, 0x4f, 0x4b, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a,
+	0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x7a, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
+	0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x69, 0x6f, 0x2e,
+	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x4d,
+	0x0a, 0x1d, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x72, 0x75, 0x64, 0x64, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x50,
+	0x01, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b,
+	0x65, 0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_plugin_v1_plugin_proto_rawDescOnce sync.Once
+	file_api_plugin_v1_plugin_proto_rawDescData = file_api_plugin_v1_plugin_proto_rawDesc
+)
+
+func file_api_plugin_v1_plugin_proto_rawDescGZIP() []byte {
+	file_api_plugin_v1_plugin_proto_rawDescOnce.Do(func() {
+		file_api_plugin_v1_plugin_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_plugin_v1_plugin_proto_rawDescData)
+	})
+	return file_api_plugin_v1_plugin_proto_rawDescData
+}
+
+var file_api_plugin_v1_plugin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_plugin_v1_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 75)
+var file_api_plugin_v1_plugin_proto_goTypes = []interface{}{
+	(ConfigurationType)(0),                      // 0: io.tkeel.rudder.api.plugin.v1.ConfigurationType
+	(*RegisterAddons)(nil),                      // 1: io.tkeel.rudder.api.plugin.v1.RegisterAddons
+	(*Installer)(nil),                           // 2: io.tkeel.rudder.api.plugin.v1.Installer
+	(*EnabledTenant)(nil),                       // 3: io.tkeel.rudder.api.plugin.v1.EnabledTenant
+	(*PluginBrief)(nil),                         // 4: io.tkeel.rudder.api.plugin.v1.PluginBrief
+	(*HealthTransition)(nil),                    // 5: io.tkeel.rudder.api.plugin.v1.HealthTransition
+	(*PluginHealth)(nil),                        // 6: io.tkeel.rudder.api.plugin.v1.PluginHealth
+	(*PluginObject)(nil),                        // 7: io.tkeel.rudder.api.plugin.v1.PluginObject
+	(*PluginCandidate)(nil),                     // 8: io.tkeel.rudder.api.plugin.v1.PluginCandidate
+	(*PluginMaintenance)(nil),                   // 9: io.tkeel.rudder.api.plugin.v1.PluginMaintenance
+	(*DryRunResult)(nil),                        // 10: io.tkeel.rudder.api.plugin.v1.DryRunResult
+	(*InstallPluginRequest)(nil),                // 11: io.tkeel.rudder.api.plugin.v1.InstallPluginRequest
+	(*InstallPlanItem)(nil),                     // 12: io.tkeel.rudder.api.plugin.v1.InstallPlanItem
+	(*InstallPluginResponse)(nil),               // 13: io.tkeel.rudder.api.plugin.v1.InstallPluginResponse
+	(*UpgradePluginRequest)(nil),                // 14: io.tkeel.rudder.api.plugin.v1.UpgradePluginRequest
+	(*UpgradePluginResponse)(nil),               // 15: io.tkeel.rudder.api.plugin.v1.UpgradePluginResponse
+	(*RollbackPluginRequest)(nil),               // 16: io.tkeel.rudder.api.plugin.v1.RollbackPluginRequest
+	(*RollbackPluginResponse)(nil),              // 17: io.tkeel.rudder.api.plugin.v1.RollbackPluginResponse
+	(*ReconfigurePluginRequest)(nil),            // 18: io.tkeel.rudder.api.plugin.v1.ReconfigurePluginRequest
+	(*ReconfigurePluginResponse)(nil),           // 19: io.tkeel.rudder.api.plugin.v1.ReconfigurePluginResponse
+	(*RotatePluginSecretRequest)(nil),           // 20: io.tkeel.rudder.api.plugin.v1.RotatePluginSecretRequest
+	(*RotatePluginSecretResponse)(nil),          // 21: io.tkeel.rudder.api.plugin.v1.RotatePluginSecretResponse
+	(*UninstallPluginRequest)(nil),              // 22: io.tkeel.rudder.api.plugin.v1.UninstallPluginRequest
+	(*UninstallPluginResponse)(nil),             // 23: io.tkeel.rudder.api.plugin.v1.UninstallPluginResponse
+	(*UninstallAffectedPlugin)(nil),             // 24: io.tkeel.rudder.api.plugin.v1.UninstallAffectedPlugin
+	(*UninstallPreview)(nil),                    // 25: io.tkeel.rudder.api.plugin.v1.UninstallPreview
+	(*GetPluginRequest)(nil),                    // 26: io.tkeel.rudder.api.plugin.v1.GetPluginRequest
+	(*GetPluginResponse)(nil),                   // 27: io.tkeel.rudder.api.plugin.v1.GetPluginResponse
+	(*PluginRelease)(nil),                       // 28: io.tkeel.rudder.api.plugin.v1.PluginRelease
+	(*GetPluginReleaseRequest)(nil),             // 29: io.tkeel.rudder.api.plugin.v1.GetPluginReleaseRequest
+	(*GetPluginReleaseResponse)(nil),            // 30: io.tkeel.rudder.api.plugin.v1.GetPluginReleaseResponse
+	(*ListPluginReleasesRequest)(nil),           // 31: io.tkeel.rudder.api.plugin.v1.ListPluginReleasesRequest
+	(*ListPluginReleasesResponse)(nil),          // 32: io.tkeel.rudder.api.plugin.v1.ListPluginReleasesResponse
+	(*ListPluginRequest)(nil),                   // 33: io.tkeel.rudder.api.plugin.v1.ListPluginRequest
+	(*ListPluginResponse)(nil),                  // 34: io.tkeel.rudder.api.plugin.v1.ListPluginResponse
+	(*TenantEnableRequest)(nil),                 // 35: io.tkeel.rudder.api.plugin.v1.TenantEnableRequest
+	(*TMTenantEnableRequest)(nil),               // 36: io.tkeel.rudder.api.plugin.v1.TMTenantEnableRequest
+	(*TenantDisableRequest)(nil),                // 37: io.tkeel.rudder.api.plugin.v1.TenantDisableRequest
+	(*TMTenantDisableRequest)(nil),              // 38: io.tkeel.rudder.api.plugin.v1.TMTenantDisableRequest
+	(*TenantSelector)(nil),                      // 39: io.tkeel.rudder.api.plugin.v1.TenantSelector
+	(*BatchTenantRequest)(nil),                  // 40: io.tkeel.rudder.api.plugin.v1.BatchTenantRequest
+	(*BatchTenantResponse)(nil),                 // 41: io.tkeel.rudder.api.plugin.v1.BatchTenantResponse
+	(*ListEnabledTenantsRequest)(nil),           // 42: io.tkeel.rudder.api.plugin.v1.ListEnabledTenantsRequest
+	(*ListEnabledTenantsResponse)(nil),          // 43: io.tkeel.rudder.api.plugin.v1.ListEnabledTenantsResponse
+	(*TMUpdatePluginIdentifyRequest)(nil),       // 44: io.tkeel.rudder.api.plugin.v1.TMUpdatePluginIdentifyRequest
+	(*TMRegisterPluginRequest)(nil),             // 45: io.tkeel.rudder.api.plugin.v1.TMRegisterPluginRequest
+	(*OperationPhase)(nil),                      // 46: io.tkeel.rudder.api.plugin.v1.OperationPhase
+	(*OperationObject)(nil),                     // 47: io.tkeel.rudder.api.plugin.v1.OperationObject
+	(*OperationReportItem)(nil),                 // 48: io.tkeel.rudder.api.plugin.v1.OperationReportItem
+	(*SetPluginUpgradePolicyRequest)(nil),       // 49: io.tkeel.rudder.api.plugin.v1.SetPluginUpgradePolicyRequest
+	(*SetPluginUpgradePolicyResponse)(nil),      // 50: io.tkeel.rudder.api.plugin.v1.SetPluginUpgradePolicyResponse
+	(*ListAvailableUpgradesRequest)(nil),        // 51: io.tkeel.rudder.api.plugin.v1.ListAvailableUpgradesRequest
+	(*AvailableUpgrade)(nil),                    // 52: io.tkeel.rudder.api.plugin.v1.AvailableUpgrade
+	(*ListAvailableUpgradesResponse)(nil),       // 53: io.tkeel.rudder.api.plugin.v1.ListAvailableUpgradesResponse
+	(*SetPluginMaintenanceRequest)(nil),         // 54: io.tkeel.rudder.api.plugin.v1.SetPluginMaintenanceRequest
+	(*SetPluginMaintenanceResponse)(nil),        // 55: io.tkeel.rudder.api.plugin.v1.SetPluginMaintenanceResponse
+	(*SetPluginCandidateRouteRequest)(nil),      // 56: io.tkeel.rudder.api.plugin.v1.SetPluginCandidateRouteRequest
+	(*SetPluginCandidateRouteResponse)(nil),     // 57: io.tkeel.rudder.api.plugin.v1.SetPluginCandidateRouteResponse
+	(*PromotePluginCandidateRequest)(nil),       // 58: io.tkeel.rudder.api.plugin.v1.PromotePluginCandidateRequest
+	(*PromotePluginCandidateResponse)(nil),      // 59: io.tkeel.rudder.api.plugin.v1.PromotePluginCandidateResponse
+	(*AbortPluginCandidateRequest)(nil),         // 60: io.tkeel.rudder.api.plugin.v1.AbortPluginCandidateRequest
+	(*AbortPluginCandidateResponse)(nil),        // 61: io.tkeel.rudder.api.plugin.v1.AbortPluginCandidateResponse
+	(*GetPluginGraphRequest)(nil),               // 62: io.tkeel.rudder.api.plugin.v1.GetPluginGraphRequest
+	(*PluginGraphNode)(nil),                     // 63: io.tkeel.rudder.api.plugin.v1.PluginGraphNode
+	(*PluginGraphEdge)(nil),                     // 64: io.tkeel.rudder.api.plugin.v1.PluginGraphEdge
+	(*GetPluginGraphResponse)(nil),              // 65: io.tkeel.rudder.api.plugin.v1.GetPluginGraphResponse
+	(*ReconcilePluginsRequest)(nil),             // 66: io.tkeel.rudder.api.plugin.v1.ReconcilePluginsRequest
+	(*PluginDrift)(nil),                         // 67: io.tkeel.rudder.api.plugin.v1.PluginDrift
+	(*ReconcilePluginsResponse)(nil),            // 68: io.tkeel.rudder.api.plugin.v1.ReconcilePluginsResponse
+	(*GetOperationRequest)(nil),                 // 69: io.tkeel.rudder.api.plugin.v1.GetOperationRequest
+	(*GetOperationResponse)(nil),                // 70: io.tkeel.rudder.api.plugin.v1.GetOperationResponse
+	(*ListOperationsRequest)(nil),               // 71: io.tkeel.rudder.api.plugin.v1.ListOperationsRequest
+	(*ListOperationsResponse)(nil),              // 72: io.tkeel.rudder.api.plugin.v1.ListOperationsResponse
+	(*WatchOperationRequest)(nil),               // 73: io.tkeel.rudder.api.plugin.v1.WatchOperationRequest
+	(*InstallerMaintainer)(nil),                 // 74: io.tkeel.rudder.api.plugin.v1.Installer.maintainer
+	(*TenantEnableRequest_EnableExtraData)(nil), // 75: io.tkeel.rudder.api.plugin.v1.TenantEnableRequest.EnableExtraData
+	(v1.PluginStatus)(0),                        // 76: openapi.v1.PluginStatus
+	(*v1.AddonsPoint)(nil),                      // 77: openapi.v1.AddonsPoint
+	(*v1.ImplementedPlugin)(nil),                // 78: openapi.v1.ImplementedPlugin
+	(*v1.ConsoleEntry)(nil),                     // 79: openapi.v1.ConsoleEntry
+	(*emptypb.Empty)(nil),                       // 80: google.protobuf.Empty
+}
+var file_api_plugin_v1_plugin_proto_depIdxs = []int32{
+	0,  // 0: io.tkeel.rudder.api.plugin.v1.Installer.type:type_name -> io.tkeel.rudder.api.plugin.v1.ConfigurationType
+	74, // 1: io.tkeel.rudder.api.plugin.v1.Installer.maintainers:type_name -> io.tkeel.rudder.api.plugin.v1.Installer.maintainer
+	2,  // 2: io.tkeel.rudder.api.plugin.v1.PluginBrief.installer_brief:type_name -> io.tkeel.rudder.api.plugin.v1.Installer
+	76, // 3: io.tkeel.rudder.api.plugin.v1.PluginBrief.status:type_name -> openapi.v1.PluginStatus
+	5,  // 4: io.tkeel.rudder.api.plugin.v1.PluginHealth.transitions:type_name -> io.tkeel.rudder.api.plugin.v1.HealthTransition
+	4,  // 5: io.tkeel.rudder.api.plugin.v1.PluginObject.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginBrief
+	77, // 6: io.tkeel.rudder.api.plugin.v1.PluginObject.addons_point:type_name -> openapi.v1.AddonsPoint
+	78, // 7: io.tkeel.rudder.api.plugin.v1.PluginObject.implemented_plugin:type_name -> openapi.v1.ImplementedPlugin
+	3,  // 8: io.tkeel.rudder.api.plugin.v1.PluginObject.enable_tenantes:type_name -> io.tkeel.rudder.api.plugin.v1.EnabledTenant
+	1,  // 9: io.tkeel.rudder.api.plugin.v1.PluginObject.register_addons:type_name -> io.tkeel.rudder.api.plugin.v1.RegisterAddons
+	79, // 10: io.tkeel.rudder.api.plugin.v1.PluginObject.console_entries:type_name -> openapi.v1.ConsoleEntry
+	6,  // 11: io.tkeel.rudder.api.plugin.v1.PluginObject.health:type_name -> io.tkeel.rudder.api.plugin.v1.PluginHealth
+	9,  // 12: io.tkeel.rudder.api.plugin.v1.PluginObject.maintenance:type_name -> io.tkeel.rudder.api.plugin.v1.PluginMaintenance
+	8,  // 13: io.tkeel.rudder.api.plugin.v1.PluginObject.candidate:type_name -> io.tkeel.rudder.api.plugin.v1.PluginCandidate
+	2,  // 14: io.tkeel.rudder.api.plugin.v1.InstallPluginRequest.installer:type_name -> io.tkeel.rudder.api.plugin.v1.Installer
+	7,  // 15: io.tkeel.rudder.api.plugin.v1.InstallPluginResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	10, // 16: io.tkeel.rudder.api.plugin.v1.InstallPluginResponse.dry_run:type_name -> io.tkeel.rudder.api.plugin.v1.DryRunResult
+	12, // 17: io.tkeel.rudder.api.plugin.v1.InstallPluginResponse.install_plan:type_name -> io.tkeel.rudder.api.plugin.v1.InstallPlanItem
+	2,  // 18: io.tkeel.rudder.api.plugin.v1.UpgradePluginRequest.installer:type_name -> io.tkeel.rudder.api.plugin.v1.Installer
+	7,  // 19: io.tkeel.rudder.api.plugin.v1.UpgradePluginResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	10, // 20: io.tkeel.rudder.api.plugin.v1.UpgradePluginResponse.dry_run:type_name -> io.tkeel.rudder.api.plugin.v1.DryRunResult
+	7,  // 21: io.tkeel.rudder.api.plugin.v1.RollbackPluginResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	0,  // 22: io.tkeel.rudder.api.plugin.v1.ReconfigurePluginRequest.type:type_name -> io.tkeel.rudder.api.plugin.v1.ConfigurationType
+	7,  // 23: io.tkeel.rudder.api.plugin.v1.ReconfigurePluginResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	7,  // 24: io.tkeel.rudder.api.plugin.v1.RotatePluginSecretResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	7,  // 25: io.tkeel.rudder.api.plugin.v1.UninstallPluginResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	25, // 26: io.tkeel.rudder.api.plugin.v1.UninstallPluginResponse.preview:type_name -> io.tkeel.rudder.api.plugin.v1.UninstallPreview
+	48, // 27: io.tkeel.rudder.api.plugin.v1.UninstallPluginResponse.report:type_name -> io.tkeel.rudder.api.plugin.v1.OperationReportItem
+	24, // 28: io.tkeel.rudder.api.plugin.v1.UninstallPreview.plugins:type_name -> io.tkeel.rudder.api.plugin.v1.UninstallAffectedPlugin
+	7,  // 29: io.tkeel.rudder.api.plugin.v1.GetPluginResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	28, // 30: io.tkeel.rudder.api.plugin.v1.GetPluginReleaseResponse.release:type_name -> io.tkeel.rudder.api.plugin.v1.PluginRelease
+	28, // 31: io.tkeel.rudder.api.plugin.v1.ListPluginReleasesResponse.releases:type_name -> io.tkeel.rudder.api.plugin.v1.PluginRelease
+	4,  // 32: io.tkeel.rudder.api.plugin.v1.ListPluginResponse.plugin_list:type_name -> io.tkeel.rudder.api.plugin.v1.PluginBrief
+	75, // 33: io.tkeel.rudder.api.plugin.v1.TenantEnableRequest.extra:type_name -> io.tkeel.rudder.api.plugin.v1.TenantEnableRequest.EnableExtraData
+	39, // 34: io.tkeel.rudder.api.plugin.v1.BatchTenantRequest.selector:type_name -> io.tkeel.rudder.api.plugin.v1.TenantSelector
+	48, // 35: io.tkeel.rudder.api.plugin.v1.BatchTenantResponse.report:type_name -> io.tkeel.rudder.api.plugin.v1.OperationReportItem
+	3,  // 36: io.tkeel.rudder.api.plugin.v1.ListEnabledTenantsResponse.tenants:type_name -> io.tkeel.rudder.api.plugin.v1.EnabledTenant
+	46, // 37: io.tkeel.rudder.api.plugin.v1.OperationObject.phases:type_name -> io.tkeel.rudder.api.plugin.v1.OperationPhase
+	48, // 38: io.tkeel.rudder.api.plugin.v1.OperationObject.report:type_name -> io.tkeel.rudder.api.plugin.v1.OperationReportItem
+	7,  // 39: io.tkeel.rudder.api.plugin.v1.SetPluginUpgradePolicyResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	52, // 40: io.tkeel.rudder.api.plugin.v1.ListAvailableUpgradesResponse.upgrades:type_name -> io.tkeel.rudder.api.plugin.v1.AvailableUpgrade
+	7,  // 41: io.tkeel.rudder.api.plugin.v1.SetPluginMaintenanceResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	7,  // 42: io.tkeel.rudder.api.plugin.v1.SetPluginCandidateRouteResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	7,  // 43: io.tkeel.rudder.api.plugin.v1.PromotePluginCandidateResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	7,  // 44: io.tkeel.rudder.api.plugin.v1.AbortPluginCandidateResponse.plugin:type_name -> io.tkeel.rudder.api.plugin.v1.PluginObject
+	63, // 45: io.tkeel.rudder.api.plugin.v1.GetPluginGraphResponse.nodes:type_name -> io.tkeel.rudder.api.plugin.v1.PluginGraphNode
+	64, // 46: io.tkeel.rudder.api.plugin.v1.GetPluginGraphResponse.edges:type_name -> io.tkeel.rudder.api.plugin.v1.PluginGraphEdge
+	67, // 47: io.tkeel.rudder.api.plugin.v1.ReconcilePluginsResponse.drifts:type_name -> io.tkeel.rudder.api.plugin.v1.PluginDrift
+	47, // 48: io.tkeel.rudder.api.plugin.v1.GetOperationResponse.operation:type_name -> io.tkeel.rudder.api.plugin.v1.OperationObject
+	47, // 49: io.tkeel.rudder.api.plugin.v1.ListOperationsResponse.operations:type_name -> io.tkeel.rudder.api.plugin.v1.OperationObject
+	11, // 50: io.tkeel.rudder.api.plugin.v1.Plugin.InstallPlugin:input_type -> io.tkeel.rudder.api.plugin.v1.InstallPluginRequest
+	14, // 51: io.tkeel.rudder.api.plugin.v1.Plugin.UpgradePlugin:input_type -> io.tkeel.rudder.api.plugin.v1.UpgradePluginRequest
+	16, // 52: io.tkeel.rudder.api.plugin.v1.Plugin.RollbackPlugin:input_type -> io.tkeel.rudder.api.plugin.v1.RollbackPluginRequest
+	18, // 53: io.tkeel.rudder.api.plugin.v1.Plugin.ReconfigurePlugin:input_type -> io.tkeel.rudder.api.plugin.v1.ReconfigurePluginRequest
+	20, // 54: io.tkeel.rudder.api.plugin.v1.Plugin.RotatePluginSecret:input_type -> io.tkeel.rudder.api.plugin.v1.RotatePluginSecretRequest
+	22, // 55: io.tkeel.rudder.api.plugin.v1.Plugin.UninstallPlugin:input_type -> io.tkeel.rudder.api.plugin.v1.UninstallPluginRequest
+	26, // 56: io.tkeel.rudder.api.plugin.v1.Plugin.GetPlugin:input_type -> io.tkeel.rudder.api.plugin.v1.GetPluginRequest
+	29, // 57: io.tkeel.rudder.api.plugin.v1.Plugin.GetPluginRelease:input_type -> io.tkeel.rudder.api.plugin.v1.GetPluginReleaseRequest
+	31, // 58: io.tkeel.rudder.api.plugin.v1.Plugin.ListPluginReleases:input_type -> io.tkeel.rudder.api.plugin.v1.ListPluginReleasesRequest
+	33, // 59: io.tkeel.rudder.api.plugin.v1.Plugin.ListPlugin:input_type -> io.tkeel.rudder.api.plugin.v1.ListPluginRequest
+	35, // 60: io.tkeel.rudder.api.plugin.v1.Plugin.TenantEnable:input_type -> io.tkeel.rudder.api.plugin.v1.TenantEnableRequest
+	37, // 61: io.tkeel.rudder.api.plugin.v1.Plugin.TenantDisable:input_type -> io.tkeel.rudder.api.plugin.v1.TenantDisableRequest
+	42, // 62: io.tkeel.rudder.api.plugin.v1.Plugin.ListEnabledTenants:input_type -> io.tkeel.rudder.api.plugin.v1.ListEnabledTenantsRequest
+	44, // 63: io.tkeel.rudder.api.plugin.v1.Plugin.TMUpdatePluginIdentify:input_type -> io.tkeel.rudder.api.plugin.v1.TMUpdatePluginIdentifyRequest
+	45, // 64: io.tkeel.rudder.api.plugin.v1.Plugin.TMRegisterPlugin:input_type -> io.tkeel.rudder.api.plugin.v1.TMRegisterPluginRequest
+	36, // 65: io.tkeel.rudder.api.plugin.v1.Plugin.TMTenantEnable:input_type -> io.tkeel.rudder.api.plugin.v1.TMTenantEnableRequest
+	38, // 66: io.tkeel.rudder.api.plugin.v1.Plugin.TMTenantDisable:input_type -> io.tkeel.rudder.api.plugin.v1.TMTenantDisableRequest
+	40, // 67: io.tkeel.rudder.api.plugin.v1.Plugin.BatchTenantEnable:input_type -> io.tkeel.rudder.api.plugin.v1.BatchTenantRequest
+	40, // 68: io.tkeel.rudder.api.plugin.v1.Plugin.BatchTenantDisable:input_type -> io.tkeel.rudder.api.plugin.v1.BatchTenantRequest
+	49, // 69: io.tkeel.rudder.api.plugin.v1.Plugin.SetPluginUpgradePolicy:input_type -> io.tkeel.rudder.api.plugin.v1.SetPluginUpgradePolicyRequest
+	51, // 70: io.tkeel.rudder.api.plugin.v1.Plugin.ListAvailableUpgrades:input_type -> io.tkeel.rudder.api.plugin.v1.ListAvailableUpgradesRequest
+	54, // 71: io.tkeel.rudder.api.plugin.v1.Plugin.SetPluginMaintenance:input_type -> io.tkeel.rudder.api.plugin.v1.SetPluginMaintenanceRequest
+	56, // 72: io.tkeel.rudder.api.plugin.v1.Plugin.SetPluginCandidateRoute:input_type -> io.tkeel.rudder.api.plugin.v1.SetPluginCandidateRouteRequest
+	58, // 73: io.tkeel.rudder.api.plugin.v1.Plugin.PromotePluginCandidate:input_type -> io.tkeel.rudder.api.plugin.v1.PromotePluginCandidateRequest
+	60, // 74: io.tkeel.rudder.api.plugin.v1.Plugin.AbortPluginCandidate:input_type -> io.tkeel.rudder.api.plugin.v1.AbortPluginCandidateRequest
+	62, // 75: io.tkeel.rudder.api.plugin.v1.Plugin.GetPluginGraph:input_type -> io.tkeel.rudder.api.plugin.v1.GetPluginGraphRequest
+	66, // 76: io.tkeel.rudder.api.plugin.v1.Plugin.ReconcilePlugins:input_type -> io.tkeel.rudder.api.plugin.v1.ReconcilePluginsRequest
+	69, // 77: io.tkeel.rudder.api.plugin.v1.Plugin.GetOperation:input_type -> io.tkeel.rudder.api.plugin.v1.GetOperationRequest
+	71, // 78: io.tkeel.rudder.api.plugin.v1.Plugin.ListOperations:input_type -> io.tkeel.rudder.api.plugin.v1.ListOperationsRequest
+	73, // 79: io.tkeel.rudder.api.plugin.v1.Plugin.WatchOperation:input_type -> io.tkeel.rudder.api.plugin.v1.WatchOperationRequest
+	13, // 80: io.tkeel.rudder.api.plugin.v1.Plugin.InstallPlugin:output_type -> io.tkeel.rudder.api.plugin.v1.InstallPluginResponse
+	15, // 81: io.tkeel.rudder.api.plugin.v1.Plugin.UpgradePlugin:output_type -> io.tkeel.rudder.api.plugin.v1.UpgradePluginResponse
+	17, // 82: io.tkeel.rudder.api.plugin.v1.Plugin.RollbackPlugin:output_type -> io.tkeel.rudder.api.plugin.v1.RollbackPluginResponse
+	19, // 83: io.tkeel.rudder.api.plugin.v1.Plugin.ReconfigurePlugin:output_type -> io.tkeel.rudder.api.plugin.v1.ReconfigurePluginResponse
+	21, // 84: io.tkeel.rudder.api.plugin.v1.Plugin.RotatePluginSecret:output_type -> io.tkeel.rudder.api.plugin.v1.RotatePluginSecretResponse
+	23, // 85: io.tkeel.rudder.api.plugin.v1.Plugin.UninstallPlugin:output_type -> io.tkeel.rudder.api.plugin.v1.UninstallPluginResponse
+	27, // 86: io.tkeel.rudder.api.plugin.v1.Plugin.GetPlugin:output_type -> io.tkeel.rudder.api.plugin.v1.GetPluginResponse
+	30, // 87: io.tkeel.rudder.api.plugin.v1.Plugin.GetPluginRelease:output_type -> io.tkeel.rudder.api.plugin.v1.GetPluginReleaseResponse
+	32, // 88: io.tkeel.rudder.api.plugin.v1.Plugin.ListPluginReleases:output_type -> io.tkeel.rudder.api.plugin.v1.ListPluginReleasesResponse
+	34, // 89: io.tkeel.rudder.api.plugin.v1.Plugin.ListPlugin:output_type -> io.tkeel.rudder.api.plugin.v1.ListPluginResponse
+	80, // 90: io.tkeel.rudder.api.plugin.v1.Plugin.TenantEnable:output_type -> google.protobuf.Empty
+	80, // 91: io.tkeel.rudder.api.plugin.v1.Plugin.TenantDisable:output_type -> google.protobuf.Empty
+	43, // 92: io.tkeel.rudder.api.plugin.v1.Plugin.ListEnabledTenants:output_type -> io.tkeel.rudder.api.plugin.v1.ListEnabledTenantsResponse
+	80, // 93: io.tkeel.rudder.api.plugin.v1.Plugin.TMUpdatePluginIdentify:output_type -> google.protobuf.Empty
+	80, // 94: io.tkeel.rudder.api.plugin.v1.Plugin.TMRegisterPlugin:output_type -> google.protobuf.Empty
+	80, // 95: io.tkeel.rudder.api.plugin.v1.Plugin.TMTenantEnable:output_type -> google.protobuf.Empty
+	80, // 96: io.tkeel.rudder.api.plugin.v1.Plugin.TMTenantDisable:output_type -> google.protobuf.Empty
+	41, // 97: io.tkeel.rudder.api.plugin.v1.Plugin.BatchTenantEnable:output_type -> io.tkeel.rudder.api.plugin.v1.BatchTenantResponse
+	41, // 98: io.tkeel.rudder.api.plugin.v1.Plugin.BatchTenantDisable:output_type -> io.tkeel.rudder.api.plugin.v1.BatchTenantResponse
+	50, // 99: io.tkeel.rudder.api.plugin.v1.Plugin.SetPluginUpgradePolicy:output_type -> io.tkeel.rudder.api.plugin.v1.SetPluginUpgradePolicyResponse
+	53, // 100: io.tkeel.rudder.api.plugin.v1.Plugin.ListAvailableUpgrades:output_type -> io.tkeel.rudder.api.plugin.v1.ListAvailableUpgradesResponse
+	55, // 101: io.tkeel.rudder.api.plugin.v1.Plugin.SetPluginMaintenance:output_type -> io.tkeel.rudder.api.plugin.v1.SetPluginMaintenanceResponse
+	57, // 102: io.tkeel.rudder.api.plugin.v1.Plugin.SetPluginCandidateRoute:output_type -> io.tkeel.rudder.api.plugin.v1.SetPluginCandidateRouteResponse
+	59, // 103: io.tkeel.rudder.api.plugin.v1.Plugin.PromotePluginCandidate:output_type -> io.tkeel.rudder.api.plugin.v1.PromotePluginCandidateResponse
+	61, // 104: io.tkeel.rudder.api.plugin.v1.Plugin.AbortPluginCandidate:output_type -> io.tkeel.rudder.api.plugin.v1.AbortPluginCandidateResponse
+	65, // 105: io.tkeel.rudder.api.plugin.v1.Plugin.GetPluginGraph:output_type -> io.tkeel.rudder.api.plugin.v1.GetPluginGraphResponse
+	68, // 106: io.tkeel.rudder.api.plugin.v1.Plugin.ReconcilePlugins:output_type -> io.tkeel.rudder.api.plugin.v1.ReconcilePluginsResponse
+	70, // 107: io.tkeel.rudder.api.plugin.v1.Plugin.GetOperation:output_type -> io.tkeel.rudder.api.plugin.v1.GetOperationResponse
+	72, // 108: io.tkeel.rudder.api.plugin.v1.Plugin.ListOperations:output_type -> io.tkeel.rudder.api.plugin.v1.ListOperationsResponse
+	47, // 109: io.tkeel.rudder.api.plugin.v1.Plugin.WatchOperation:output_type -> io.tkeel.rudder.api.plugin.v1.OperationObject
+	80, // [80:110] is the sub-list for method output_type
+	50, // [50:80] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
+}
+
+func init() { file_api_plugin_v1_plugin_proto_init() }
+func file_api_plugin_v1_plugin_proto_init() {
+	if File_api_plugin_v1_plugin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_plugin_v1_plugin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterAddons); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Installer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnabledTenant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginBrief); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthTransition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginMaintenance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DryRunResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallPluginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallPlanItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallPluginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradePluginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradePluginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackPluginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollbackPluginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconfigurePluginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconfigurePluginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotatePluginSecretRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotatePluginSecretResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UninstallPluginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UninstallPluginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UninstallAffectedPlugin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UninstallPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPluginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPluginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginRelease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPluginReleaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPluginReleaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPluginReleasesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2365,8 +7481,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Installer); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPluginReleasesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2377,8 +7493,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnabledTenant); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPluginRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2389,8 +7505,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PluginBrief); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPluginResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2401,8 +7517,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PluginObject); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantEnableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2413,8 +7529,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstallPluginRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TMTenantEnableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2425,8 +7541,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstallPluginResponse); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantDisableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2437,8 +7553,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpgradePluginRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TMTenantDisableRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2449,8 +7565,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpgradePluginResponse); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantSelector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2461,8 +7577,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UninstallPluginRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchTenantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2473,8 +7589,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UninstallPluginResponse); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchTenantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2485,8 +7601,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPluginRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEnabledTenantsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2497,8 +7613,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPluginResponse); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEnabledTenantsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2509,8 +7625,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPluginRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TMUpdatePluginIdentifyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2521,8 +7637,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPluginResponse); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TMRegisterPluginRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2533,8 +7649,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TenantEnableRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationPhase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2545,8 +7661,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TMTenantEnableRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationObject); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2557,8 +7673,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TenantDisableRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationReportItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2569,8 +7685,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TMTenantDisableRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPluginUpgradePolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2581,8 +7697,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListEnabledTenantsRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPluginUpgradePolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2593,8 +7709,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListEnabledTenantsResponse); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAvailableUpgradesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2605,8 +7721,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TMUpdatePluginIdentifyRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AvailableUpgrade); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2617,8 +7733,8 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TMRegisterPluginRequest); i {
+		file_api_plugin_v1_plugin_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAvailableUpgradesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2629,7 +7745,247 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_api_plugin_v1_plugin_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPluginMaintenanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPluginMaintenanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPluginCandidateRouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPluginCandidateRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotePluginCandidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotePluginCandidateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbortPluginCandidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbortPluginCandidateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPluginGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginGraphNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginGraphEdge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPluginGraphResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcilePluginsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluginDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcilePluginsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOperationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_plugin_v1_plugin_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstallerMaintainer); i {
 			case 0:
 				return &v.state
@@ -2641,7 +7997,7 @@ func file_api_plugin_v1_plugin_proto_init() {
 				return nil
 			}
 		}
-		file_api_plugin_v1_plugin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_api_plugin_v1_plugin_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TenantEnableRequest_EnableExtraData); i {
 			case 0:
 				return &v.state
@@ -2660,7 +8016,7 @@ func file_api_plugin_v1_plugin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_plugin_v1_plugin_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   25,
+			NumMessages:   75,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,8 +21,13 @@ const _ = grpc.SupportPackageIsVersion7
 type PluginClient interface {
 	InstallPlugin(ctx context.Context, in *InstallPluginRequest, opts ...grpc.CallOption) (*InstallPluginResponse, error)
 	UpgradePlugin(ctx context.Context, in *UpgradePluginRequest, opts ...grpc.CallOption) (*UpgradePluginResponse, error)
+	RollbackPlugin(ctx context.Context, in *RollbackPluginRequest, opts ...grpc.CallOption) (*RollbackPluginResponse, error)
+	ReconfigurePlugin(ctx context.Context, in *ReconfigurePluginRequest, opts ...grpc.CallOption) (*ReconfigurePluginResponse, error)
+	RotatePluginSecret(ctx context.Context, in *RotatePluginSecretRequest, opts ...grpc.CallOption) (*RotatePluginSecretResponse, error)
 	UninstallPlugin(ctx context.Context, in *UninstallPluginRequest, opts ...grpc.CallOption) (*UninstallPluginResponse, error)
 	GetPlugin(ctx context.Context, in *GetPluginRequest, opts ...grpc.CallOption) (*GetPluginResponse, error)
+	GetPluginRelease(ctx context.Context, in *GetPluginReleaseRequest, opts ...grpc.CallOption) (*GetPluginReleaseResponse, error)
+	ListPluginReleases(ctx context.Context, in *ListPluginReleasesRequest, opts ...grpc.CallOption) (*ListPluginReleasesResponse, error)
 	ListPlugin(ctx context.Context, in *ListPluginRequest, opts ...grpc.CallOption) (*ListPluginResponse, error)
 	TenantEnable(ctx context.Context, in *TenantEnableRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	TenantDisable(ctx context.Context, in *TenantDisableRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -31,6 +36,20 @@ type PluginClient interface {
 	TMRegisterPlugin(ctx context.Context, in *TMRegisterPluginRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	TMTenantEnable(ctx context.Context, in *TMTenantEnableRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	TMTenantDisable(ctx context.Context, in *TMTenantDisableRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BatchTenantEnable(ctx context.Context, in *BatchTenantRequest, opts ...grpc.CallOption) (*BatchTenantResponse, error)
+	BatchTenantDisable(ctx context.Context, in *BatchTenantRequest, opts ...grpc.CallOption) (*BatchTenantResponse, error)
+	SetPluginUpgradePolicy(ctx context.Context, in *SetPluginUpgradePolicyRequest, opts ...grpc.CallOption) (*SetPluginUpgradePolicyResponse, error)
+	ListAvailableUpgrades(ctx context.Context, in *ListAvailableUpgradesRequest, opts ...grpc.CallOption) (*ListAvailableUpgradesResponse, error)
+	SetPluginMaintenance(ctx context.Context, in *SetPluginMaintenanceRequest, opts ...grpc.CallOption) (*SetPluginMaintenanceResponse, error)
+	SetPluginCandidateRoute(ctx context.Context, in *SetPluginCandidateRouteRequest, opts ...grpc.CallOption) (*SetPluginCandidateRouteResponse, error)
+	PromotePluginCandidate(ctx context.Context, in *PromotePluginCandidateRequest, opts ...grpc.CallOption) (*PromotePluginCandidateResponse, error)
+	AbortPluginCandidate(ctx context.Context, in *AbortPluginCandidateRequest, opts ...grpc.CallOption) (*AbortPluginCandidateResponse, error)
+	GetPluginGraph(ctx context.Context, in *GetPluginGraphRequest, opts ...grpc.CallOption) (*GetPluginGraphResponse, error)
+	ReconcilePlugins(ctx context.Context, in *ReconcilePluginsRequest, opts ...grpc.CallOption) (*ReconcilePluginsResponse, error)
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error)
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	// WatchOperation stream the operation until it finished.
+	WatchOperation(ctx context.Context, in *WatchOperationRequest, opts ...grpc.CallOption) (Plugin_WatchOperationClient, error)
 }
 
 type pluginClient struct {
@@ -59,6 +78,33 @@ func (c *pluginClient) UpgradePlugin(ctx context.Context, in *UpgradePluginReque
 	return out, nil
 }
 
+func (c *pluginClient) RollbackPlugin(ctx context.Context, in *RollbackPluginRequest, opts ...grpc.CallOption) (*RollbackPluginResponse, error) {
+	out := new(RollbackPluginResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/RollbackPlugin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) ReconfigurePlugin(ctx context.Context, in *ReconfigurePluginRequest, opts ...grpc.CallOption) (*ReconfigurePluginResponse, error) {
+	out := new(ReconfigurePluginResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/ReconfigurePlugin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) RotatePluginSecret(ctx context.Context, in *RotatePluginSecretRequest, opts ...grpc.CallOption) (*RotatePluginSecretResponse, error) {
+	out := new(RotatePluginSecretResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/RotatePluginSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pluginClient) UninstallPlugin(ctx context.Context, in *UninstallPluginRequest, opts ...grpc.CallOption) (*UninstallPluginResponse, error) {
 	out := new(UninstallPluginResponse)
 	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/UninstallPlugin", in, out, opts...)
@@ -77,6 +123,24 @@ func (c *pluginClient) GetPlugin(ctx context.Context, in *GetPluginRequest, opts
 	return out, nil
 }
 
+func (c *pluginClient) GetPluginRelease(ctx context.Context, in *GetPluginReleaseRequest, opts ...grpc.CallOption) (*GetPluginReleaseResponse, error) {
+	out := new(GetPluginReleaseResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/GetPluginRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) ListPluginReleases(ctx context.Context, in *ListPluginReleasesRequest, opts ...grpc.CallOption) (*ListPluginReleasesResponse, error) {
+	out := new(ListPluginReleasesResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/ListPluginReleases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pluginClient) ListPlugin(ctx context.Context, in *ListPluginRequest, opts ...grpc.CallOption) (*ListPluginResponse, error) {
 	out := new(ListPluginResponse)
 	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/ListPlugin", in, out, opts...)
@@ -149,14 +213,159 @@ func (c *pluginClient) TMTenantDisable(ctx context.Context, in *TMTenantDisableR
 	return out, nil
 }
 
+func (c *pluginClient) BatchTenantEnable(ctx context.Context, in *BatchTenantRequest, opts ...grpc.CallOption) (*BatchTenantResponse, error) {
+	out := new(BatchTenantResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/BatchTenantEnable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) BatchTenantDisable(ctx context.Context, in *BatchTenantRequest, opts ...grpc.CallOption) (*BatchTenantResponse, error) {
+	out := new(BatchTenantResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/BatchTenantDisable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) SetPluginUpgradePolicy(ctx context.Context, in *SetPluginUpgradePolicyRequest, opts ...grpc.CallOption) (*SetPluginUpgradePolicyResponse, error) {
+	out := new(SetPluginUpgradePolicyResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/SetPluginUpgradePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) ListAvailableUpgrades(ctx context.Context, in *ListAvailableUpgradesRequest, opts ...grpc.CallOption) (*ListAvailableUpgradesResponse, error) {
+	out := new(ListAvailableUpgradesResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/ListAvailableUpgrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) SetPluginMaintenance(ctx context.Context, in *SetPluginMaintenanceRequest, opts ...grpc.CallOption) (*SetPluginMaintenanceResponse, error) {
+	out := new(SetPluginMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/SetPluginMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) SetPluginCandidateRoute(ctx context.Context, in *SetPluginCandidateRouteRequest, opts ...grpc.CallOption) (*SetPluginCandidateRouteResponse, error) {
+	out := new(SetPluginCandidateRouteResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/SetPluginCandidateRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) PromotePluginCandidate(ctx context.Context, in *PromotePluginCandidateRequest, opts ...grpc.CallOption) (*PromotePluginCandidateResponse, error) {
+	out := new(PromotePluginCandidateResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/PromotePluginCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) AbortPluginCandidate(ctx context.Context, in *AbortPluginCandidateRequest, opts ...grpc.CallOption) (*AbortPluginCandidateResponse, error) {
+	out := new(AbortPluginCandidateResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/AbortPluginCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) GetPluginGraph(ctx context.Context, in *GetPluginGraphRequest, opts ...grpc.CallOption) (*GetPluginGraphResponse, error) {
+	out := new(GetPluginGraphResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/GetPluginGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) ReconcilePlugins(ctx context.Context, in *ReconcilePluginsRequest, opts ...grpc.CallOption) (*ReconcilePluginsResponse, error) {
+	out := new(ReconcilePluginsResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/ReconcilePlugins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error) {
+	out := new(GetOperationResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/GetOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.rudder.api.plugin.v1.Plugin/ListOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluginClient) WatchOperation(ctx context.Context, in *WatchOperationRequest, opts ...grpc.CallOption) (Plugin_WatchOperationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Plugin_ServiceDesc.Streams[0], "/io.tkeel.rudder.api.plugin.v1.Plugin/WatchOperation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluginWatchOperationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Plugin_WatchOperationClient interface {
+	Recv() (*OperationObject, error)
+	grpc.ClientStream
+}
+
+type pluginWatchOperationClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluginWatchOperationClient) Recv() (*OperationObject, error) {
+	m := new(OperationObject)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PluginServer is the server API for Plugin service.
 // All implementations must embed UnimplementedPluginServer
 // for forward compatibility
 type PluginServer interface {
 	InstallPlugin(context.Context, *InstallPluginRequest) (*InstallPluginResponse, error)
 	UpgradePlugin(context.Context, *UpgradePluginRequest) (*UpgradePluginResponse, error)
+	RollbackPlugin(context.Context, *RollbackPluginRequest) (*RollbackPluginResponse, error)
+	ReconfigurePlugin(context.Context, *ReconfigurePluginRequest) (*ReconfigurePluginResponse, error)
+	RotatePluginSecret(context.Context, *RotatePluginSecretRequest) (*RotatePluginSecretResponse, error)
 	UninstallPlugin(context.Context, *UninstallPluginRequest) (*UninstallPluginResponse, error)
 	GetPlugin(context.Context, *GetPluginRequest) (*GetPluginResponse, error)
+	GetPluginRelease(context.Context, *GetPluginReleaseRequest) (*GetPluginReleaseResponse, error)
+	ListPluginReleases(context.Context, *ListPluginReleasesRequest) (*ListPluginReleasesResponse, error)
 	ListPlugin(context.Context, *ListPluginRequest) (*ListPluginResponse, error)
 	TenantEnable(context.Context, *TenantEnableRequest) (*emptypb.Empty, error)
 	TenantDisable(context.Context, *TenantDisableRequest) (*emptypb.Empty, error)
@@ -165,6 +374,20 @@ type PluginServer interface {
 	TMRegisterPlugin(context.Context, *TMRegisterPluginRequest) (*emptypb.Empty, error)
 	TMTenantEnable(context.Context, *TMTenantEnableRequest) (*emptypb.Empty, error)
 	TMTenantDisable(context.Context, *TMTenantDisableRequest) (*emptypb.Empty, error)
+	BatchTenantEnable(context.Context, *BatchTenantRequest) (*BatchTenantResponse, error)
+	BatchTenantDisable(context.Context, *BatchTenantRequest) (*BatchTenantResponse, error)
+	SetPluginUpgradePolicy(context.Context, *SetPluginUpgradePolicyRequest) (*SetPluginUpgradePolicyResponse, error)
+	ListAvailableUpgrades(context.Context, *ListAvailableUpgradesRequest) (*ListAvailableUpgradesResponse, error)
+	SetPluginMaintenance(context.Context, *SetPluginMaintenanceRequest) (*SetPluginMaintenanceResponse, error)
+	SetPluginCandidateRoute(context.Context, *SetPluginCandidateRouteRequest) (*SetPluginCandidateRouteResponse, error)
+	PromotePluginCandidate(context.Context, *PromotePluginCandidateRequest) (*PromotePluginCandidateResponse, error)
+	AbortPluginCandidate(context.Context, *AbortPluginCandidateRequest) (*AbortPluginCandidateResponse, error)
+	GetPluginGraph(context.Context, *GetPluginGraphRequest) (*GetPluginGraphResponse, error)
+	ReconcilePlugins(context.Context, *ReconcilePluginsRequest) (*ReconcilePluginsResponse, error)
+	GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error)
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	// WatchOperation stream the operation until it finished.
+	WatchOperation(*WatchOperationRequest, Plugin_WatchOperationServer) error
 	mustEmbedUnimplementedPluginServer()
 }
 
@@ -178,12 +401,27 @@ func (UnimplementedPluginServer) InstallPlugin(context.Context, *InstallPluginRe
 func (UnimplementedPluginServer) UpgradePlugin(context.Context, *UpgradePluginRequest) (*UpgradePluginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradePlugin not implemented")
 }
+func (UnimplementedPluginServer) RollbackPlugin(context.Context, *RollbackPluginRequest) (*RollbackPluginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPlugin not implemented")
+}
+func (UnimplementedPluginServer) ReconfigurePlugin(context.Context, *ReconfigurePluginRequest) (*ReconfigurePluginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconfigurePlugin not implemented")
+}
+func (UnimplementedPluginServer) RotatePluginSecret(context.Context, *RotatePluginSecretRequest) (*RotatePluginSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotatePluginSecret not implemented")
+}
 func (UnimplementedPluginServer) UninstallPlugin(context.Context, *UninstallPluginRequest) (*UninstallPluginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UninstallPlugin not implemented")
 }
 func (UnimplementedPluginServer) GetPlugin(context.Context, *GetPluginRequest) (*GetPluginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlugin not implemented")
 }
+func (UnimplementedPluginServer) GetPluginRelease(context.Context, *GetPluginReleaseRequest) (*GetPluginReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPluginRelease not implemented")
+}
+func (UnimplementedPluginServer) ListPluginReleases(context.Context, *ListPluginReleasesRequest) (*ListPluginReleasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPluginReleases not implemented")
+}
 func (UnimplementedPluginServer) ListPlugin(context.Context, *ListPluginRequest) (*ListPluginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPlugin not implemented")
 }
@@ -208,6 +446,45 @@ func (UnimplementedPluginServer) TMTenantEnable(context.Context, *TMTenantEnable
 func (UnimplementedPluginServer) TMTenantDisable(context.Context, *TMTenantDisableRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TMTenantDisable not implemented")
 }
+func (UnimplementedPluginServer) BatchTenantEnable(context.Context, *BatchTenantRequest) (*BatchTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTenantEnable not implemented")
+}
+func (UnimplementedPluginServer) BatchTenantDisable(context.Context, *BatchTenantRequest) (*BatchTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTenantDisable not implemented")
+}
+func (UnimplementedPluginServer) SetPluginUpgradePolicy(context.Context, *SetPluginUpgradePolicyRequest) (*SetPluginUpgradePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPluginUpgradePolicy not implemented")
+}
+func (UnimplementedPluginServer) ListAvailableUpgrades(context.Context, *ListAvailableUpgradesRequest) (*ListAvailableUpgradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAvailableUpgrades not implemented")
+}
+func (UnimplementedPluginServer) SetPluginMaintenance(context.Context, *SetPluginMaintenanceRequest) (*SetPluginMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPluginMaintenance not implemented")
+}
+func (UnimplementedPluginServer) SetPluginCandidateRoute(context.Context, *SetPluginCandidateRouteRequest) (*SetPluginCandidateRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPluginCandidateRoute not implemented")
+}
+func (UnimplementedPluginServer) PromotePluginCandidate(context.Context, *PromotePluginCandidateRequest) (*PromotePluginCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromotePluginCandidate not implemented")
+}
+func (UnimplementedPluginServer) AbortPluginCandidate(context.Context, *AbortPluginCandidateRequest) (*AbortPluginCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortPluginCandidate not implemented")
+}
+func (UnimplementedPluginServer) GetPluginGraph(context.Context, *GetPluginGraphRequest) (*GetPluginGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPluginGraph not implemented")
+}
+func (UnimplementedPluginServer) ReconcilePlugins(context.Context, *ReconcilePluginsRequest) (*ReconcilePluginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcilePlugins not implemented")
+}
+func (UnimplementedPluginServer) GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedPluginServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedPluginServer) WatchOperation(*WatchOperationRequest, Plugin_WatchOperationServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOperation not implemented")
+}
 func (UnimplementedPluginServer) mustEmbedUnimplementedPluginServer() {}
 
 // UnsafePluginServer may be embedded to opt out of forward compatibility for this service.
@@ -257,6 +534,60 @@ func _Plugin_UpgradePlugin_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Plugin_RollbackPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).RollbackPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/RollbackPlugin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).RollbackPlugin(ctx, req.(*RollbackPluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_ReconfigurePlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconfigurePluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).ReconfigurePlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/ReconfigurePlugin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).ReconfigurePlugin(ctx, req.(*ReconfigurePluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_RotatePluginSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotatePluginSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).RotatePluginSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/RotatePluginSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).RotatePluginSecret(ctx, req.(*RotatePluginSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Plugin_UninstallPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UninstallPluginRequest)
 	if err := dec(in); err != nil {
@@ -293,6 +624,42 @@ func _Plugin_GetPlugin_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Plugin_GetPluginRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPluginReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).GetPluginRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/GetPluginRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).GetPluginRelease(ctx, req.(*GetPluginReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_ListPluginReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPluginReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).ListPluginReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/ListPluginReleases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).ListPluginReleases(ctx, req.(*ListPluginReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Plugin_ListPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPluginRequest)
 	if err := dec(in); err != nil {
@@ -437,6 +804,243 @@ func _Plugin_TMTenantDisable_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Plugin_BatchTenantEnable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).BatchTenantEnable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/BatchTenantEnable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).BatchTenantEnable(ctx, req.(*BatchTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_BatchTenantDisable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).BatchTenantDisable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/BatchTenantDisable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).BatchTenantDisable(ctx, req.(*BatchTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_SetPluginUpgradePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPluginUpgradePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).SetPluginUpgradePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/SetPluginUpgradePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).SetPluginUpgradePolicy(ctx, req.(*SetPluginUpgradePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_ListAvailableUpgrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvailableUpgradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).ListAvailableUpgrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/ListAvailableUpgrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).ListAvailableUpgrades(ctx, req.(*ListAvailableUpgradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_SetPluginMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPluginMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).SetPluginMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/SetPluginMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).SetPluginMaintenance(ctx, req.(*SetPluginMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_SetPluginCandidateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPluginCandidateRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).SetPluginCandidateRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/SetPluginCandidateRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).SetPluginCandidateRoute(ctx, req.(*SetPluginCandidateRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_PromotePluginCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotePluginCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).PromotePluginCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/PromotePluginCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).PromotePluginCandidate(ctx, req.(*PromotePluginCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_AbortPluginCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortPluginCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).AbortPluginCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/AbortPluginCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).AbortPluginCandidate(ctx, req.(*AbortPluginCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_GetPluginGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPluginGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).GetPluginGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/GetPluginGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).GetPluginGraph(ctx, req.(*GetPluginGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_ReconcilePlugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcilePluginsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).ReconcilePlugins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/ReconcilePlugins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).ReconcilePlugins(ctx, req.(*ReconcilePluginsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/GetOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluginServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.rudder.api.plugin.v1.Plugin/ListOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluginServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Plugin_WatchOperation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOperationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PluginServer).WatchOperation(m, &pluginWatchOperationServer{stream})
+}
+
+type Plugin_WatchOperationServer interface {
+	Send(*OperationObject) error
+	grpc.ServerStream
+}
+
+type pluginWatchOperationServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluginWatchOperationServer) Send(m *OperationObject) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Plugin_ServiceDesc is the grpc.ServiceDesc for Plugin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -452,6 +1056,18 @@ var Plugin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpgradePlugin",
 			Handler:    _Plugin_UpgradePlugin_Handler,
 		},
+		{
+			MethodName: "RollbackPlugin",
+			Handler:    _Plugin_RollbackPlugin_Handler,
+		},
+		{
+			MethodName: "ReconfigurePlugin",
+			Handler:    _Plugin_ReconfigurePlugin_Handler,
+		},
+		{
+			MethodName: "RotatePluginSecret",
+			Handler:    _Plugin_RotatePluginSecret_Handler,
+		},
 		{
 			MethodName: "UninstallPlugin",
 			Handler:    _Plugin_UninstallPlugin_Handler,
@@ -460,6 +1076,14 @@ var Plugin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPlugin",
 			Handler:    _Plugin_GetPlugin_Handler,
 		},
+		{
+			MethodName: "GetPluginRelease",
+			Handler:    _Plugin_GetPluginRelease_Handler,
+		},
+		{
+			MethodName: "ListPluginReleases",
+			Handler:    _Plugin_ListPluginReleases_Handler,
+		},
 		{
 			MethodName: "ListPlugin",
 			Handler:    _Plugin_ListPlugin_Handler,
@@ -492,7 +1116,61 @@ var Plugin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TMTenantDisable",
 			Handler:    _Plugin_TMTenantDisable_Handler,
 		},
+		{
+			MethodName: "BatchTenantEnable",
+			Handler:    _Plugin_BatchTenantEnable_Handler,
+		},
+		{
+			MethodName: "BatchTenantDisable",
+			Handler:    _Plugin_BatchTenantDisable_Handler,
+		},
+		{
+			MethodName: "SetPluginUpgradePolicy",
+			Handler:    _Plugin_SetPluginUpgradePolicy_Handler,
+		},
+		{
+			MethodName: "ListAvailableUpgrades",
+			Handler:    _Plugin_ListAvailableUpgrades_Handler,
+		},
+		{
+			MethodName: "SetPluginMaintenance",
+			Handler:    _Plugin_SetPluginMaintenance_Handler,
+		},
+		{
+			MethodName: "SetPluginCandidateRoute",
+			Handler:    _Plugin_SetPluginCandidateRoute_Handler,
+		},
+		{
+			MethodName: "PromotePluginCandidate",
+			Handler:    _Plugin_PromotePluginCandidate_Handler,
+		},
+		{
+			MethodName: "AbortPluginCandidate",
+			Handler:    _Plugin_AbortPluginCandidate_Handler,
+		},
+		{
+			MethodName: "GetPluginGraph",
+			Handler:    _Plugin_GetPluginGraph_Handler,
+		},
+		{
+			MethodName: "ReconcilePlugins",
+			Handler:    _Plugin_ReconcilePlugins_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _Plugin_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _Plugin_ListOperations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOperation",
+			Handler:       _Plugin_WatchOperation_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/plugin/v1/plugin.proto",
 }
@@ -63,6 +63,9 @@ const (
 	// @msg=INSTALLER不存在
 	// @code=NOT_FOUND
 	Error_ERR_INSTALLER_NOT_FOUND Error = 7
+	// @msg=刷新REPO出错
+	// @code=INTERNAL
+	Error_ERR_REFRESH_REPO Error = 8
 )
 
 // Enum value maps for Error.
@@ -76,6 +79,7 @@ var (
 		5: "ERR_INTERNAL_ERROR",
 		6: "ERR_REPO_EXIST",
 		7: "ERR_INSTALLER_NOT_FOUND",
+		8: "ERR_REFRESH_REPO",
 	}
 	Error_value = map[string]int32{
 		"ERR_UNKNOWN":             0,
@@ -86,6 +90,7 @@ var (
 		"ERR_INTERNAL_ERROR":      5,
 		"ERR_REPO_EXIST":          6,
 		"ERR_INSTALLER_NOT_FOUND": 7,
+		"ERR_REFRESH_REPO":        8,
 	}
 )
 
@@ -122,7 +127,7 @@ var file_api_repo_v1_error_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72,
 	0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
 	0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72,
-	0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2a, 0xc0, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2a, 0xd6, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
 	0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x52, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
 	0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x52, 0x52, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x4e, 0x4f,
 	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x52, 0x52,
@@ -134,13 +139,15 @@ var file_api_repo_v1_error_proto_rawDesc = []byte{
 	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x52, 0x52, 0x5f,
 	0x52, 0x45, 0x50, 0x4f, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17,
 	0x45, 0x52, 0x52, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
-	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x07, 0x42, 0x59, 0x0a, 0x1b, 0x69, 0x6f, 0x2e,
-	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70,
-	0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x31, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f,
-	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x76,
-	0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x52, 0x52,
+	0x5f, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53, 0x48, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x08, 0x42,
+	0x59, 0x0a, 0x1b, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x42, 0x0e,
+	0x4f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x31, 0x50, 0x01,
+	0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65,
+	0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x72, 0x65, 0x70, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -19,6 +19,7 @@ var errInternalStore *errors.TError
 var errInternalError *errors.TError
 var errRepoExist *errors.TError
 var errInstallerNotFound *errors.TError
+var errRefreshRepo *errors.TError
 
 func init() {
 	errUnknown = errors.New(int(codes.Unknown), "io.tkeel.plugin.api.repo.v1.ERR_UNKNOWN", "未知类型")
@@ -37,6 +38,8 @@ func init() {
 	errors.Register(errRepoExist)
 	errInstallerNotFound = errors.New(int(codes.NotFound), "io.tkeel.plugin.api.repo.v1.ERR_INSTALLER_NOT_FOUND", "INSTALLER不存在")
 	errors.Register(errInstallerNotFound)
+	errRefreshRepo = errors.New(int(codes.Internal), "io.tkeel.plugin.api.repo.v1.ERR_REFRESH_REPO", "刷新REPO出错")
+	errors.Register(errRefreshRepo)
 }
 
 func ErrUnknown() errors.Error {
@@ -70,3 +73,7 @@ func ErrRepoExist() errors.Error {
 func ErrInstallerNotFound() errors.Error {
 	return errInstallerNotFound
 }
+
+func ErrRefreshRepo() errors.Error {
+	return errRefreshRepo
+}
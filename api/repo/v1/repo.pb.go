@@ -96,6 +96,9 @@ type RepoObject struct {
 	Metadata     map[string][]byte `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Annotations  map[string]string `protobuf:"bytes,4,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	InstallerNum int32             `protobuf:"varint,5,opt,name=installer_num,json=installerNum,proto3" json:"installer_num,omitempty"`
+	Auth         *RepoAuthStatus   `protobuf:"bytes,6,opt,name=auth,proto3" json:"auth,omitempty"`
+	Verify       string            `protobuf:"bytes,7,opt,name=verify,proto3" json:"verify,omitempty"`
+	Status       *RepoStatus       `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
 }
 
 func (x *RepoObject) Reset() {
@@ -165,6 +168,296 @@ func (x *RepoObject) GetInstallerNum() int32 {
 	return 0
 }
 
+func (x *RepoObject) GetAuth() *RepoAuthStatus {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *RepoObject) GetVerify() string {
+	if x != nil {
+		return x.Verify
+	}
+	return ""
+}
+
+func (x *RepoObject) GetStatus() *RepoStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type RepoStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastSyncTime       int64  `protobuf:"varint,1,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	LastError          string `protobuf:"bytes,2,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime      int64  `protobuf:"varint,3,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	ChartCount         int32  `protobuf:"varint,4,opt,name=chart_count,json=chartCount,proto3" json:"chart_count,omitempty"`
+	IndexGeneratedTime int64  `protobuf:"varint,5,opt,name=index_generated_time,json=indexGeneratedTime,proto3" json:"index_generated_time,omitempty"`
+	RefreshInterval    string `protobuf:"bytes,6,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`
+}
+
+func (x *RepoStatus) Reset() {
+	*x = RepoStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_repo_v1_repo_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoStatus) ProtoMessage() {}
+
+func (x *RepoStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_repo_v1_repo_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoStatus.ProtoReflect.Descriptor instead.
+func (*RepoStatus) Descriptor() ([]byte, []int) {
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RepoStatus) GetLastSyncTime() int64 {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return 0
+}
+
+func (x *RepoStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *RepoStatus) GetLastErrorTime() int64 {
+	if x != nil {
+		return x.LastErrorTime
+	}
+	return 0
+}
+
+func (x *RepoStatus) GetChartCount() int32 {
+	if x != nil {
+		return x.ChartCount
+	}
+	return 0
+}
+
+func (x *RepoStatus) GetIndexGeneratedTime() int64 {
+	if x != nil {
+		return x.IndexGeneratedTime
+	}
+	return 0
+}
+
+func (x *RepoStatus) GetRefreshInterval() string {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return ""
+}
+
+type RepoAuth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username              string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password              string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Token                 string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	CertData              string `protobuf:"bytes,4,opt,name=cert_data,json=certData,proto3" json:"cert_data,omitempty"`
+	KeyData               string `protobuf:"bytes,5,opt,name=key_data,json=keyData,proto3" json:"key_data,omitempty"`
+	CaData                string `protobuf:"bytes,6,opt,name=ca_data,json=caData,proto3" json:"ca_data,omitempty"`
+	InsecureSkipTlsVerify bool   `protobuf:"varint,7,opt,name=insecure_skip_tls_verify,json=insecureSkipTlsVerify,proto3" json:"insecure_skip_tls_verify,omitempty"`
+}
+
+func (x *RepoAuth) Reset() {
+	*x = RepoAuth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_repo_v1_repo_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoAuth) ProtoMessage() {}
+
+func (x *RepoAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_repo_v1_repo_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoAuth.ProtoReflect.Descriptor instead.
+func (*RepoAuth) Descriptor() ([]byte, []int) {
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RepoAuth) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RepoAuth) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RepoAuth) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RepoAuth) GetCertData() string {
+	if x != nil {
+		return x.CertData
+	}
+	return ""
+}
+
+func (x *RepoAuth) GetKeyData() string {
+	if x != nil {
+		return x.KeyData
+	}
+	return ""
+}
+
+func (x *RepoAuth) GetCaData() string {
+	if x != nil {
+		return x.CaData
+	}
+	return ""
+}
+
+func (x *RepoAuth) GetInsecureSkipTlsVerify() bool {
+	if x != nil {
+		return x.InsecureSkipTlsVerify
+	}
+	return false
+}
+
+type RepoAuthStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username              string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PasswordSet           bool   `protobuf:"varint,2,opt,name=password_set,json=passwordSet,proto3" json:"password_set,omitempty"`
+	TokenSet              bool   `protobuf:"varint,3,opt,name=token_set,json=tokenSet,proto3" json:"token_set,omitempty"`
+	ClientCertSet         bool   `protobuf:"varint,4,opt,name=client_cert_set,json=clientCertSet,proto3" json:"client_cert_set,omitempty"`
+	CaSet                 bool   `protobuf:"varint,5,opt,name=ca_set,json=caSet,proto3" json:"ca_set,omitempty"`
+	InsecureSkipTlsVerify bool   `protobuf:"varint,6,opt,name=insecure_skip_tls_verify,json=insecureSkipTlsVerify,proto3" json:"insecure_skip_tls_verify,omitempty"`
+}
+
+func (x *RepoAuthStatus) Reset() {
+	*x = RepoAuthStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_repo_v1_repo_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoAuthStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoAuthStatus) ProtoMessage() {}
+
+func (x *RepoAuthStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_repo_v1_repo_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoAuthStatus.ProtoReflect.Descriptor instead.
+func (*RepoAuthStatus) Descriptor() ([]byte, []int) {
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RepoAuthStatus) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RepoAuthStatus) GetPasswordSet() bool {
+	if x != nil {
+		return x.PasswordSet
+	}
+	return false
+}
+
+func (x *RepoAuthStatus) GetTokenSet() bool {
+	if x != nil {
+		return x.TokenSet
+	}
+	return false
+}
+
+func (x *RepoAuthStatus) GetClientCertSet() bool {
+	if x != nil {
+		return x.ClientCertSet
+	}
+	return false
+}
+
+func (x *RepoAuthStatus) GetCaSet() bool {
+	if x != nil {
+		return x.CaSet
+	}
+	return false
+}
+
+func (x *RepoAuthStatus) GetInsecureSkipTlsVerify() bool {
+	if x != nil {
+		return x.InsecureSkipTlsVerify
+	}
+	return false
+}
+
 type VersionList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -177,7 +470,7 @@ type VersionList struct {
 func (x *VersionList) Reset() {
 	*x = VersionList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[1]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -190,7 +483,7 @@ func (x *VersionList) String() string {
 func (*VersionList) ProtoMessage() {}
 
 func (x *VersionList) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[1]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,7 +496,7 @@ func (x *VersionList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionList.ProtoReflect.Descriptor instead.
 func (*VersionList) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{1}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *VersionList) GetVersion() string {
@@ -236,12 +529,13 @@ type InstallerObject struct {
 	Timestamp   uint64                       `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Icon        string                       `protobuf:"bytes,10,opt,name=icon,proto3" json:"icon,omitempty"`
 	VersionList []*VersionList               `protobuf:"bytes,11,rep,name=version_list,json=versionList,proto3" json:"version_list,omitempty"`
+	Provenance  *InstallerProvenance         `protobuf:"bytes,12,opt,name=provenance,proto3" json:"provenance,omitempty"`
 }
 
 func (x *InstallerObject) Reset() {
 	*x = InstallerObject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[2]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -254,7 +548,7 @@ func (x *InstallerObject) String() string {
 func (*InstallerObject) ProtoMessage() {}
 
 func (x *InstallerObject) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[2]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +561,7 @@ func (x *InstallerObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallerObject.ProtoReflect.Descriptor instead.
 func (*InstallerObject) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{2}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *InstallerObject) GetName() string {
@@ -347,6 +641,100 @@ func (x *InstallerObject) GetVersionList() []*VersionList {
 	return nil
 }
 
+func (x *InstallerObject) GetProvenance() *InstallerProvenance {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type InstallerProvenance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy   string `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Status   string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	SignedBy string `protobuf:"bytes,3,opt,name=signed_by,json=signedBy,proto3" json:"signed_by,omitempty"`
+	KeyId    string `protobuf:"bytes,4,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	FileHash string `protobuf:"bytes,5,opt,name=file_hash,json=fileHash,proto3" json:"file_hash,omitempty"`
+	Message  string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *InstallerProvenance) Reset() {
+	*x = InstallerProvenance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_repo_v1_repo_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallerProvenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallerProvenance) ProtoMessage() {}
+
+func (x *InstallerProvenance) ProtoReflect() protoreflect.Message {
+	mi := &file_api_repo_v1_repo_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallerProvenance.ProtoReflect.Descriptor instead.
+func (*InstallerProvenance) Descriptor() ([]byte, []int) {
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InstallerProvenance) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *InstallerProvenance) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InstallerProvenance) GetSignedBy() string {
+	if x != nil {
+		return x.SignedBy
+	}
+	return ""
+}
+
+func (x *InstallerProvenance) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *InstallerProvenance) GetFileHash() string {
+	if x != nil {
+		return x.FileHash
+	}
+	return ""
+}
+
+func (x *InstallerProvenance) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type CreateRepoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -359,7 +747,7 @@ type CreateRepoRequest struct {
 func (x *CreateRepoRequest) Reset() {
 	*x = CreateRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[3]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -372,7 +760,7 @@ func (x *CreateRepoRequest) String() string {
 func (*CreateRepoRequest) ProtoMessage() {}
 
 func (x *CreateRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[3]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,24 +773,212 @@ func (x *CreateRepoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRepoRequest.ProtoReflect.Descriptor instead.
 func (*CreateRepoRequest) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{3}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateRepoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRepoRequest) GetUrl() *CreateRepoRequest_RepoUrl {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+type CreateRepoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo *RepoObject `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+}
+
+func (x *CreateRepoResponse) Reset() {
+	*x = CreateRepoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_repo_v1_repo_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRepoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRepoResponse) ProtoMessage() {}
+
+func (x *CreateRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_repo_v1_repo_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRepoResponse.ProtoReflect.Descriptor instead.
+func (*CreateRepoResponse) Descriptor() ([]byte, []int) {
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateRepoResponse) GetRepo() *RepoObject {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+type DeleteRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteRepoRequest) Reset() {
+	*x = DeleteRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_repo_v1_repo_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRepoRequest) ProtoMessage() {}
+
+func (x *DeleteRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_repo_v1_repo_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRepoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteRepoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteRepoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo *RepoObject `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+}
+
+func (x *DeleteRepoResponse) Reset() {
+	*x = DeleteRepoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_repo_v1_repo_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRepoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRepoResponse) ProtoMessage() {}
+
+func (x *DeleteRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_repo_v1_repo_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRepoResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRepoResponse) Descriptor() ([]byte, []int) {
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteRepoResponse) GetRepo() *RepoObject {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+type GetRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *GetRepoRequest) Reset() {
+	*x = GetRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_repo_v1_repo_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoRequest) ProtoMessage() {}
+
+func (x *GetRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_repo_v1_repo_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *CreateRepoRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GetRepoRequest.ProtoReflect.Descriptor instead.
+func (*GetRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateRepoRequest) GetUrl() *CreateRepoRequest_RepoUrl {
+func (x *GetRepoRequest) GetName() string {
 	if x != nil {
-		return x.Url
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type CreateRepoResponse struct {
+type GetRepoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -410,23 +986,23 @@ type CreateRepoResponse struct {
 	Repo *RepoObject `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 }
 
-func (x *CreateRepoResponse) Reset() {
-	*x = CreateRepoResponse{}
+func (x *GetRepoResponse) Reset() {
+	*x = GetRepoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[4]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRepoResponse) String() string {
+func (x *GetRepoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRepoResponse) ProtoMessage() {}
+func (*GetRepoResponse) ProtoMessage() {}
 
-func (x *CreateRepoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[4]
+func (x *GetRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_repo_v1_repo_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,19 +1013,19 @@ func (x *CreateRepoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRepoResponse.ProtoReflect.Descriptor instead.
-func (*CreateRepoResponse) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetRepoResponse.ProtoReflect.Descriptor instead.
+func (*GetRepoResponse) Descriptor() ([]byte, []int) {
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateRepoResponse) GetRepo() *RepoObject {
+func (x *GetRepoResponse) GetRepo() *RepoObject {
 	if x != nil {
 		return x.Repo
 	}
 	return nil
 }
 
-type DeleteRepoRequest struct {
+type RefreshRepoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -457,23 +1033,23 @@ type DeleteRepoRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *DeleteRepoRequest) Reset() {
-	*x = DeleteRepoRequest{}
+func (x *RefreshRepoRequest) Reset() {
+	*x = RefreshRepoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[5]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteRepoRequest) String() string {
+func (x *RefreshRepoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRepoRequest) ProtoMessage() {}
+func (*RefreshRepoRequest) ProtoMessage() {}
 
-func (x *DeleteRepoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[5]
+func (x *RefreshRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_repo_v1_repo_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,19 +1060,19 @@ func (x *DeleteRepoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRepoRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRepoRequest) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RefreshRepoRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DeleteRepoRequest) GetName() string {
+func (x *RefreshRepoRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type DeleteRepoResponse struct {
+type RefreshRepoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -504,23 +1080,23 @@ type DeleteRepoResponse struct {
 	Repo *RepoObject `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 }
 
-func (x *DeleteRepoResponse) Reset() {
-	*x = DeleteRepoResponse{}
+func (x *RefreshRepoResponse) Reset() {
+	*x = RefreshRepoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[6]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteRepoResponse) String() string {
+func (x *RefreshRepoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRepoResponse) ProtoMessage() {}
+func (*RefreshRepoResponse) ProtoMessage() {}
 
-func (x *DeleteRepoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[6]
+func (x *RefreshRepoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_repo_v1_repo_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,12 +1107,12 @@ func (x *DeleteRepoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRepoResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRepoResponse) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RefreshRepoResponse.ProtoReflect.Descriptor instead.
+func (*RefreshRepoResponse) Descriptor() ([]byte, []int) {
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DeleteRepoResponse) GetRepo() *RepoObject {
+func (x *RefreshRepoResponse) GetRepo() *RepoObject {
 	if x != nil {
 		return x.Repo
 	}
@@ -554,7 +1130,7 @@ type ListRepoResponse struct {
 func (x *ListRepoResponse) Reset() {
 	*x = ListRepoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[7]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -567,7 +1143,7 @@ func (x *ListRepoResponse) String() string {
 func (*ListRepoResponse) ProtoMessage() {}
 
 func (x *ListRepoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[7]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +1156,7 @@ func (x *ListRepoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRepoResponse.ProtoReflect.Descriptor instead.
 func (*ListRepoResponse) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{7}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListRepoResponse) GetRepos() []*RepoObject {
@@ -606,7 +1182,7 @@ type ListAllRepoInstallerRequest struct {
 func (x *ListAllRepoInstallerRequest) Reset() {
 	*x = ListAllRepoInstallerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[8]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -619,7 +1195,7 @@ func (x *ListAllRepoInstallerRequest) String() string {
 func (*ListAllRepoInstallerRequest) ProtoMessage() {}
 
 func (x *ListAllRepoInstallerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[8]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,7 +1208,7 @@ func (x *ListAllRepoInstallerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllRepoInstallerRequest.ProtoReflect.Descriptor instead.
 func (*ListAllRepoInstallerRequest) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{8}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListAllRepoInstallerRequest) GetPageNum() int32 {
@@ -692,7 +1268,7 @@ type ListAllRepoInstallerResponse struct {
 func (x *ListAllRepoInstallerResponse) Reset() {
 	*x = ListAllRepoInstallerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[9]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +1281,7 @@ func (x *ListAllRepoInstallerResponse) String() string {
 func (*ListAllRepoInstallerResponse) ProtoMessage() {}
 
 func (x *ListAllRepoInstallerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[9]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +1294,7 @@ func (x *ListAllRepoInstallerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAllRepoInstallerResponse.ProtoReflect.Descriptor instead.
 func (*ListAllRepoInstallerResponse) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{9}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListAllRepoInstallerResponse) GetTotal() int32 {
@@ -773,7 +1349,7 @@ type ListRepoInstallerRequest struct {
 func (x *ListRepoInstallerRequest) Reset() {
 	*x = ListRepoInstallerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[10]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -786,7 +1362,7 @@ func (x *ListRepoInstallerRequest) String() string {
 func (*ListRepoInstallerRequest) ProtoMessage() {}
 
 func (x *ListRepoInstallerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[10]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,7 +1375,7 @@ func (x *ListRepoInstallerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRepoInstallerRequest.ProtoReflect.Descriptor instead.
 func (*ListRepoInstallerRequest) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{10}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListRepoInstallerRequest) GetPageNum() int32 {
@@ -866,7 +1442,7 @@ type ListRepoInstallerResponse struct {
 func (x *ListRepoInstallerResponse) Reset() {
 	*x = ListRepoInstallerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[11]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -879,7 +1455,7 @@ func (x *ListRepoInstallerResponse) String() string {
 func (*ListRepoInstallerResponse) ProtoMessage() {}
 
 func (x *ListRepoInstallerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[11]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,7 +1468,7 @@ func (x *ListRepoInstallerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRepoInstallerResponse.ProtoReflect.Descriptor instead.
 func (*ListRepoInstallerResponse) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{11}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListRepoInstallerResponse) GetTotal() int32 {
@@ -943,7 +1519,7 @@ type GetRepoInstallerRequest struct {
 func (x *GetRepoInstallerRequest) Reset() {
 	*x = GetRepoInstallerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[12]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -956,7 +1532,7 @@ func (x *GetRepoInstallerRequest) String() string {
 func (*GetRepoInstallerRequest) ProtoMessage() {}
 
 func (x *GetRepoInstallerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[12]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,7 +1545,7 @@ func (x *GetRepoInstallerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRepoInstallerRequest.ProtoReflect.Descriptor instead.
 func (*GetRepoInstallerRequest) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{12}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetRepoInstallerRequest) GetRepo() string {
@@ -1004,7 +1580,7 @@ type GetRepoInstallerResponse struct {
 func (x *GetRepoInstallerResponse) Reset() {
 	*x = GetRepoInstallerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[13]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1017,7 +1593,7 @@ func (x *GetRepoInstallerResponse) String() string {
 func (*GetRepoInstallerResponse) ProtoMessage() {}
 
 func (x *GetRepoInstallerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[13]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,7 +1606,7 @@ func (x *GetRepoInstallerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRepoInstallerResponse.ProtoReflect.Descriptor instead.
 func (*GetRepoInstallerResponse) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{13}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetRepoInstallerResponse) GetInstaller() *InstallerObject {
@@ -1053,7 +1629,7 @@ type InstallerObjectMaintainer struct {
 func (x *InstallerObjectMaintainer) Reset() {
 	*x = InstallerObjectMaintainer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[18]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1066,7 +1642,7 @@ func (x *InstallerObjectMaintainer) String() string {
 func (*InstallerObjectMaintainer) ProtoMessage() {}
 
 func (x *InstallerObjectMaintainer) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[18]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1079,7 +1655,7 @@ func (x *InstallerObjectMaintainer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallerObjectMaintainer.ProtoReflect.Descriptor instead.
 func (*InstallerObjectMaintainer) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{2, 2}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{5, 2}
 }
 
 func (x *InstallerObjectMaintainer) GetName() string {
@@ -1108,13 +1684,17 @@ type CreateRepoRequest_RepoUrl struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Url             string    `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Auth            *RepoAuth `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+	Verify          string    `protobuf:"bytes,3,opt,name=verify,proto3" json:"verify,omitempty"`
+	Keyring         string    `protobuf:"bytes,4,opt,name=keyring,proto3" json:"keyring,omitempty"`
+	RefreshInterval string    `protobuf:"bytes,5,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`
 }
 
 func (x *CreateRepoRequest_RepoUrl) Reset() {
 	*x = CreateRepoRequest_RepoUrl{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_repo_v1_repo_proto_msgTypes[19]
+		mi := &file_api_repo_v1_repo_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1127,7 +1707,7 @@ func (x *CreateRepoRequest_RepoUrl) String() string {
 func (*CreateRepoRequest_RepoUrl) ProtoMessage() {}
 
 func (x *CreateRepoRequest_RepoUrl) ProtoReflect() protoreflect.Message {
-	mi := &file_api_repo_v1_repo_proto_msgTypes[19]
+	mi := &file_api_repo_v1_repo_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,7 +1720,7 @@ func (x *CreateRepoRequest_RepoUrl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRepoRequest_RepoUrl.ProtoReflect.Descriptor instead.
 func (*CreateRepoRequest_RepoUrl) Descriptor() ([]byte, []int) {
-	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{3, 0}
+	return file_api_repo_v1_repo_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *CreateRepoRequest_RepoUrl) GetUrl() string {
@@ -1150,6 +1730,34 @@ func (x *CreateRepoRequest_RepoUrl) GetUrl() string {
 	return ""
 }
 
+func (x *CreateRepoRequest_RepoUrl) GetAuth() *RepoAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *CreateRepoRequest_RepoUrl) GetVerify() string {
+	if x != nil {
+		return x.Verify
+	}
+	return ""
+}
+
+func (x *CreateRepoRequest_RepoUrl) GetKeyring() string {
+	if x != nil {
+		return x.Keyring
+	}
+	return ""
+}
+
+func (x *CreateRepoRequest_RepoUrl) GetRefreshInterval() string {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return ""
+}
+
 var File_api_repo_v1_repo_proto protoreflect.FileDescriptor
 
 var file_api_repo_v1_repo_proto_rawDesc = []byte{
@@ -1163,7 +1771,7 @@ var file_api_repo_v1_repo_proto_rawDesc = []byte{
 	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
 	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
 	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xd0, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x22, 0xb7, 0x05, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
 	0x1f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92,
 	0x41, 0x08, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x12, 0x1d, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92,
@@ -1184,339 +1792,534 @@ var file_api_repo_v1_repo_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x05, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5,
 	0x8c, 0x85, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xcc, 0x07,
-	0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x65, 0x70,
-	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe4, 0xbb,
-	0x93, 0xe5, 0xba, 0x93, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x66, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x69,
-	0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5,
-	0x85, 0x83, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x6c, 0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65,
-	0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65,
-	0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0xb3, 0xa8,
-	0xe8, 0xa7, 0xa3, 0x52, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x69, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
-	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x0e,
-	0x92, 0x41, 0x0b, 0x32, 0x09, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe8, 0x80, 0x85, 0x52, 0x0b,
-	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e,
-	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe7, 0x8a,
-	0xb6, 0xe6, 0x80, 0x81, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x64,
-	0x65, 0x73, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06,
-	0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x2f, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9,
-	0x97, 0xb4, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a,
-	0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08,
-	0x32, 0x06, 0xe5, 0x9b, 0xbe, 0xe6, 0xa0, 0x87, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x5e,
-	0x0a, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
+	0x6c, 0x65, 0x72, 0x4e, 0x75, 0x6d, 0x12, 0x60, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
 	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x11,
-	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0x88, 0x97, 0xe8, 0xa1,
-	0xa8, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x3b,
-	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x0a, 0x6d,
-	0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x90,
-	0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06,
-	0xe9, 0x82, 0xae, 0xe7, 0xae, 0xb1, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a,
-	0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32,
-	0x06, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xcd, 0x01, 0x0a,
-	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x90, 0x8d,
-	0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x61, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe4, 0xb8,
+	0x8e, 0x20, 0x54, 0x4c, 0x53, 0x20, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0x8a, 0xb6, 0xe6,
+	0x80, 0x81, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x2f, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6,
+	0x9d, 0xa5, 0xe6, 0xba, 0x90, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0xe7, 0xad, 0x96, 0xe7, 0x95,
+	0xa5, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
+	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe7,
+	0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x3b, 0x0a,
+	0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb9, 0x03, 0x0a, 0x0a, 0x52,
+	0x65, 0x70, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x49, 0x0a, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8,
+	0x80, 0xe6, 0xac, 0xa1, 0xe6, 0x88, 0x90, 0xe5, 0x8a, 0x9f, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5,
+	0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0x92, 0x41, 0x31, 0x32, 0x2f, 0xe6,
+	0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe5, 0x90, 0x8c, 0xe6, 0xad,
+	0xa5, 0xe9, 0x94, 0x99, 0xe8, 0xaf, 0xaf, 0x2c, 0x20, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe6,
+	0x88, 0x90, 0xe5, 0x8a, 0x9f, 0xe5, 0x90, 0x8e, 0xe6, 0xb8, 0x85, 0xe7, 0xa9, 0xba, 0x52, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4b, 0x0a, 0x0f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4,
+	0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe5, 0x90, 0x8c, 0xe6, 0xad, 0xa5, 0xe9, 0x94, 0x99, 0xe8, 0xaf,
+	0xaf, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14, 0x92, 0x41, 0x11,
+	0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe6, 0x95, 0xb0, 0xe9, 0x87,
+	0x8f, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x49, 0x0a,
+	0x14, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x17, 0x92, 0x41, 0x14,
+	0x32, 0x12, 0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0x9f, 0xe6, 0x88, 0x90, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x12, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe9,
+	0x97, 0xb4, 0xe9, 0x9a, 0x94, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xa8, 0x03, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x20, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x90,
+	0x8d, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92,
+	0x41, 0x14, 0x32, 0x12, 0x42, 0x61, 0x73, 0x69, 0x63, 0x20, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81,
+	0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x28, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x12, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0xe4, 0xbb, 0xa4,
+	0xe7, 0x89, 0x8c, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x09, 0x63, 0x65,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92,
+	0x41, 0x1b, 0x32, 0x19, 0x50, 0x45, 0x4d, 0x20, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe5, 0xae,
+	0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe8, 0xaf, 0x81, 0xe4, 0xb9, 0xa6, 0x52, 0x08, 0x63,
+	0x65, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x32, 0x19,
+	0x50, 0x45, 0x4d, 0x20, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7,
+	0xe7, 0xab, 0xaf, 0xe7, 0xa7, 0x81, 0xe9, 0x92, 0xa5, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x14, 0x50, 0x45, 0x4d, 0x20, 0xe6, 0xa0,
+	0xbc, 0xe5, 0xbc, 0x8f, 0x20, 0x43, 0x41, 0x20, 0xe8, 0xaf, 0x81, 0xe4, 0xb9, 0xa6, 0x52, 0x06,
+	0x63, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x59, 0x0a, 0x18, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe8,
+	0xb7, 0xb3, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0xab, 0xaf, 0xe8, 0xaf,
+	0x81, 0xe4, 0xb9, 0xa6, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0x52, 0x15, 0x69, 0x6e, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x54, 0x6c, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x22, 0x9b, 0x03, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0x42, 0x61, 0x73,
+	0x69, 0x63, 0x20, 0xe8, 0xae, 0xa4, 0xe8, 0xaf, 0x81, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5,
+	0x90, 0x8d, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0c,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8,
+	0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe5, 0xaf, 0x86, 0xe7, 0xa0, 0x81, 0x52, 0x0b, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1f, 0x92, 0x41, 0x1c,
+	0x32, 0x1a, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0x20, 0x42,
+	0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0xe4, 0xbb, 0xa4, 0xe7, 0x89, 0x8c, 0x52, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x48, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xae, 0xbe, 0xe7,
+	0xbd, 0xae, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe8, 0xaf, 0x81, 0xe4, 0xb9,
+	0xa6, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x53, 0x65, 0x74,
+	0x12, 0x32, 0x0a, 0x06, 0x63, 0x61, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x16, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0xae, 0xbe,
+	0xe7, 0xbd, 0xae, 0x20, 0x43, 0x41, 0x20, 0xe8, 0xaf, 0x81, 0xe4, 0xb9, 0xa6, 0x52, 0x05, 0x63,
+	0x61, 0x53, 0x65, 0x74, 0x12, 0x59, 0x0a, 0x18, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe8, 0xb7, 0xb3,
+	0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0xab, 0xaf, 0xe8, 0xaf, 0x81, 0xe4,
+	0xb9, 0xa6, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0x52, 0x15, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x54, 0x6c, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x22,
+	0x48, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb7, 0x08, 0x0a, 0x0f, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1f, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08,
+	0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93,
+	0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x66, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b,
+	0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5, 0x85, 0x83, 0xe6, 0x95,
+	0xb0, 0xe6, 0x8d, 0xae, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x6c,
+	0x0a, 0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0xb3, 0xa8, 0xe8, 0xa7, 0xa3, 0x52,
+	0x0b, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x69, 0x0a, 0x0b,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x37, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32,
+	0x09, 0xe7, 0xbb, 0xb4, 0xe6, 0x8a, 0xa4, 0xe8, 0x80, 0x85, 0x52, 0x0b, 0x6d, 0x61, 0x69, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
 	0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x42, 0x17,
-	0x92, 0x41, 0x14, 0x32, 0x12, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d,
-	0x80, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0x2e, 0x0a, 0x07,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x63, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f, 0x8f, 0xe8,
+	0xbf, 0xb0, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x32, 0x0c, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x04, 0x69, 0x63, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x9b,
+	0xbe, 0xe6, 0xa0, 0x87, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x0c, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x0b, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x69, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x9d, 0xa5, 0xe6, 0xba, 0x90, 0xe6, 0xa0, 0xa1,
+	0xe9, 0xaa, 0x8c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x6f, 0x0a, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b,
+	0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0x82, 0xae, 0xe7, 0xae, 0xb1, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x22, 0xe5, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41, 0x2a,
+	0x32, 0x28, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x3a, 0x20,
+	0x6e, 0x6f, 0x6e, 0x65, 0x2c, 0x20, 0x69, 0x66, 0x2d, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x2c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x4e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x33, 0x32, 0x31, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0xe7,
+	0x8a, 0xb6, 0xe6, 0x80, 0x81, 0x3a, 0x20, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x2c, 0x20,
+	0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x2c, 0x20, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x2c, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2b, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe7, 0xad, 0xbe, 0xe5,
+	0x90, 0x8d, 0xe8, 0x80, 0x85, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x2a, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe7, 0xad, 0xbe, 0xe5, 0x90, 0x8d, 0xe5, 0xaf, 0x86, 0xe9,
+	0x92, 0xa5, 0x49, 0x44, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
+	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x93,
+	0x88, 0xe5, 0xb8, 0x8c, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2b,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0xe4, 0xbf, 0xa1, 0xe6,
+	0x81, 0xaf, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9b, 0x04, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7,
+	0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x61, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x42, 0x17, 0x92,
+	0x41, 0x14, 0x32, 0x12, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80,
+	0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0xfb, 0x02, 0x0a, 0x07,
 	0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5, 0xba,
-	0x93, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x5e, 0x0a, 0x12,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x48, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x27, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x70, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06,
-	0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x3a, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x93, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x5a, 0x0a, 0x04,
+	0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6f, 0x2e,
+	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x41, 0x75, 0x74,
+	0x68, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe8, 0xae,
+	0xa4, 0xe8, 0xaf, 0x81, 0xe4, 0xb8, 0x8e, 0x20, 0x54, 0x4c, 0x53, 0x20, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x4b, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2e, 0xe6,
+	0x9d, 0xa5, 0xe6, 0xba, 0x90, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c, 0xe7, 0xad, 0x96, 0xe7, 0x95,
+	0xa5, 0x3a, 0x20, 0x6e, 0x6f, 0x6e, 0x65, 0x2c, 0x20, 0x69, 0x66, 0x2d, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x74, 0x2c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x06, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x41, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x92, 0x41, 0x24, 0x32, 0x22, 0x41, 0x53, 0x43,
+	0x49, 0x49, 0x20, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x65, 0x64, 0x20, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc,
+	0x8f, 0x20, 0x50, 0x47, 0x50, 0x20, 0xe5, 0x85, 0xac, 0xe9, 0x92, 0xa5, 0xe7, 0x8e, 0xaf, 0x52,
+	0x07, 0x6b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x5f, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x34, 0x92, 0x41, 0x31, 0x32, 0x2f, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe9,
+	0x97, 0xb4, 0xe9, 0x9a, 0x94, 0x2c, 0x20, 0xe4, 0xbe, 0x8b, 0xe5, 0xa6, 0x82, 0x20, 0x31, 0x30,
+	0x6d, 0x2c, 0x20, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe9,
+	0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe5, 0x80, 0xbc, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x5e, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe4, 0xbb, 0x93,
+	0xe5, 0xba, 0x93, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x3a, 0x0a, 0x11, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x04, 0x72,
+	0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
+	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0x52,
+	0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5,
+	0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5b,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe4,
+	0xbb, 0x93, 0xe5, 0xba, 0x93, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x3b, 0x0a, 0x12, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7,
-	0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
-	0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69,
+	0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x13, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe4, 0xbb, 0x93,
+	0xe5, 0xba, 0x93, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69,
 	0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe4, 0xbb, 0x93, 0xe5,
-	0xba, 0x93, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x05,
-	0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6f,
-	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5, 0xba,
-	0x93, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0xbf,
-	0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
-	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70,
-	0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6,
-	0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x42, 0x79, 0x12, 0x36, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x32, 0x0c, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x0c,
-	0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x09,
-	0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa0, 0xe5, 0x85, 0xb3, 0xe9,
-	0x94, 0xae, 0xe5, 0xad, 0x97, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12,
-	0x2f, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5,
-	0xae, 0x89, 0xe8, 0xa3, 0x85, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
-	0x22, 0xcc, 0x02, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5,
+	0xba, 0x93, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22,
+	0xbf, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07,
+	0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x36, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52,
+	0x0c, 0x69, 0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a,
+	0x09, 0x6b, 0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa0, 0xe5, 0x85, 0xb3,
+	0xe9, 0x94, 0xae, 0xe5, 0xad, 0x97, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x2f, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
+	0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x64, 0x22, 0xcc, 0x02, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70,
+	0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0xe9, 0x87,
+	0x8f, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32,
+	0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d,
+	0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5,
+	0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x73, 0x0a, 0x10, 0x62, 0x72, 0x69, 0x65, 0x66, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6f, 0x2e,
+	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe5,
+	0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe7, 0xae, 0x80, 0xe8, 0xa6, 0x81, 0xe4, 0xbf,
+	0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0f, 0x62, 0x72, 0x69, 0x65, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14, 0x92, 0x41,
+	0x11, 0x32, 0x0f, 0xe5, 0xb7, 0xb2, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe6, 0x95, 0xb0, 0xe9,
+	0x87, 0x8f, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4e, 0x75, 0x6d,
+	0x22, 0xe3, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61,
+	0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6,
+	0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8e,
+	0x92, 0xe5, 0xba, 0x8f, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x79, 0x12, 0x36, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x0c, 0x69,
+	0x73, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x09, 0x6b,
+	0x65, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
+	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe5, 0x85, 0xb3, 0xe9, 0x94,
+	0xae, 0xe5, 0xad, 0x97, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x25,
+	0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x2f, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6,
+	0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0x52, 0x09, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xc9, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0,
+	0xe9, 0x87, 0x8f, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x92, 0x41,
+	0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e,
+	0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9,
+	0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x73, 0x0a, 0x10, 0x62, 0x72, 0x69, 0x65, 0x66, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69,
+	0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32,
+	0x15, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe7, 0xae, 0x80, 0xe8, 0xa6, 0x81,
+	0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0f, 0x62, 0x72, 0x69, 0x65, 0x66, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14,
+	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe6, 0x95,
+	0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4e,
+	0x75, 0x6d, 0x22, 0xc0, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x3b, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92,
+	0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0x8d,
+	0xe7, 0xa7, 0xb0, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x41, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92,
+	0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe7, 0x89, 0x88,
+	0xe6, 0x9c, 0xac, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f,
 	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06,
-	0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x12,
-	0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6,
-	0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x73, 0x0a, 0x10, 0x62, 0x72, 0x69, 0x65, 0x66, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
-	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe5, 0xae,
-	0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe7, 0xae, 0x80, 0xe8, 0xa6, 0x81, 0xe4, 0xbf, 0xa1,
-	0xe6, 0x81, 0xaf, 0x52, 0x0f, 0x62, 0x72, 0x69, 0x65, 0x66, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14, 0x92, 0x41, 0x11,
-	0x32, 0x0f, 0xe5, 0xb7, 0xb2, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe6, 0x95, 0xb0, 0xe9, 0x87,
-	0x8f, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x22,
-	0xe3, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b,
-	0x92, 0x41, 0x08, 0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61, 0x67,
-	0x65, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf,
-	0x8f, 0xe9, 0xa1, 0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8e, 0x92,
-	0xe5, 0xba, 0x8f, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x42, 0x79, 0x12, 0x36, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
-	0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x80, 0x92, 0xe5, 0xba, 0x8f, 0x52, 0x0c, 0x69, 0x73,
-	0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x31, 0x0a, 0x09, 0x6b, 0x65,
-	0x79, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92,
-	0x41, 0x11, 0x32, 0x0f, 0xe6, 0x90, 0x9c, 0xe7, 0xb4, 0xa2, 0xe5, 0x85, 0xb3, 0xe9, 0x94, 0xae,
-	0xe5, 0xad, 0x97, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x25, 0x0a,
-	0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04,
-	0x72, 0x65, 0x70, 0x6f, 0x12, 0x2f, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98,
-	0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xc9, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x80, 0xbb, 0xe6, 0x95, 0xb0, 0xe9,
-	0x87, 0x8f, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x08, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x92, 0x41, 0x08,
-	0x32, 0x06, 0xe9, 0xa1, 0xb5, 0xe7, 0xa0, 0x81, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75,
-	0x6d, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1,
-	0xb5, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x73, 0x0a, 0x10, 0x62, 0x72, 0x69, 0x65, 0x66, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6f,
-	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15,
-	0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe7, 0xae, 0x80, 0xe8, 0xa6, 0x81, 0xe4,
-	0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0f, 0x62, 0x72, 0x69, 0x65, 0x66, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x14, 0x92,
-	0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe6, 0x95, 0xb0,
-	0xe9, 0x87, 0x8f, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x4e, 0x75,
-	0x6d, 0x22, 0xc0, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
-	0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x32, 0x0c, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04,
-	0x72, 0x65, 0x70, 0x6f, 0x12, 0x3b, 0x0a, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41,
-	0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0x8d, 0xe7,
-	0xa7, 0xb0, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x41, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41,
-	0x11, 0x32, 0x0f, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe7, 0x89, 0x88, 0xe6,
-	0x9c, 0xac, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5a, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c,
-	0x85, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x2a, 0x3d, 0x0a, 0x0e,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0d,
-	0x0a, 0x09, 0x55, 0x4e, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
-	0x53, 0x41, 0x4d, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x32, 0xe2, 0x0d, 0x0a, 0x04,
-	0x52, 0x65, 0x70, 0x6f, 0x12, 0xf7, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x12, 0x2e, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xa0, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x14, 0x22, 0x0d, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x3a, 0x03, 0x75, 0x72, 0x6c, 0x92, 0x41, 0x82, 0x01, 0x0a, 0x04, 0x52, 0x65,
-	0x70, 0x6f, 0x12, 0x12, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93,
-	0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x70, 0x6f, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55, 0x43,
-	0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41,
-	0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03,
-	0x34, 0x30, 0x39, 0x12, 0x10, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45,
-	0x58, 0x49, 0x53, 0x54, 0x53, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x12, 0x8b,
-	0x02, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x2e, 0x2e,
-	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
-	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b,
-	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x2a, 0x0d, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f,
-	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x92, 0x41, 0x82, 0x01, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f,
-	0x12, 0x12, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe6, 0x8e,
-	0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x65, 0x12, 0x5a, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5,
+	0x8c, 0x85, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x2a, 0x3d, 0x0a,
+	0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x32, 0xde, 0x11, 0x0a,
+	0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0xf7, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x12, 0x2e, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0xa0, 0x01, 0x92,
+	0x41, 0x82, 0x01, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x12, 0xe5, 0x88, 0x9b, 0xe5, 0xbb,
+	0xba, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30,
+	0x12, 0x06, 0x0a, 0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12,
+	0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x34, 0x30, 0x39, 0x12, 0x10, 0x0a, 0x0e, 0x41, 0x4c,
+	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x4a, 0x17, 0x0a, 0x03,
+	0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f,
+	0x53, 0x54, 0x4f, 0x52, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x03, 0x75, 0x72, 0x6c,
+	0x22, 0x0d, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12,
+	0x8b, 0x02, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x2e,
+	0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9b, 0x01, 0x92, 0x41, 0x82, 0x01, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x12, 0xe5, 0x88,
+	0xa0, 0xe9, 0x99, 0xa4, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3,
+	0x2a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x4a, 0x0d, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34,
+	0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52,
+	0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x10, 0x0a,
+	0x0e, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a,
+	0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x2a, 0x0d,
+	0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0xd1, 0x01,
+	0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x2d, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x7e, 0x92, 0x41, 0x6d, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x18, 0xe8, 0x8e,
+	0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8,
+	0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f,
 	0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55, 0x43, 0x43, 0x4a,
 	0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
-	0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x34, 0x30,
-	0x34, 0x12, 0x10, 0x0a, 0x0e, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
-	0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e,
-	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x12, 0xd1, 0x01, 0x0a,
-	0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x2d, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x7e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x08, 0x12, 0x06, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73,
-	0x92, 0x41, 0x6d, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x18, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f,
-	0x96, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe6, 0x8e, 0xa5,
-	0xe5, 0x8f, 0xa3, 0x2a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4a, 0x0d, 0x0a,
-	0x03, 0x32, 0x30, 0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03,
-	0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41,
-	0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10,
-	0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45,
-	0x12, 0xb6, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x38, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
+	0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30,
+	0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54,
+	0x4f, 0x52, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x08, 0x12, 0x06, 0x2f, 0x72, 0x65, 0x70, 0x6f,
+	0x73, 0x12, 0xeb, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x2b, 0x2e,
+	0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x6f, 0x2e,
+	0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x84, 0x01, 0x92, 0x41, 0x6c, 0x0a, 0x04,
+	0x52, 0x65, 0x70, 0x6f, 0x12, 0x18, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xbb, 0x93, 0xe5,
+	0xba, 0x93, 0xe8, 0xaf, 0xa6, 0xe6, 0x83, 0x85, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x07,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06,
+	0x0a, 0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a,
+	0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e,
+	0x54, 0x4a, 0x17, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x10, 0x0a, 0x0e, 0x52, 0x45, 0x50, 0x4f,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
+	0x12, 0x0d, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12,
+	0xa4, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x12,
+	0x2f, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xb1, 0x01, 0x92, 0x41, 0x8d, 0x01, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12,
+	0x1e, 0xe7, 0xab, 0x8b, 0xe5, 0x8d, 0xb3, 0xe5, 0x88, 0xb7, 0xe6, 0x96, 0xb0, 0xe4, 0xbb, 0x93,
+	0xe5, 0xba, 0x93, 0xe7, 0xb4, 0xa2, 0xe5, 0xbc, 0x95, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a,
+	0x0b, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x4a, 0x0d, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34,
+	0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52,
+	0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x10, 0x0a,
+	0x0e, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a,
+	0x15, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x0e, 0x0a, 0x0c, 0x52, 0x45, 0x46, 0x52, 0x45, 0x53,
+	0x48, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22,
+	0x15, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x12, 0xb6, 0x02, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x38, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x69, 0x6f, 0x2e, 0x74,
 	0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52,
-	0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa8,
-	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x92, 0x41, 0x8b, 0x01, 0x0a, 0x04,
-	0x52, 0x65, 0x70, 0x6f, 0x12, 0x2a, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x89, 0x80, 0xe6,
-	0x9c, 0x89, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x89, 0xe8, 0xa3,
-	0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3,
-	0x2a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06, 0x0a,
-	0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10,
-	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54,
-	0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52,
-	0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x12, 0xc4, 0x02, 0x0a, 0x11, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12,
-	0x35, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65,
-	0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbf,
-	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f,
-	0x7b, 0x72, 0x65, 0x70, 0x6f, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72,
-	0x73, 0x92, 0x41, 0x9b, 0x01, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x24, 0xe8, 0x8e, 0xb7,
-	0xe5, 0x8f, 0x96, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe4, 0xb8, 0xad, 0xe5, 0xae, 0x89, 0xe8,
-	0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f,
-	0xa3, 0x2a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x65, 0x72, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06, 0x0a, 0x04, 0x53,
-	0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e,
-	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17,
-	0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x10, 0x0a, 0x0e, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x4e, 0x4f,
-	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10,
-	0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45,
-	0x12, 0xfd, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x34, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa8, 0x01, 0x92, 0x41, 0x8b, 0x01, 0x0a, 0x04, 0x52, 0x65, 0x70,
+	0x6f, 0x12, 0x2a, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe4,
+	0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c,
+	0x85, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55,
+	0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a,
+	0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12,
+	0xc4, 0x02, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c,
 	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x69, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x69,
+	0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbf, 0x01, 0x92, 0x41, 0x9b, 0x01, 0x0a, 0x04, 0x52, 0x65, 0x70,
+	0x6f, 0x12, 0x24, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xbb, 0x93, 0xe5, 0xba, 0x93, 0xe4,
+	0xb8, 0xad, 0xe5, 0xae, 0x89, 0xe8, 0xa3, 0x85, 0xe5, 0x8c, 0x85, 0xe5, 0x88, 0x97, 0xe8, 0xa1,
+	0xa8, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0x2a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x06, 0x0a, 0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30,
+	0x12, 0x12, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55,
+	0x4d, 0x45, 0x4e, 0x54, 0x4a, 0x17, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x10, 0x0a, 0x0e, 0x52,
+	0x45, 0x50, 0x4f, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a,
+	0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x70, 0x6f, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0xe4, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x34, 0x2e, 0x69, 0x6f,
 	0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70,
 	0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
-	0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xfb, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x72, 0x65,
-	0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x70, 0x6f, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
-	0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x92, 0x41, 0xb2, 0x01, 0x0a, 0x04,
-	0x52, 0x65, 0x70, 0x6f, 0x12, 0x1e, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xbb, 0x93, 0xe5,
-	0xba, 0x93, 0xe4, 0xb8, 0xad, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe5, 0xae, 0x89, 0xe8, 0xa3,
-	0x85, 0xe5, 0x8c, 0x85, 0x2a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06, 0x0a,
-	0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a, 0x10,
-	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54,
-	0x4a, 0x17, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x10, 0x0a, 0x0e, 0x52, 0x45, 0x50, 0x4f, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x1c, 0x0a, 0x03, 0x34, 0x30, 0x34,
-	0x12, 0x15, 0x0a, 0x13, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x4e, 0x4f,
-	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a, 0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10,
-	0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45,
-	0x42, 0x49, 0x0a, 0x1b, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x50,
-	0x01, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b,
-	0x65, 0x65, 0x6c, 0x2d, 0x69, 0x6f, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x72, 0x65, 0x70, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x35, 0x2e, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe2, 0x01, 0x92, 0x41, 0x99, 0x01, 0x0a,
+	0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1e, 0xe8, 0x8e, 0xb7, 0xe5, 0x8f, 0x96, 0xe4, 0xbb, 0x93,
+	0xe5, 0xba, 0x93, 0xe4, 0xb8, 0xad, 0xe6, 0x8c, 0x87, 0xe5, 0xae, 0x9a, 0xe5, 0xae, 0x89, 0xe8,
+	0xa3, 0x85, 0xe5, 0x8c, 0x85, 0x2a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x4a, 0x0d, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x06,
+	0x0a, 0x04, 0x53, 0x55, 0x43, 0x43, 0x4a, 0x19, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x12, 0x0a,
+	0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e,
+	0x54, 0x4a, 0x1c, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x15, 0x0a, 0x13, 0x49, 0x4e, 0x53, 0x54,
+	0x41, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x4a,
+	0x17, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x10, 0x0a, 0x0e, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e,
+	0x41, 0x4c, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d,
+	0x2f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x70, 0x6f, 0x7d, 0x2f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x42, 0x49, 0x0a,
+	0x1b, 0x69, 0x6f, 0x2e, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c,
+	0x2d, 0x69, 0x6f, 0x2f, 0x74, 0x6b, 0x65, 0x65, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
+	0x70, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1532,63 +2335,81 @@ func file_api_repo_v1_repo_proto_rawDescGZIP() []byte {
 }
 
 var file_api_repo_v1_repo_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_repo_v1_repo_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_api_repo_v1_repo_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_api_repo_v1_repo_proto_goTypes = []interface{}{
 	(InstallerState)(0),                  // 0: io.tkeel.plugin.api.repo.v1.InstallerState
 	(*RepoObject)(nil),                   // 1: io.tkeel.plugin.api.repo.v1.RepoObject
-	(*VersionList)(nil),                  // 2: io.tkeel.plugin.api.repo.v1.VersionList
-	(*InstallerObject)(nil),              // 3: io.tkeel.plugin.api.repo.v1.InstallerObject
-	(*CreateRepoRequest)(nil),            // 4: io.tkeel.plugin.api.repo.v1.CreateRepoRequest
-	(*CreateRepoResponse)(nil),           // 5: io.tkeel.plugin.api.repo.v1.CreateRepoResponse
-	(*DeleteRepoRequest)(nil),            // 6: io.tkeel.plugin.api.repo.v1.DeleteRepoRequest
-	(*DeleteRepoResponse)(nil),           // 7: io.tkeel.plugin.api.repo.v1.DeleteRepoResponse
-	(*ListRepoResponse)(nil),             // 8: io.tkeel.plugin.api.repo.v1.ListRepoResponse
-	(*ListAllRepoInstallerRequest)(nil),  // 9: io.tkeel.plugin.api.repo.v1.ListAllRepoInstallerRequest
-	(*ListAllRepoInstallerResponse)(nil), // 10: io.tkeel.plugin.api.repo.v1.ListAllRepoInstallerResponse
-	(*ListRepoInstallerRequest)(nil),     // 11: io.tkeel.plugin.api.repo.v1.ListRepoInstallerRequest
-	(*ListRepoInstallerResponse)(nil),    // 12: io.tkeel.plugin.api.repo.v1.ListRepoInstallerResponse
-	(*GetRepoInstallerRequest)(nil),      // 13: io.tkeel.plugin.api.repo.v1.GetRepoInstallerRequest
-	(*GetRepoInstallerResponse)(nil),     // 14: io.tkeel.plugin.api.repo.v1.GetRepoInstallerResponse
-	nil,                                  // 15: io.tkeel.plugin.api.repo.v1.RepoObject.MetadataEntry
-	nil,                                  // 16: io.tkeel.plugin.api.repo.v1.RepoObject.AnnotationsEntry
-	nil,                                  // 17: io.tkeel.plugin.api.repo.v1.InstallerObject.MetadataEntry
-	nil,                                  // 18: io.tkeel.plugin.api.repo.v1.InstallerObject.AnnotationsEntry
-	(*InstallerObjectMaintainer)(nil),    // 19: io.tkeel.plugin.api.repo.v1.InstallerObject.maintainer
-	(*CreateRepoRequest_RepoUrl)(nil),    // 20: io.tkeel.plugin.api.repo.v1.CreateRepoRequest.RepoUrl
-	(*emptypb.Empty)(nil),                // 21: google.protobuf.Empty
+	(*RepoStatus)(nil),                   // 2: io.tkeel.plugin.api.repo.v1.RepoStatus
+	(*RepoAuth)(nil),                     // 3: io.tkeel.plugin.api.repo.v1.RepoAuth
+	(*RepoAuthStatus)(nil),               // 4: io.tkeel.plugin.api.repo.v1.RepoAuthStatus
+	(*VersionList)(nil),                  // 5: io.tkeel.plugin.api.repo.v1.VersionList
+	(*InstallerObject)(nil),              // 6: io.tkeel.plugin.api.repo.v1.InstallerObject
+	(*InstallerProvenance)(nil),          // 7: io.tkeel.plugin.api.repo.v1.InstallerProvenance
+	(*CreateRepoRequest)(nil),            // 8: io.tkeel.plugin.api.repo.v1.CreateRepoRequest
+	(*CreateRepoResponse)(nil),           // 9: io.tkeel.plugin.api.repo.v1.CreateRepoResponse
+	(*DeleteRepoRequest)(nil),            // 10: io.tkeel.plugin.api.repo.v1.DeleteRepoRequest
+	(*DeleteRepoResponse)(nil),           // 11: io.tkeel.plugin.api.repo.v1.DeleteRepoResponse
+	(*GetRepoRequest)(nil),               // 12: io.tkeel.plugin.api.repo.v1.GetRepoRequest
+	(*GetRepoResponse)(nil),              // 13: io.tkeel.plugin.api.repo.v1.GetRepoResponse
+	(*RefreshRepoRequest)(nil),           // 14: io.tkeel.plugin.api.repo.v1.RefreshRepoRequest
+	(*RefreshRepoResponse)(nil),          // 15: io.tkeel.plugin.api.repo.v1.RefreshRepoResponse
+	(*ListRepoResponse)(nil),             // 16: io.tkeel.plugin.api.repo.v1.ListRepoResponse
+	(*ListAllRepoInstallerRequest)(nil),  // 17: io.tkeel.plugin.api.repo.v1.ListAllRepoInstallerRequest
+	(*ListAllRepoInstallerResponse)(nil), // 18: io.tkeel.plugin.api.repo.v1.ListAllRepoInstallerResponse
+	(*ListRepoInstallerRequest)(nil),     // 19: io.tkeel.plugin.api.repo.v1.ListRepoInstallerRequest
+	(*ListRepoInstallerResponse)(nil),    // 20: io.tkeel.plugin.api.repo.v1.ListRepoInstallerResponse
+	(*GetRepoInstallerRequest)(nil),      // 21: io.tkeel.plugin.api.repo.v1.GetRepoInstallerRequest
+	(*GetRepoInstallerResponse)(nil),     // 22: io.tkeel.plugin.api.repo.v1.GetRepoInstallerResponse
+	nil,                                  // 23: io.tkeel.plugin.api.repo.v1.RepoObject.MetadataEntry
+	nil,                                  // 24: io.tkeel.plugin.api.repo.v1.RepoObject.AnnotationsEntry
+	nil,                                  // 25: io.tkeel.plugin.api.repo.v1.InstallerObject.MetadataEntry
+	nil,                                  // 26: io.tkeel.plugin.api.repo.v1.InstallerObject.AnnotationsEntry
+	(*InstallerObjectMaintainer)(nil),    // 27: io.tkeel.plugin.api.repo.v1.InstallerObject.maintainer
+	(*CreateRepoRequest_RepoUrl)(nil),    // 28: io.tkeel.plugin.api.repo.v1.CreateRepoRequest.RepoUrl
+	(*emptypb.Empty)(nil),                // 29: google.protobuf.Empty
 }
 var file_api_repo_v1_repo_proto_depIdxs = []int32{
-	15, // 0: io.tkeel.plugin.api.repo.v1.RepoObject.metadata:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject.MetadataEntry
-	16, // 1: io.tkeel.plugin.api.repo.v1.RepoObject.annotations:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject.AnnotationsEntry
-	17, // 2: io.tkeel.plugin.api.repo.v1.InstallerObject.metadata:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject.MetadataEntry
-	18, // 3: io.tkeel.plugin.api.repo.v1.InstallerObject.annotations:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject.AnnotationsEntry
-	19, // 4: io.tkeel.plugin.api.repo.v1.InstallerObject.maintainers:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject.maintainer
-	0,  // 5: io.tkeel.plugin.api.repo.v1.InstallerObject.state:type_name -> io.tkeel.plugin.api.repo.v1.InstallerState
-	2,  // 6: io.tkeel.plugin.api.repo.v1.InstallerObject.version_list:type_name -> io.tkeel.plugin.api.repo.v1.VersionList
-	20, // 7: io.tkeel.plugin.api.repo.v1.CreateRepoRequest.url:type_name -> io.tkeel.plugin.api.repo.v1.CreateRepoRequest.RepoUrl
-	1,  // 8: io.tkeel.plugin.api.repo.v1.CreateRepoResponse.repo:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject
-	1,  // 9: io.tkeel.plugin.api.repo.v1.DeleteRepoResponse.repo:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject
-	1,  // 10: io.tkeel.plugin.api.repo.v1.ListRepoResponse.repos:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject
-	3,  // 11: io.tkeel.plugin.api.repo.v1.ListAllRepoInstallerResponse.brief_installers:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject
-	3,  // 12: io.tkeel.plugin.api.repo.v1.ListRepoInstallerResponse.brief_installers:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject
-	3,  // 13: io.tkeel.plugin.api.repo.v1.GetRepoInstallerResponse.installer:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject
-	4,  // 14: io.tkeel.plugin.api.repo.v1.Repo.CreateRepo:input_type -> io.tkeel.plugin.api.repo.v1.CreateRepoRequest
-	6,  // 15: io.tkeel.plugin.api.repo.v1.Repo.DeleteRepo:input_type -> io.tkeel.plugin.api.repo.v1.DeleteRepoRequest
-	21, // 16: io.tkeel.plugin.api.repo.v1.Repo.ListRepo:input_type -> google.protobuf.Empty
-	9,  // 17: io.tkeel.plugin.api.repo.v1.Repo.ListAllRepoInstaller:input_type -> io.tkeel.plugin.api.repo.v1.ListAllRepoInstallerRequest
-	11, // 18: io.tkeel.plugin.api.repo.v1.Repo.ListRepoInstaller:input_type -> io.tkeel.plugin.api.repo.v1.ListRepoInstallerRequest
-	13, // 19: io.tkeel.plugin.api.repo.v1.Repo.GetRepoInstaller:input_type -> io.tkeel.plugin.api.repo.v1.GetRepoInstallerRequest
-	21, // 20: io.tkeel.plugin.api.repo.v1.Repo.CreateRepo:output_type -> google.protobuf.Empty
-	7,  // 21: io.tkeel.plugin.api.repo.v1.Repo.DeleteRepo:output_type -> io.tkeel.plugin.api.repo.v1.DeleteRepoResponse
-	8,  // 22: io.tkeel.plugin.api.repo.v1.Repo.ListRepo:output_type -> io.tkeel.plugin.api.repo.v1.ListRepoResponse
-	10, // 23: io.tkeel.plugin.api.repo.v1.Repo.ListAllRepoInstaller:output_type -> io.tkeel.plugin.api.repo.v1.ListAllRepoInstallerResponse
-	12, // 24: io.tkeel.plugin.api.repo.v1.Repo.ListRepoInstaller:output_type -> io.tkeel.plugin.api.repo.v1.ListRepoInstallerResponse
-	14, // 25: io.tkeel.plugin.api.repo.v1.Repo.GetRepoInstaller:output_type -> io.tkeel.plugin.api.repo.v1.GetRepoInstallerResponse
-	20, // [20:26] is the sub-list for method output_type
-	14, // [14:20] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	23, // 0: io.tkeel.plugin.api.repo.v1.RepoObject.metadata:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject.MetadataEntry
+	24, // 1: io.tkeel.plugin.api.repo.v1.RepoObject.annotations:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject.AnnotationsEntry
+	4,  // 2: io.tkeel.plugin.api.repo.v1.RepoObject.auth:type_name -> io.tkeel.plugin.api.repo.v1.RepoAuthStatus
+	2,  // 3: io.tkeel.plugin.api.repo.v1.RepoObject.status:type_name -> io.tkeel.plugin.api.repo.v1.RepoStatus
+	25, // 4: io.tkeel.plugin.api.repo.v1.InstallerObject.metadata:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject.MetadataEntry
+	26, // 5: io.tkeel.plugin.api.repo.v1.InstallerObject.annotations:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject.AnnotationsEntry
+	27, // 6: io.tkeel.plugin.api.repo.v1.InstallerObject.maintainers:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject.maintainer
+	0,  // 7: io.tkeel.plugin.api.repo.v1.InstallerObject.state:type_name -> io.tkeel.plugin.api.repo.v1.InstallerState
+	5,  // 8: io.tkeel.plugin.api.repo.v1.InstallerObject.version_list:type_name -> io.tkeel.plugin.api.repo.v1.VersionList
+	7,  // 9: io.tkeel.plugin.api.repo.v1.InstallerObject.provenance:type_name -> io.tkeel.plugin.api.repo.v1.InstallerProvenance
+	28, // 10: io.tkeel.plugin.api.repo.v1.CreateRepoRequest.url:type_name -> io.tkeel.plugin.api.repo.v1.CreateRepoRequest.RepoUrl
+	1,  // 11: io.tkeel.plugin.api.repo.v1.CreateRepoResponse.repo:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject
+	1,  // 12: io.tkeel.plugin.api.repo.v1.DeleteRepoResponse.repo:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject
+	1,  // 13: io.tkeel.plugin.api.repo.v1.GetRepoResponse.repo:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject
+	1,  // 14: io.tkeel.plugin.api.repo.v1.RefreshRepoResponse.repo:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject
+	1,  // 15: io.tkeel.plugin.api.repo.v1.ListRepoResponse.repos:type_name -> io.tkeel.plugin.api.repo.v1.RepoObject
+	6,  // 16: io.tkeel.plugin.api.repo.v1.ListAllRepoInstallerResponse.brief_installers:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject
+	6,  // 17: io.tkeel.plugin.api.repo.v1.ListRepoInstallerResponse.brief_installers:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject
+	6,  // 18: io.tkeel.plugin.api.repo.v1.GetRepoInstallerResponse.installer:type_name -> io.tkeel.plugin.api.repo.v1.InstallerObject
+	3,  // 19: io.tkeel.plugin.api.repo.v1.CreateRepoRequest.RepoUrl.auth:type_name -> io.tkeel.plugin.api.repo.v1.RepoAuth
+	8,  // 20: io.tkeel.plugin.api.repo.v1.Repo.CreateRepo:input_type -> io.tkeel.plugin.api.repo.v1.CreateRepoRequest
+	10, // 21: io.tkeel.plugin.api.repo.v1.Repo.DeleteRepo:input_type -> io.tkeel.plugin.api.repo.v1.DeleteRepoRequest
+	29, // 22: io.tkeel.plugin.api.repo.v1.Repo.ListRepo:input_type -> google.protobuf.Empty
+	12, // 23: io.tkeel.plugin.api.repo.v1.Repo.GetRepo:input_type -> io.tkeel.plugin.api.repo.v1.GetRepoRequest
+	14, // 24: io.tkeel.plugin.api.repo.v1.Repo.RefreshRepo:input_type -> io.tkeel.plugin.api.repo.v1.RefreshRepoRequest
+	17, // 25: io.tkeel.plugin.api.repo.v1.Repo.ListAllRepoInstaller:input_type -> io.tkeel.plugin.api.repo.v1.ListAllRepoInstallerRequest
+	19, // 26: io.tkeel.plugin.api.repo.v1.Repo.ListRepoInstaller:input_type -> io.tkeel.plugin.api.repo.v1.ListRepoInstallerRequest
+	21, // 27: io.tkeel.plugin.api.repo.v1.Repo.GetRepoInstaller:input_type -> io.tkeel.plugin.api.repo.v1.GetRepoInstallerRequest
+	29, // 28: io.tkeel.plugin.api.repo.v1.Repo.CreateRepo:output_type -> google.protobuf.Empty
+	11, // 29: io.tkeel.plugin.api.repo.v1.Repo.DeleteRepo:output_type -> io.tkeel.plugin.api.repo.v1.DeleteRepoResponse
+	16, // 30: io.tkeel.plugin.api.repo.v1.Repo.ListRepo:output_type -> io.tkeel.plugin.api.repo.v1.ListRepoResponse
+	13, // 31: io.tkeel.plugin.api.repo.v1.Repo.GetRepo:output_type -> io.tkeel.plugin.api.repo.v1.GetRepoResponse
+	15, // 32: io.tkeel.plugin.api.repo.v1.Repo.RefreshRepo:output_type -> io.tkeel.plugin.api.repo.v1.RefreshRepoResponse
+	18, // 33: io.tkeel.plugin.api.repo.v1.Repo.ListAllRepoInstaller:output_type -> io.tkeel.plugin.api.repo.v1.ListAllRepoInstallerResponse
+	20, // 34: io.tkeel.plugin.api.repo.v1.Repo.ListRepoInstaller:output_type -> io.tkeel.plugin.api.repo.v1.ListRepoInstallerResponse
+	22, // 35: io.tkeel.plugin.api.repo.v1.Repo.GetRepoInstaller:output_type -> io.tkeel.plugin.api.repo.v1.GetRepoInstallerResponse
+	28, // [28:36] is the sub-list for method output_type
+	20, // [20:28] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_api_repo_v1_repo_proto_init() }
@@ -1610,7 +2431,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionList); i {
+			switch v := v.(*RepoStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1622,7 +2443,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstallerObject); i {
+			switch v := v.(*RepoAuth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1634,7 +2455,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRepoRequest); i {
+			switch v := v.(*RepoAuthStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1646,7 +2467,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRepoResponse); i {
+			switch v := v.(*VersionList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1658,7 +2479,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRepoRequest); i {
+			switch v := v.(*InstallerObject); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1670,7 +2491,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteRepoResponse); i {
+			switch v := v.(*InstallerProvenance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1682,7 +2503,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRepoResponse); i {
+			switch v := v.(*CreateRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1694,7 +2515,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAllRepoInstallerRequest); i {
+			switch v := v.(*CreateRepoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1706,7 +2527,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAllRepoInstallerResponse); i {
+			switch v := v.(*DeleteRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1718,7 +2539,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRepoInstallerRequest); i {
+			switch v := v.(*DeleteRepoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1730,7 +2551,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRepoInstallerResponse); i {
+			switch v := v.(*GetRepoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1742,7 +2563,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRepoInstallerRequest); i {
+			switch v := v.(*GetRepoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1754,7 +2575,55 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRepoInstallerResponse); i {
+			switch v := v.(*RefreshRepoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_repo_v1_repo_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshRepoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_repo_v1_repo_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_repo_v1_repo_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllRepoInstallerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_repo_v1_repo_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllRepoInstallerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1766,7 +2635,7 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstallerObjectMaintainer); i {
+			switch v := v.(*ListRepoInstallerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1778,6 +2647,54 @@ func file_api_repo_v1_repo_proto_init() {
 			}
 		}
 		file_api_repo_v1_repo_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRepoInstallerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_repo_v1_repo_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRepoInstallerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_repo_v1_repo_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRepoInstallerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_repo_v1_repo_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstallerObjectMaintainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_repo_v1_repo_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRepoRequest_RepoUrl); i {
 			case 0:
 				return &v.state
@@ -1796,7 +2713,7 @@ func file_api_repo_v1_repo_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_repo_v1_repo_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   20,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
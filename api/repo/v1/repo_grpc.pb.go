@@ -22,6 +22,8 @@ type RepoClient interface {
 	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*DeleteRepoResponse, error)
 	ListRepo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListRepoResponse, error)
+	GetRepo(ctx context.Context, in *GetRepoRequest, opts ...grpc.CallOption) (*GetRepoResponse, error)
+	RefreshRepo(ctx context.Context, in *RefreshRepoRequest, opts ...grpc.CallOption) (*RefreshRepoResponse, error)
 	ListAllRepoInstaller(ctx context.Context, in *ListAllRepoInstallerRequest, opts ...grpc.CallOption) (*ListAllRepoInstallerResponse, error)
 	ListRepoInstaller(ctx context.Context, in *ListRepoInstallerRequest, opts ...grpc.CallOption) (*ListRepoInstallerResponse, error)
 	GetRepoInstaller(ctx context.Context, in *GetRepoInstallerRequest, opts ...grpc.CallOption) (*GetRepoInstallerResponse, error)
@@ -62,6 +64,24 @@ func (c *repoClient) ListRepo(ctx context.Context, in *emptypb.Empty, opts ...gr
 	return out, nil
 }
 
+func (c *repoClient) GetRepo(ctx context.Context, in *GetRepoRequest, opts ...grpc.CallOption) (*GetRepoResponse, error) {
+	out := new(GetRepoResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.plugin.api.repo.v1.Repo/GetRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoClient) RefreshRepo(ctx context.Context, in *RefreshRepoRequest, opts ...grpc.CallOption) (*RefreshRepoResponse, error) {
+	out := new(RefreshRepoResponse)
+	err := c.cc.Invoke(ctx, "/io.tkeel.plugin.api.repo.v1.Repo/RefreshRepo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repoClient) ListAllRepoInstaller(ctx context.Context, in *ListAllRepoInstallerRequest, opts ...grpc.CallOption) (*ListAllRepoInstallerResponse, error) {
 	out := new(ListAllRepoInstallerResponse)
 	err := c.cc.Invoke(ctx, "/io.tkeel.plugin.api.repo.v1.Repo/ListAllRepoInstaller", in, out, opts...)
@@ -96,6 +116,8 @@ type RepoServer interface {
 	CreateRepo(context.Context, *CreateRepoRequest) (*emptypb.Empty, error)
 	DeleteRepo(context.Context, *DeleteRepoRequest) (*DeleteRepoResponse, error)
 	ListRepo(context.Context, *emptypb.Empty) (*ListRepoResponse, error)
+	GetRepo(context.Context, *GetRepoRequest) (*GetRepoResponse, error)
+	RefreshRepo(context.Context, *RefreshRepoRequest) (*RefreshRepoResponse, error)
 	ListAllRepoInstaller(context.Context, *ListAllRepoInstallerRequest) (*ListAllRepoInstallerResponse, error)
 	ListRepoInstaller(context.Context, *ListRepoInstallerRequest) (*ListRepoInstallerResponse, error)
 	GetRepoInstaller(context.Context, *GetRepoInstallerRequest) (*GetRepoInstallerResponse, error)
@@ -115,6 +137,12 @@ func (UnimplementedRepoServer) DeleteRepo(context.Context, *DeleteRepoRequest) (
 func (UnimplementedRepoServer) ListRepo(context.Context, *emptypb.Empty) (*ListRepoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRepo not implemented")
 }
+func (UnimplementedRepoServer) GetRepo(context.Context, *GetRepoRequest) (*GetRepoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepo not implemented")
+}
+func (UnimplementedRepoServer) RefreshRepo(context.Context, *RefreshRepoRequest) (*RefreshRepoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshRepo not implemented")
+}
 func (UnimplementedRepoServer) ListAllRepoInstaller(context.Context, *ListAllRepoInstallerRequest) (*ListAllRepoInstallerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllRepoInstaller not implemented")
 }
@@ -191,6 +219,42 @@ func _Repo_ListRepo_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Repo_GetRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServer).GetRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.plugin.api.repo.v1.Repo/GetRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServer).GetRepo(ctx, req.(*GetRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Repo_RefreshRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServer).RefreshRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/io.tkeel.plugin.api.repo.v1.Repo/RefreshRepo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServer).RefreshRepo(ctx, req.(*RefreshRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Repo_ListAllRepoInstaller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAllRepoInstallerRequest)
 	if err := dec(in); err != nil {
@@ -264,6 +328,14 @@ var Repo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRepo",
 			Handler:    _Repo_ListRepo_Handler,
 		},
+		{
+			MethodName: "GetRepo",
+			Handler:    _Repo_GetRepo_Handler,
+		},
+		{
+			MethodName: "RefreshRepo",
+			Handler:    _Repo_RefreshRepo_Handler,
+		},
 		{
 			MethodName: "ListAllRepoInstaller",
 			Handler:    _Repo_ListAllRepoInstaller_Handler,
@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tkeel-io/kit/result"
+	manifest_v1 "github.com/tkeel-io/tkeel/api/manifest/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const _successCode = "io.tkeel.SUCCESS"
+
+var (
+	manifestAddr  string
+	manifestToken string
+	manifestFile  string
+	manifestPrune bool
+	manifestDry   bool
+)
+
+var manifestCmd = &cobra.Command{
+	Use:   "manifest",
+	Short: "Apply, diff or export the declarative platform manifest.",
+	Long: `Apply, diff or export the declarative platform manifest through the rudder API.
+The platform admin token is required, the address should be the keel proxy of rudder,
+e.g. http://tkeel.io/apis/rudder/v1.`,
+}
+
+var manifestApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Apply the platform manifest.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		b, err := readManifestFile()
+		if err != nil {
+			return err
+		}
+		resp := &manifest_v1.ApplyManifestResponse{}
+		if err = requestManifest(http.MethodPost, "/manifest/apply", &manifest_v1.ApplyManifestRequest{
+			Manifest: b,
+			Prune:    manifestPrune,
+			DryRun:   manifestDry,
+		}, resp); err != nil {
+			return err
+		}
+		printManifestChanges(resp.Changes)
+		if resp.Failed != 0 {
+			return errors.Errorf("%d changes failed", resp.Failed)
+		}
+		return nil
+	},
+}
+
+var manifestDiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show the changes to apply the platform manifest.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		b, err := readManifestFile()
+		if err != nil {
+			return err
+		}
+		resp := &manifest_v1.DiffManifestResponse{}
+		if err = requestManifest(http.MethodPost, "/manifest/diff", &manifest_v1.DiffManifestRequest{
+			Manifest: b,
+			Prune:    manifestPrune,
+		}, resp); err != nil {
+			return err
+		}
+		printManifestChanges(resp.Changes)
+		return nil
+	},
+}
+
+var manifestExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the current platform manifest, the plugin secrets are not exported.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resp := &manifest_v1.ExportManifestResponse{}
+		if err := requestManifest(http.MethodGet, "/manifest", nil, resp); err != nil {
+			return err
+		}
+		if manifestFile == "" || manifestFile == "-" {
+			_, err := os.Stdout.Write(resp.Manifest)
+			return errors.Wrap(err, "write manifest")
+		}
+		return errors.Wrap(ioutil.WriteFile(manifestFile, resp.Manifest, 0o600), "write manifest file")
+	},
+}
+
+func readManifestFile() ([]byte, error) {
+	if manifestFile == "" {
+		return nil, errors.New("manifest file required")
+	}
+	if manifestFile == "-" {
+		b, err := ioutil.ReadAll(os.Stdin)
+		return b, errors.Wrap(err, "read manifest")
+	}
+	b, err := ioutil.ReadFile(manifestFile)
+	return b, errors.Wrapf(err, "read manifest file(%s)", manifestFile)
+}
+
+// requestManifest request the rudder manifest API and unmarshal the response data into resp.
+func requestManifest(method, path string, req, resp proto.Message) error {
+	if manifestToken == "" {
+		return errors.New("platform admin token required, set --token or RUDDER_TOKEN")
+	}
+	var body []byte
+	if req != nil {
+		b, err := protojson.Marshal(req)
+		if err != nil {
+			return errors.Wrap(err, "marshal request")
+		}
+		body = b
+	}
+	hReq, err := http.NewRequest(method, strings.TrimSuffix(manifestAddr, "/")+path, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "new request")
+	}
+	hReq.Header.Set(model.ContentTypeHeader, "application/json")
+	hReq.Header.Set(model.AuthorizationHeader, "Bearer "+manifestToken)
+	hResp, err := (&http.Client{Timeout: 10 * time.Minute}).Do(hReq)
+	if err != nil {
+		return errors.Wrap(err, "do request")
+	}
+	defer hResp.Body.Close()
+	b, err := ioutil.ReadAll(hResp.Body)
+	if err != nil {
+		return errors.Wrap(err, "read response")
+	}
+	out := &result.Http{}
+	if err = protojson.Unmarshal(b, out); err != nil {
+		return errors.Wrapf(err, "unmarshal response(%d): %s", hResp.StatusCode, b)
+	}
+	if out.Code != _successCode {
+		return errors.Errorf("request failed(%d): %s %s", hResp.StatusCode, out.Code, out.Msg)
+	}
+	if out.Data == nil {
+		return nil
+	}
+	return errors.Wrap(out.Data.UnmarshalTo(resp), "unmarshal response data")
+}
+
+func printManifestChanges(changes []*manifest_v1.ManifestChange) {
+	if len(changes) == 0 {
+		fmt.Println("no changes")
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tACTION\tTARGET\tDETAIL\tSTATUS\tMESSAGE")
+	for _, v := range changes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", v.Kind, v.Action, v.Target, v.Detail, v.Status, v.Message)
+	}
+	w.Flush()
+}
+
+func init() {
+	manifestCmd.PersistentFlags().StringVar(&manifestAddr, "addr", getEnvStr("RUDDER_ADDR", "http://127.0.0.1:31234/v1"), "rudder API address.")
+	manifestCmd.PersistentFlags().StringVar(&manifestToken, "token", getEnvStr("RUDDER_TOKEN", ""), "platform admin token, required.")
+	manifestCmd.PersistentFlags().StringVarP(&manifestFile, "file", "f", "", "manifest file path, - means stdin or stdout.")
+	manifestApplyCmd.Flags().BoolVar(&manifestPrune, "prune", false, "remove the repos, plugins and tenant enablements not in the manifest.")
+	manifestApplyCmd.Flags().BoolVar(&manifestDry, "dry-run", false, "show the changes only.")
+	manifestDiffCmd.Flags().BoolVar(&manifestPrune, "prune", false, "include the removals of the repos, plugins and tenant enablements not in the manifest.")
+	manifestCmd.AddCommand(manifestApplyCmd, manifestDiffCmd, manifestExportCmd)
+}
@@ -22,10 +22,12 @@ import (
 	"syscall"
 	"time"
 
+	audit_v1 "github.com/tkeel-io/tkeel/api/audit/v1"
 	authentication_v1 "github.com/tkeel-io/tkeel/api/authentication/v1"
 	config_v1 "github.com/tkeel-io/tkeel/api/config/v1"
 	entity_token_v1 "github.com/tkeel-io/tkeel/api/entity/v1"
 	entry_v1 "github.com/tkeel-io/tkeel/api/entry/v1"
+	manifest_v1 "github.com/tkeel-io/tkeel/api/manifest/v1"
 	metrics_v1 "github.com/tkeel-io/tkeel/api/metrics/v1"
 	oauth2_v1 "github.com/tkeel-io/tkeel/api/oauth2/v1"
 	plugin_v1 "github.com/tkeel-io/tkeel/api/plugin/v1"
@@ -39,14 +41,19 @@ import (
 	"github.com/tkeel-io/tkeel/pkg/client/kubernetes"
 	"github.com/tkeel-io/tkeel/pkg/client/openapi"
 	"github.com/tkeel-io/tkeel/pkg/config"
+	"github.com/tkeel-io/tkeel/pkg/health"
 	"github.com/tkeel-io/tkeel/pkg/hub"
+	"github.com/tkeel-io/tkeel/pkg/leader"
 	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/audit"
 	"github.com/tkeel-io/tkeel/pkg/model/kv"
 	"github.com/tkeel-io/tkeel/pkg/model/metrics"
+	"github.com/tkeel-io/tkeel/pkg/model/operation"
 	"github.com/tkeel-io/tkeel/pkg/model/plgprofile"
 	"github.com/tkeel-io/tkeel/pkg/model/plugin"
 	"github.com/tkeel-io/tkeel/pkg/model/prepo"
 	"github.com/tkeel-io/tkeel/pkg/model/proute"
+	"github.com/tkeel-io/tkeel/pkg/model/registration"
 	"github.com/tkeel-io/tkeel/pkg/register"
 	"github.com/tkeel-io/tkeel/pkg/repository"
 	"github.com/tkeel-io/tkeel/pkg/repository/helm"
@@ -92,8 +99,6 @@ var rootCmd = &cobra.Command{
 		httpSrv := server.NewHTTPServer(conf.HTTPAddr)
 		httpSrv.Container.EnableContentEncoding(false)
 		grpcSrv := server.NewGRPCServer(conf.GRPCAddr)
-		register.Init()
-		register.Instance().Run(conf.Tkeel.Namespace)
 
 		rudderApp = app.New("rudder", &log.Conf{
 			App:    "rudder",
@@ -113,8 +118,6 @@ var rootCmd = &cobra.Command{
 			// dapr http client.
 			daprHTTPClient := t_dapr.NewHTTPClient(conf.Dapr.HTTPPort)
 
-			openapiCli := openapi.NewDaprClient(conf.Dapr.HTTPPort)
-
 			// init k8s client.
 			k8sClient := kubernetes.NewClient(conf.DeploymentConfigmap, conf.Tkeel.Namespace)
 			helm.UpdatePullPolicy(k8sClient)
@@ -122,7 +125,20 @@ var rootCmd = &cobra.Command{
 			// init operator.
 			pOp := plugin.NewDaprStateOperator(conf.Dapr.PrivateStateName, daprGRPCClient)
 			prOp := proute.NewDaprStateOperator(conf.Dapr.PublicStateName, daprGRPCClient)
+			// the openapi requests are sent to the app serving the plugin.
+			openapiCli := openapi.NewRoutedClient(openapi.NewDaprClient(conf.Dapr.HTTPPort),
+				func(ctx context.Context, pluginID string) string {
+					pr, err := prOp.Get(ctx, pluginID)
+					if err != nil {
+						return pluginID
+					}
+					return pr.GetAppID()
+				})
+			prepo.SetCredentialsKey(conf.Tkeel.RepoCredentialsKey)
 			riOp := prepo.NewDaprStateOperator(conf.Dapr.PrivateStateName, daprGRPCClient)
+			opOp := operation.NewDaprStateOperator(conf.Dapr.PrivateStateName, daprGRPCClient)
+			regOp := registration.NewDaprStateOperator(conf.Dapr.PrivateStateName, daprGRPCClient)
+			// the watched keys are loaded and polled in every replica.
 			kvOp := kv.NewDaprStateOperator(conf.Tkeel.WatchInterval, conf.Dapr.PrivateStateName, daprGRPCClient)
 			kvOp.Watch(context.TODO(), model.KeyPermissionSet, func(value []byte, version string) error {
 				log.Debugf("update %s %s", model.KeyPermissionSet, string(value))
@@ -131,6 +147,7 @@ var rootCmd = &cobra.Command{
 				}
 				return nil
 			})
+			kvOp.Run(context.TODO())
 
 			// init security operator.
 			tokenConf := &service.TokenConf{TokenType: service.TokenTypeBearer, AllowedGrantTypes: service.DefaultGrantType}
@@ -148,6 +165,13 @@ var rootCmd = &cobra.Command{
 				log.Fatal(err)
 				os.Exit(-1)
 			}
+			// init audit log operator.
+			auditOp, err := audit.NewGormOperator(gormdb)
+			if err != nil {
+				log.Fatal("fatal new audit operator: %s", err)
+				os.Exit(-1)
+			}
+			service.InitAuditRecorder(auditOp)
 			rbacOp, err := security_casbin.NewRBACOperator(&security_casbin.MysqlConf{
 				DBName: conf.SecurityConf.Mysql.DBName,
 				User:   conf.SecurityConf.Mysql.User, Password: conf.SecurityConf.Mysql.Password,
@@ -191,6 +215,13 @@ var rootCmd = &cobra.Command{
 					if !ok {
 						return nil, errors.New("invalid argument type")
 					}
+					if helm.IsOCI(connectInfo.URL) {
+						repo, err := helm.NewOCIRepo(connectInfo, drive, namespace)
+						if err != nil {
+							return nil, errors.Wrap(err, "new oci repo")
+						}
+						return repo, nil
+					}
 					repo, err := helm.NewHelmRepo(connectInfo, drive, namespace)
 					if err != nil {
 						return nil, errors.Wrap(err, "new helm repo")
@@ -212,9 +243,62 @@ var rootCmd = &cobra.Command{
 			// init service.
 			// plugin service.
 			pluginSrvV1 := service.NewPluginServiceV1(rbacOp, gormdb, conf.Tkeel,
-				kvOp, pOp, prOp, tenantPluginOp, openapiCli)
+				kvOp, pOp, prOp, tenantPluginOp, openapiCli, opOp, k8sClient)
+			// init plugin health monitor, the health is written with the plugin locked.
+			healthMonitor, err := health.NewMonitor(conf.Tkeel.HealthCheckInterval, pOp, openapiCli, k8sClient,
+				pluginSrvV1.UpdatePluginLocked)
+			if err != nil {
+				log.Fatal("fatal new plugin health monitor: %s", err)
+				os.Exit(-1)
+			}
+			// init plugin registry, the pending registrations are replayed.
+			register.Init(regOp, k8sClient)
+			register.Instance().SetHandler(pluginSrvV1.HandleRegistration)
+			// check the available plugin upgrades after the repository indexes refreshed.
+			hub.GetInstance().OnRefreshed(func() {
+				if err := pluginSrvV1.RefreshAvailableUpgrades(context.TODO()); err != nil {
+					log.Errorf("error refresh available upgrades: %s", err)
+				}
+			})
+			// manifest service.
+			manifestSrv, err := service.NewManifestService(conf.Tkeel, kvOp, pOp, pluginSrvV1)
+			if err != nil {
+				log.Fatal("fatal new manifest service: %s", err)
+				os.Exit(-1)
+			}
+
+			// init leader elector, the background controllers changing the state run in the leader only,
+			// the caches are refreshed in every replica, the replica is always the leader if the election
+			// is disabled or not in cluster.
+			identity, err := os.Hostname()
+			if err != nil {
+				log.Fatal("fatal get hostname: %s", err)
+				os.Exit(-1)
+			}
+			lock, err := leader.NewLock(conf.LeaderElection.Lock, conf.LeaderElection.Name, conf.Tkeel.Namespace)
+			if err != nil {
+				log.Fatal("fatal new leader election lock: %s", err)
+				os.Exit(-1)
+			}
+			elector, err := leader.NewElector(lock, identity, conf.LeaderElection.LeaseDuration, conf.LeaderElection.RetryPeriod)
+			if err != nil {
+				log.Fatal("fatal new leader elector: %s", err)
+				os.Exit(-1)
+			}
+			elector.OnStartedLeading(hub.GetInstance().Run)
+			elector.OnStartedLeading(func(ctx context.Context) {
+				register.Instance().Run(ctx, conf.Tkeel.Namespace)
+			})
+			elector.OnStartedLeading(healthMonitor.Run)
+			elector.OnStartedLeading(manifestSrv.Run)
+			elector.OnStartedLeading(pluginSrvV1.RunUpgradeScheduler)
+			elector.OnStartedLeading(pluginSrvV1.RunReconciler)
+			elector.OnStartedLeading(pluginSrvV1.RunOperationPruner)
+			elector.Run(context.TODO())
 			plugin_v1.RegisterPluginHTTPServer(httpSrv.Container, pluginSrvV1)
 			plugin_v1.RegisterPluginServer(grpcSrv.GetServe(), pluginSrvV1)
+			manifest_v1.RegisterManifestHTTPServer(httpSrv.Container, manifestSrv)
+			manifest_v1.RegisterManifestServer(grpcSrv.GetServe(), manifestSrv)
 			// oauth2 service.
 			oauth2SrvV1 := service.NewOauth2ServiceV1(conf.Tkeel.AdminPassword, kvOp, pOp)
 			oauth2_v1.RegisterOauth2HTTPServer(httpSrv.Container, oauth2SrvV1)
@@ -264,6 +348,11 @@ var rootCmd = &cobra.Command{
 			config_v1.RegisterConfigHTTPServer(httpSrv.Container, configSrv)
 			config_v1.RegisterConfigServer(grpcSrv.GetServe(), configSrv)
 
+			// audit service.
+			auditSrv := service.NewAuditService(gormdb, auditOp)
+			audit_v1.RegisterAuditHTTPServer(httpSrv.Container, auditSrv)
+			audit_v1.RegisterAuditServer(grpcSrv.GetServe(), auditSrv)
+
 			// metrics service.
 			metricsSrv := service.NewMetricsService(metrics.CollectorUser, metrics.CollectorRole, metrics.CollectorTKeelProfiles)
 			metrics_v1.RegisterMetricsHTTPServer(httpSrv.Container, metricsSrv)
@@ -291,6 +380,7 @@ func init() {
 	conf.AttachCmdFlags(rootCmd.Flags().StringVar, rootCmd.Flags().BoolVar, rootCmd.Flags().IntVar)
 	rootCmd.Flags().StringVar(&configFile, "config", getEnvStr("RUDDER_CONFIG", ""), "rudder config file path.")
 	rootCmd.AddCommand(cmd.VersionCmd)
+	rootCmd.AddCommand(manifestCmd)
 }
 
 func getEnvStr(env string, defaultValue string) string {
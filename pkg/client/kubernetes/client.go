@@ -2,12 +2,16 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/tkeel-io/kit/config"
 	"github.com/tkeel-io/kit/log"
 	"gopkg.in/yaml.v3"
+	k_errors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	k_kubernetes "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
@@ -48,3 +52,46 @@ func (c *Client) GetDeploymentConfig(ctx context.Context) (*config.InstallConfig
 	}
 	return conf, nil
 }
+
+// WorkloadReady get whether the deployment(or statefulset) with the name is ready, and the readiness detail.
+func (c *Client) WorkloadReady(ctx context.Context, name string) (bool, string, error) {
+	var replicas, readyReplicas int32
+	dep, err := c.c.AppsV1().Deployments(c.namespace).Get(ctx, name, v1.GetOptions{})
+	switch {
+	case err == nil:
+		replicas, readyReplicas = 1, dep.Status.ReadyReplicas
+		if dep.Spec.Replicas != nil {
+			replicas = *dep.Spec.Replicas
+		}
+	case k_errors.IsNotFound(err):
+		sts, err := c.c.AppsV1().StatefulSets(c.namespace).Get(ctx, name, v1.GetOptions{})
+		if err != nil {
+			return false, "", errors.Wrapf(err, "get %s deployment or statefulset", name)
+		}
+		replicas, readyReplicas = 1, sts.Status.ReadyReplicas
+		if sts.Spec.Replicas != nil {
+			replicas = *sts.Spec.Replicas
+		}
+	default:
+		return false, "", errors.Wrapf(err, "get %s deployment", name)
+	}
+	return readyReplicas >= replicas, fmt.Sprintf("ready replicas %d/%d", readyReplicas, replicas), nil
+}
+
+// RestartWorkload rollout restart the deployment(or statefulset) with the name as kubectl does,
+// the pods are recreated with the sidecars loading the current components.
+func (c *Client) RestartWorkload(ctx context.Context, name string) error {
+	patch := []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":%q}}}}}`,
+		time.Now().Format(time.RFC3339)))
+	_, err := c.c.AppsV1().Deployments(c.namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, v1.PatchOptions{})
+	if err == nil {
+		return nil
+	}
+	if !k_errors.IsNotFound(err) {
+		return errors.Wrapf(err, "restart %s deployment", name)
+	}
+	if _, err = c.c.AppsV1().StatefulSets(c.namespace).Patch(ctx, name, types.StrategicMergePatchType, patch, v1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "restart %s deployment or statefulset", name)
+	}
+	return nil
+}
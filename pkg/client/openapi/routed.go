@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"context"
+
+	openapi_v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
+)
+
+// AppIDResolver get the app id serving the plugin, the id itself is returned if it is not a routed plugin.
+type AppIDResolver func(ctx context.Context, pluginID string) string
+
+type appIDKey struct{}
+
+// WithAppID send the requests of the context to the app as is, it is used to reach
+// the candidate app of a plugin whose id may be the plugin id of another app.
+func WithAppID(ctx context.Context) context.Context {
+	return context.WithValue(ctx, appIDKey{}, true)
+}
+
+// RoutedClient send the openapi requests of the plugin to the app serving it,
+// the app of a plugin changes when its blue/green upgrade is promoted.
+type RoutedClient struct {
+	c       Client
+	resolve AppIDResolver
+}
+
+func NewRoutedClient(c Client, resolve AppIDResolver) *RoutedClient {
+	return &RoutedClient{
+		c:       c,
+		resolve: resolve,
+	}
+}
+
+func (c *RoutedClient) appID(ctx context.Context, id string) string {
+	if v, _ := ctx.Value(appIDKey{}).(bool); v {
+		return id
+	}
+	return c.resolve(ctx, id)
+}
+
+func (c *RoutedClient) Identify(ctx context.Context, sendToPluginID string) (*openapi_v1.IdentifyResponse, error) {
+	return c.c.Identify(ctx, c.appID(ctx, sendToPluginID))
+}
+
+func (c *RoutedClient) AddonsIdentify(ctx context.Context, sendToPluginID string, req *openapi_v1.AddonsIdentifyRequest) (*openapi_v1.AddonsIdentifyResponse, error) {
+	return c.c.AddonsIdentify(ctx, c.appID(ctx, sendToPluginID), req)
+}
+
+func (c *RoutedClient) Status(ctx context.Context, sendToPluginID string) (*openapi_v1.StatusResponse, error) {
+	return c.c.Status(ctx, c.appID(ctx, sendToPluginID))
+}
+
+func (c *RoutedClient) TenantEnable(ctx context.Context, sendToPluginID string, req *openapi_v1.TenantEnableRequest) (*openapi_v1.TenantEnableResponse, error) {
+	return c.c.TenantEnable(ctx, c.appID(ctx, sendToPluginID), req)
+}
+
+func (c *RoutedClient) TenantDisable(ctx context.Context, sendToPluginID string, req *openapi_v1.TenantDisableRequest) (*openapi_v1.TenantDisableResponse, error) {
+	return c.c.TenantDisable(ctx, c.appID(ctx, sendToPluginID), req)
+}
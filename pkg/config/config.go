@@ -34,6 +34,30 @@ type TkeelConf struct {
 	AdminPassword string `json:"admin_password" yaml:"adminPassword"`
 	// watch interval.
 	WatchInterval string `json:"watch_interval" yaml:"watchInterval"`
+	// plugin health check interval.
+	HealthCheckInterval string `json:"health_check_interval" yaml:"healthCheckInterval"`
+	// applied platform manifest reconcile interval, 0 disables the reconciliation.
+	ManifestReconcileInterval string `json:"manifest_reconcile_interval" yaml:"manifestReconcileInterval"`
+	// plugin state reconcile interval, 0 disables the periodic reconciliation.
+	ReconcileInterval string `json:"reconcile_interval" yaml:"reconcileInterval"`
+	// comma separated drift kinds repaired by the periodic reconciliation, empty only reports the drifts.
+	ReconcileRepair string `json:"reconcile_repair" yaml:"reconcileRepair"`
+	// plugin automatic upgrade maintenance window in UTC such as "02:00-04:00", empty disables the automatic upgrades.
+	UpgradeMaintenanceWindow string `json:"upgrade_maintenance_window" yaml:"upgradeMaintenanceWindow"`
+	// key to encrypt the stored auth and tls settings of the private repositories.
+	RepoCredentialsKey string `json:"repo_credentials_key" yaml:"repoCredentialsKey"`
+}
+
+// LeaderElectionConf leader election configuration of the background controllers.
+type LeaderElectionConf struct {
+	// lock type, lease, file, memory or none, none disables the election and the replica is always the leader.
+	Lock string `json:"lock" yaml:"lock"`
+	// lock name, the lease name or the lock file path.
+	Name string `json:"name" yaml:"name"`
+	// the leader holds the lock for the lease duration after renewed.
+	LeaseDuration string `json:"lease_duration" yaml:"leaseDuration"`
+	// retry period of acquiring or renewing the lock.
+	RetryPeriod string `json:"retry_period" yaml:"retryPeriod"`
 }
 
 // DaprConf dapr sidecar configuration.
@@ -84,6 +108,8 @@ type Configuration struct {
 	DatabaseURL string `json:"database_url" yaml:"databaseUrl"`
 	// DeploymentConfigMap deployment config.
 	DeploymentConfigmap string `json:"deployment_configmap" yaml:"deploymentConfigmap"`
+	// LeaderElection leader election config.
+	LeaderElection *LeaderElectionConf `json:"leader_election" yaml:"leaderElection"`
 }
 
 // SecurityConf.
@@ -128,10 +154,11 @@ type AccessConf struct {
 // NewDefaultConfiguration returns the empty config.
 func NewDefaultConfiguration() *Configuration {
 	return &Configuration{
-		Tkeel: &TkeelConf{},
-		Proxy: &ProxyConf{},
-		Dapr:  &DaprConf{},
-		Log:   &LogConf{},
+		Tkeel:          &TkeelConf{},
+		Proxy:          &ProxyConf{},
+		Dapr:           &DaprConf{},
+		Log:            &LogConf{},
+		LeaderElection: &LeaderElectionConf{},
 		SecurityConf: &SecurityConf{
 			Mysql: &MysqlConf{},
 			OAuth: &OauthConfig{
@@ -181,6 +208,16 @@ func (c *Configuration) AttachCmdFlags(strVar func(p *string, name string, value
 	strVar(&c.Tkeel.Namespace, "tkeel.namespace", getEnvStr("TKEEL_POD_NAMESPACE", "tkeel-system"), "tkeel pod namespace.(default tkeel-system)")
 	strVar(&c.Tkeel.AdminPassword, "tkeel.admin_password", getEnvStr("TKEEL_ADMIN_PASSWD", "changeme"), "tkeel admin password.(default env TKEEL_ADMIN_PASSWD)")
 	strVar(&c.Tkeel.WatchInterval, "tkeel.watch_interval", getEnvStr("TKEEL_WATCH_INTERVAL", "10s"), "tkeel watch change interval.(default 10s)")
+	strVar(&c.Tkeel.HealthCheckInterval, "tkeel.health_check_interval", getEnvStr("TKEEL_HEALTH_CHECK_INTERVAL", "30s"), "tkeel plugin health check interval.(default 30s)")
+	strVar(&c.Tkeel.ManifestReconcileInterval, "tkeel.manifest_reconcile_interval", getEnvStr("TKEEL_MANIFEST_RECONCILE_INTERVAL", "5m"), "tkeel applied platform manifest reconcile interval, 0 disables it.(default 5m)")
+	strVar(&c.Tkeel.ReconcileInterval, "tkeel.reconcile_interval", getEnvStr("TKEEL_RECONCILE_INTERVAL", "10m"), "tkeel plugin state reconcile interval, 0 disables it.(default 10m)")
+	strVar(&c.Tkeel.ReconcileRepair, "tkeel.reconcile_repair", getEnvStr("TKEEL_RECONCILE_REPAIR", ""), "tkeel comma separated drift kinds repaired by the periodic reconciliation, empty only reports the drifts.")
+	strVar(&c.Tkeel.UpgradeMaintenanceWindow, "tkeel.upgrade_maintenance_window", getEnvStr("TKEEL_UPGRADE_MAINTENANCE_WINDOW", ""), "tkeel plugin automatic upgrade maintenance window in UTC such as 02:00-04:00, empty disables the automatic upgrades.")
+	strVar(&c.Tkeel.RepoCredentialsKey, "tkeel.repo_credentials_key", getEnvStr("TKEEL_REPO_CREDENTIALS_KEY", ""), "tkeel key to encrypt the stored private repository credentials, the credentials are refused if not set.(default env TKEEL_REPO_CREDENTIALS_KEY)")
+	strVar(&c.LeaderElection.Lock, "leader_election.lock", getEnvStr("TKEEL_LEADER_ELECTION_LOCK", "lease"), "leader election lock type, lease, file, memory or none.(default lease)")
+	strVar(&c.LeaderElection.Name, "leader_election.name", getEnvStr("TKEEL_LEADER_ELECTION_NAME", "rudder-leader"), "leader election lease name or lock file path.(default rudder-leader)")
+	strVar(&c.LeaderElection.LeaseDuration, "leader_election.lease_duration", getEnvStr("TKEEL_LEADER_ELECTION_LEASE_DURATION", "15s"), "leader election lease duration.(default 15s)")
+	strVar(&c.LeaderElection.RetryPeriod, "leader_election.retry_period", getEnvStr("TKEEL_LEADER_ELECTION_RETRY_PERIOD", "5s"), "leader election retry period.(default 5s)")
 	strVar(&c.SecurityConf.Mysql.DBName, "security.mysql.dbname", getEnvStr("TKEEL_SECURITY_MYSQL_DBNAME", "tkeelauth"), "database name of auth`s mysql config")
 	strVar(&c.SecurityConf.Mysql.User, "security.mysql.user", getEnvStr("TKEEL_SECURITY_MYSQL_USER", "root"), "user name of auth`s mysql config")
 	strVar(&c.SecurityConf.Mysql.Password, "security.mysql.password", getEnvStr("TKEEL_SECURITY_MYSQL_PASSWORD", "a3fks=ixmeb82a"), "password of auth`s mysql config")
@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	openapi_v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
+	"github.com/tkeel-io/tkeel/pkg/client/openapi"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+)
+
+// WorkloadChecker check the readiness of the plugin workload.
+type WorkloadChecker interface {
+	// WorkloadReady get whether the workload of the plugin is ready, and the readiness detail.
+	WorkloadReady(ctx context.Context, name string) (bool, string, error)
+}
+
+// PluginUpdater apply the change to the latest plugin, the change is serialized with
+// the other changes of the plugin so that the concurrent operations keep their versions.
+type PluginUpdater func(ctx context.Context, pluginID string, change func(p *model.Plugin) bool) error
+
+const (
+	_updateAttempts = 3
+	_updateBackoff  = 200 * time.Millisecond
+)
+
+// Monitor poll the registered plugins status and workload readiness,
+// and record the health transitions on the plugin.
+type Monitor struct {
+	interval      time.Duration
+	pluginOp      plugin.Operator
+	openapiClient openapi.Client
+	workload      WorkloadChecker
+	updatePlugin  PluginUpdater
+}
+
+func NewMonitor(interval string, pOp plugin.Operator, openapiCli openapi.Client,
+	workload WorkloadChecker, update PluginUpdater,
+) (*Monitor, error) {
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse health check interval(%s)", interval)
+	}
+	return &Monitor{
+		interval:      d,
+		pluginOp:      pOp,
+		openapiClient: openapiCli,
+		workload:      workload,
+		updatePlugin:  update,
+	}, nil
+}
+
+// Run poll the plugins health in the background until the ctx done.
+func (m *Monitor) Run(ctx context.Context) {
+	log.Info("plugin health monitor is running")
+	go func() {
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("plugin health monitor stopped")
+				return
+			case <-ticker.C:
+				if err := m.CheckAll(ctx); err != nil {
+					log.Errorf("error check plugins health: %s", err)
+				}
+			}
+		}
+	}()
+}
+
+// CheckAll check the health of all registered plugins.
+func (m *Monitor) CheckAll(ctx context.Context) error {
+	ps, err := m.pluginOp.List(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list plugin")
+	}
+	for _, p := range ps {
+		if p.Status != openapi_v1.PluginStatus_RUNNING || isTkeelComponent(p.ID) {
+			continue
+		}
+		state, reason := m.probe(ctx, p.GetAppID())
+		if err = m.update(ctx, p.ID, state, reason); err != nil {
+			log.Errorf("error update plugin(%s) health(%s): %s", p.ID, state, err)
+		}
+	}
+	return nil
+}
+
+func (m *Monitor) probe(ctx context.Context, appID string) (string, string) {
+	probeCtx, cancel := context.WithTimeout(ctx, m.interval)
+	defer cancel()
+	resp, err := m.openapiClient.Status(probeCtx, appID)
+	if err != nil {
+		return model.HealthStateUnreachable, fmt.Sprintf("query status: %s", err)
+	}
+	if m.workload != nil {
+		ready, detail, err := m.workload.WorkloadReady(probeCtx, appID)
+		if err != nil {
+			return model.HealthStateDegraded, fmt.Sprintf("check workload: %s", err)
+		}
+		if !ready {
+			return model.HealthStateDegraded, fmt.Sprintf("workload not ready: %s", detail)
+		}
+	}
+	if resp.Status != openapi_v1.PluginStatus_RUNNING {
+		return model.HealthStateDegraded, fmt.Sprintf("plugin status %s", resp.Status)
+	}
+	return model.HealthStateRunning, ""
+}
+
+// update record the health on the latest plugin, the update conflicting with the
+// plugin changed by other replicas is retried.
+func (m *Monitor) update(ctx context.Context, pluginID, state, reason string) error {
+	var err error
+	for i := 0; i < _updateAttempts; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return errors.Wrapf(ctx.Err(), "update plugin(%s)", pluginID)
+			case <-time.After(_updateBackoff):
+			}
+		}
+		err = m.updatePlugin(ctx, pluginID, func(p *model.Plugin) bool {
+			if p.Status != openapi_v1.PluginStatus_RUNNING || !p.UpdateHealth(state, reason) {
+				return false
+			}
+			log.Infof("plugin(%s) health changed to %s: %s", pluginID, state, reason)
+			return true
+		})
+		if err == nil || errors.Is(err, plugin.ErrPluginNotExsist) {
+			return nil
+		}
+		log.Debugf("update plugin(%s) health attempt(%d): %s", pluginID, i+1, err)
+	}
+	// the unchanged state is retried on the next check.
+	return err
+}
+
+func isTkeelComponent(pluginID string) bool {
+	for _, v := range model.TKeelComponents {
+		if v == pluginID {
+			return true
+		}
+	}
+	return false
+}
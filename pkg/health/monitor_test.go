@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	openapi_v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+)
+
+// fakeUpdater apply the changes to the plugin, the first failures updates return errConflict.
+type fakeUpdater struct {
+	p        *model.Plugin
+	failures int
+	calls    int
+	writes   int
+}
+
+var errConflict = errors.New("etag mismatch")
+
+func (u *fakeUpdater) update(ctx context.Context, pluginID string, change func(p *model.Plugin) bool) error {
+	u.calls++
+	if u.p == nil || u.p.ID != pluginID {
+		return plugin.ErrPluginNotExsist
+	}
+	p := u.p.Clone()
+	if !change(p) {
+		return nil
+	}
+	if u.failures > 0 {
+		u.failures--
+		return errConflict
+	}
+	u.p = p
+	u.writes++
+	return nil
+}
+
+func TestMonitorUpdate(t *testing.T) {
+	ctx := context.Background()
+	u := &fakeUpdater{p: &model.Plugin{ID: "iothub", Status: openapi_v1.PluginStatus_RUNNING}}
+	m := &Monitor{updatePlugin: u.update}
+
+	assert.Nil(t, m.update(ctx, "iothub", model.HealthStateDegraded, "workload not ready"))
+	assert.Equal(t, model.HealthStateDegraded, u.p.Health.State)
+	assert.Equal(t, 1, u.writes)
+	// the unchanged state is not written.
+	assert.Nil(t, m.update(ctx, "iothub", model.HealthStateDegraded, "workload not ready"))
+	assert.Equal(t, 1, u.writes)
+
+	// the conflicting update is retried.
+	u.failures, u.calls = _updateAttempts-1, 0
+	assert.Nil(t, m.update(ctx, "iothub", model.HealthStateRunning, ""))
+	assert.Equal(t, _updateAttempts, u.calls)
+	assert.Equal(t, model.HealthStateRunning, u.p.Health.State)
+	assert.Len(t, u.p.Health.Transitions, 2)
+
+	u.failures, u.calls = _updateAttempts, 0
+	assert.ErrorIs(t, m.update(ctx, "iothub", model.HealthStateUnreachable, "timeout"), errConflict)
+	assert.Equal(t, _updateAttempts, u.calls)
+	assert.Equal(t, model.HealthStateRunning, u.p.Health.State)
+
+	// the removed or unregistered plugin is skipped.
+	u.failures = 0
+	assert.Nil(t, m.update(ctx, "removed", model.HealthStateUnreachable, "timeout"))
+	u.p.Status = openapi_v1.PluginStatus_ERR_REGISTER
+	assert.Nil(t, m.update(ctx, "iothub", model.HealthStateUnreachable, "timeout"))
+	assert.Equal(t, model.HealthStateRunning, u.p.Health.State)
+}
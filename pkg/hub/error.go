@@ -6,4 +6,6 @@ var (
 	ErrRepoNotFound  = errors.New("repo not found")
 	ErrInternalError = errors.New("internal error")
 	ErrRepoExist     = errors.New("repo exist")
+	// ErrInvalidRefreshInterval the refresh interval is not a positive duration.
+	ErrInvalidRefreshInterval = errors.New("invalid refresh interval")
 )
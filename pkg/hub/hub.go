@@ -41,6 +41,12 @@ type Hub struct {
 	constructor     repository.Constructor
 	destroy         repository.DestroyPlugin
 	constructorArgs []interface{}
+	listenerLock    sync.RWMutex
+	listeners       []func()
+	stateLock       sync.Mutex
+	states          map[string]*repoState
+	defaultRefresh  time.Duration
+	tick            time.Duration
 }
 
 // Init use Singleton pattern design, generating a new Hub that is globally one assigned to the h variable.
@@ -52,6 +58,7 @@ func Init(interval string, op repository.InfoOperator, c repository.Constructor,
 			constructor:     c,
 			destroy:         d,
 			constructorArgs: initRepoArgs,
+			states:          make(map[string]*repoState),
 		}
 		if err := h.Init(interval); err != nil {
 			log.Fatalf("error init hub: %s", err)
@@ -70,6 +77,12 @@ func (h *Hub) Init(interval string) error {
 	if h.repoSet == nil {
 		return errors.New("need initial")
 	}
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		return fmt.Errorf("error parse interval(%s): %w", interval, err)
+	}
+	h.defaultRefresh = d * _defaultRefreshFactor
+	h.tick = d
 	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
 	defer cancel()
 	modelRepos, err := h.infoOperator.List(ctx)
@@ -77,9 +90,9 @@ func (h *Hub) Init(interval string) error {
 		return fmt.Errorf("error list repo: %w", err)
 	}
 	for _, v := range modelRepos {
-		repo, err := h.constructor(v, h.constructorArgs...)
+		repo, err := h.construct(v)
 		if err != nil {
-			log.Errorf("error constructor repo(%s): %w", v, err)
+			log.Errorf("error constructor repo(%s): %s", v, err)
 			continue
 		}
 		h.repoSet.Store(v.Name, repo)
@@ -91,57 +104,75 @@ func (h *Hub) Init(interval string) error {
 			log.Errorf("error watch repo: %s", err)
 		}
 	}()
+	// the sync status saved by the leader is followed in every replica.
 	go func() {
-		d, err := time.ParseDuration(interval)
-		if err != nil {
-			log.Panic("error parse duration: %s", interval)
-		}
-		tick := time.NewTicker(d * 100)
+		tick := time.NewTicker(h.tick)
+		defer tick.Stop()
 		for range tick.C {
-			h.repoSet.Range(
-				func(key, value interface{}) bool {
-					repo, ok := value.(repository.Repository)
-					if !ok {
-						log.Warnf("invalid repository %v type", key)
-						h.repoSet.Delete(key)
-						return true
-					}
-					_, err := repo.Update()
-					if err != nil {
-						log.Warnf("repo update error: %s", err)
-					}
-					return true
-				},
-			)
-			tick.Reset(d * 100)
+			h.followStatus()
 		}
 	}()
 	return nil
 }
 
-// updateRepoSet watch call back func.
+// Run refresh the repository indexes by their own intervals until the ctx done.
+func (h *Hub) Run(ctx context.Context) {
+	log.Info("repo hub refresher is running")
+	go func() {
+		tick := time.NewTicker(h.tick)
+		defer tick.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("repo hub refresher stopped")
+				return
+			case <-tick.C:
+				if h.refreshDue() {
+					h.notifyRefreshed()
+				}
+			}
+		}
+	}()
+}
+
+// OnRefreshed add the listener called after the repository indexes refreshed.
+func (h *Hub) OnRefreshed(f func()) {
+	h.listenerLock.Lock()
+	defer h.listenerLock.Unlock()
+	h.listeners = append(h.listeners, f)
+}
+
+func (h *Hub) notifyRefreshed() {
+	h.listenerLock.RLock()
+	defer h.listenerLock.RUnlock()
+	for _, f := range h.listeners {
+		f()
+	}
+}
+
+// updateRepoSet watch call back func, the repositories failed to construct
+// are recorded as broken and retried by the refresh.
 func (h *Hub) updateRepoSet(newInfo, updateInfo, deleteInfo []*repository.Info) error {
 	// create new repo.
 	for _, v := range newInfo {
-		newRepo, err := h.constructor(v, h.constructorArgs...)
+		newRepo, err := h.construct(v)
 		if err != nil {
-			err = fmt.Errorf("error constructor(%s): %w", v, err)
-			log.Error(err)
-			return err
+			log.Errorf("error constructor(%s): %s", v, err)
+			continue
 		}
 		h.repoSet.Store(v.Name, newRepo)
 	}
 	// delete old repo.
 	for _, v := range deleteInfo {
 		h.repoSet.Delete(v.Name)
+		h.deleteState(v.Name)
 	}
 	// update new repo.
 	for _, v := range updateInfo {
-		changeRepo, err := h.constructor(v, h.constructorArgs...)
+		changeRepo, err := h.construct(v)
 		if err != nil {
-			err = fmt.Errorf("error constructor(%s): %w", v, err)
-			log.Error(err)
-			return err
+			log.Errorf("error constructor(%s): %s", v, err)
+			continue
 		}
 		h.repoSet.Store(v.Name, changeRepo)
 	}
@@ -172,6 +203,9 @@ func (h *Hub) Add(i *repository.Info) error {
 	if !ok {
 		return ErrRepoExist
 	}
+	if _, err := ParseRefreshInterval(i.RefreshInterval); err != nil {
+		return err
+	}
 	repo, err := h.constructor(i, h.constructorArgs...)
 	if err != nil {
 		return fmt.Errorf("error hub constructor repo(%s): %w", i, err)
@@ -182,6 +216,43 @@ func (h *Hub) Add(i *repository.Info) error {
 		return fmt.Errorf("error repo operator create(%s): %w", i, err)
 	}
 	h.repoSet.Store(i.Name, repo)
+	h.synced(repo)
+	h.saveStatus(i.Name)
+	return nil
+}
+
+// Update the repo info with the same name, the repo is reconstructed with the info.
+func (h *Hub) Update(i *repository.Info) error {
+	if _, ok := h.repoSet.Load(i.Name); !ok {
+		return ErrRepoNotFound
+	}
+	exist := false
+	h.repoSet.Range(func(key, value interface{}) bool {
+		repo, ok := value.(repository.Repository)
+		if ok && key != i.Name && repo.Info().URL == i.URL {
+			exist = true
+			return false
+		}
+		return true
+	})
+	if exist {
+		return ErrRepoExist
+	}
+	if _, err := ParseRefreshInterval(i.RefreshInterval); err != nil {
+		return err
+	}
+	repo, err := h.constructor(i, h.constructorArgs...)
+	if err != nil {
+		return fmt.Errorf("error hub constructor repo(%s): %w", i, err)
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	if err = h.infoOperator.Update(ctx, i); err != nil {
+		return fmt.Errorf("error repo operator update(%s): %w", i, err)
+	}
+	h.repoSet.Store(i.Name, repo)
+	h.synced(repo)
+	h.saveStatus(i.Name)
 	return nil
 }
 
@@ -191,7 +262,7 @@ func (h *Hub) Delete(name string) (repository.Repository, error) {
 	defer rbStack.Run()
 	repoIn, ok := h.repoSet.LoadAndDelete(name)
 	if !ok {
-		return nil, errors.New("repo not exsist")
+		return nil, h.deleteBroken(name)
 	}
 	repo, ok := repoIn.(repository.Repository)
 	if !ok {
@@ -215,6 +286,7 @@ func (h *Hub) Delete(name string) (repository.Repository, error) {
 		return nil, fmt.Errorf("error repo operator delete repo(%s): %w", name, err)
 	}
 	rbStack = util.NewRollbackStack()
+	h.deleteState(name)
 	return repo, nil
 }
 
@@ -230,7 +302,7 @@ func (h *Hub) Get(name string) (repository.Repository, error) {
 			log.Errorf("error repo operator find %s: %s", name, err)
 			return nil, ErrRepoNotFound
 		}
-		repo, err := h.constructor(modelInfo, h.constructorArgs...)
+		repo, err := h.construct(modelInfo)
 		if err != nil {
 			log.Errorf("error constructor(%s) repo: %s", modelInfo, err)
 			return nil, ErrInternalError
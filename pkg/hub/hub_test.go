@@ -0,0 +1,240 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hub
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+)
+
+// fakeInfoOperator keep the repository infos and the sync status in memory.
+type fakeInfoOperator struct {
+	infos    map[string]*repository.Info
+	statuses map[string]*repository.SyncStatus
+}
+
+func newFakeInfoOperator() *fakeInfoOperator {
+	return &fakeInfoOperator{
+		infos:    make(map[string]*repository.Info),
+		statuses: make(map[string]*repository.SyncStatus),
+	}
+}
+
+func (o *fakeInfoOperator) Create(ctx context.Context, i *repository.Info) error {
+	o.infos[i.Name] = i
+	return nil
+}
+
+func (o *fakeInfoOperator) Update(ctx context.Context, i *repository.Info) error {
+	o.infos[i.Name] = i
+	return nil
+}
+
+func (o *fakeInfoOperator) Get(ctx context.Context, name string) (*repository.Info, error) {
+	i, ok := o.infos[name]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return i, nil
+}
+
+func (o *fakeInfoOperator) Delete(ctx context.Context, name string) (*repository.Info, error) {
+	i := o.infos[name]
+	delete(o.infos, name)
+	delete(o.statuses, name)
+	return i, nil
+}
+
+func (o *fakeInfoOperator) List(ctx context.Context) ([]*repository.Info, error) {
+	ret := make([]*repository.Info, 0, len(o.infos))
+	for _, v := range o.infos {
+		ret = append(ret, v)
+	}
+	return ret, nil
+}
+
+func (o *fakeInfoOperator) Watch(ctx context.Context, interval string, callback func(news, updates, deletes []*repository.Info) error) error {
+	return nil
+}
+
+func (o *fakeInfoOperator) SaveStatus(ctx context.Context, name string, status *repository.SyncStatus) error {
+	s := *status
+	o.statuses[name] = &s
+	return nil
+}
+
+func (o *fakeInfoOperator) ListStatus(ctx context.Context, names ...string) (map[string]*repository.SyncStatus, error) {
+	ret := make(map[string]*repository.SyncStatus)
+	for _, name := range names {
+		if s, ok := o.statuses[name]; ok {
+			status := *s
+			ret[name] = &status
+		}
+	}
+	return ret, nil
+}
+
+// fakeRepo count the index updates, the update fails with the err.
+type fakeRepo struct {
+	info    *repository.Info
+	updates int
+	err     error
+}
+
+func (r *fakeRepo) Info() *repository.Info { return r.info }
+
+func (r *fakeRepo) Search(word string) ([]*repository.InstallerBrief, error) { return nil, nil }
+
+func (r *fakeRepo) Get(name, version string) (repository.Installer, error) {
+	return nil, errors.New("not found")
+}
+
+func (r *fakeRepo) Installed() ([]repository.Installer, error) { return nil, nil }
+
+func (r *fakeRepo) Update() (bool, error) {
+	if r.err != nil {
+		return false, r.err
+	}
+	r.updates++
+	return true, nil
+}
+
+func (r *fakeRepo) Len() int { return 1 }
+
+func (r *fakeRepo) Generated() time.Time { return time.Unix(1, 0) }
+
+func (r *fakeRepo) Close() error { return nil }
+
+// newTestHub new the hub whose constructed repositories are kept in the repos.
+func newTestHub(op repository.InfoOperator, repos map[string]*fakeRepo) *Hub {
+	return &Hub{
+		infoOperator: op,
+		repoSet:      new(sync.Map),
+		constructor: func(i *repository.Info, args ...interface{}) (repository.Repository, error) {
+			r := &fakeRepo{info: i}
+			repos[i.Name] = r
+			return r, nil
+		},
+		states:         make(map[string]*repoState),
+		defaultRefresh: time.Hour,
+		tick:           time.Minute,
+	}
+}
+
+func TestParseRefreshInterval(t *testing.T) {
+	d, err := ParseRefreshInterval("")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), d)
+	d, err = ParseRefreshInterval("30m")
+	assert.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, d)
+	for _, v := range []string{"abc", "0s", "-1m"} {
+		_, err = ParseRefreshInterval(v)
+		assert.ErrorIs(t, err, ErrInvalidRefreshInterval, v)
+	}
+}
+
+func TestRefreshInterval(t *testing.T) {
+	op := newFakeInfoOperator()
+	h := newTestHub(op, make(map[string]*fakeRepo))
+
+	// the invalid interval is refused to store.
+	info := repository.NewInfo("invalid", "https://invalid", nil)
+	info.RefreshInterval = "abc"
+	assert.ErrorIs(t, h.Add(info), ErrInvalidRefreshInterval)
+	assert.Empty(t, op.infos)
+
+	// the invalid interval loaded falls back to the default.
+	_, err := h.construct(info)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, h.states["invalid"].interval)
+	assert.Equal(t, "1h0m0s", h.Status("invalid").RefreshInterval)
+
+	info = repository.NewInfo("valid", "https://valid", nil)
+	info.RefreshInterval = "30m"
+	assert.NoError(t, h.Add(info))
+	assert.Equal(t, 30*time.Minute, h.states["valid"].interval)
+	assert.Equal(t, "30m0s", op.statuses["valid"].RefreshInterval)
+}
+
+func TestRefreshSaveStatus(t *testing.T) {
+	op := newFakeInfoOperator()
+	repos := make(map[string]*fakeRepo)
+	h := newTestHub(op, repos)
+	assert.NoError(t, h.Add(repository.NewInfo("default", "https://default", nil)))
+	assert.NotNil(t, op.statuses["default"])
+
+	// the refresh is due after the interval.
+	assert.False(t, h.refreshDue())
+	h.states["default"].lastTry = time.Now().Add(-time.Hour)
+	assert.True(t, h.refreshDue())
+	assert.Equal(t, 1, repos["default"].updates)
+
+	// the failure is saved.
+	repos["default"].err = errors.New("index unavailable")
+	_, err := h.Refresh("default")
+	assert.Error(t, err)
+	assert.Equal(t, "index unavailable", op.statuses["default"].LastError)
+	assert.NotZero(t, op.statuses["default"].LastErrorTimestamp)
+}
+
+func TestFollowStatus(t *testing.T) {
+	op := newFakeInfoOperator()
+	repos := make(map[string]*fakeRepo)
+	h := newTestHub(op, repos)
+	info := repository.NewInfo("default", "https://default", nil)
+	repo, err := h.construct(info)
+	assert.NoError(t, err)
+	h.repoSet.Store(info.Name, repo)
+	synced := h.Status("default").LastSyncTimestamp
+
+	// the status not saved later is not followed.
+	h.followStatus()
+	assert.Equal(t, 0, repos["default"].updates)
+
+	// the repository synced later by the leader is updated.
+	assert.NoError(t, op.SaveStatus(context.TODO(), "default", &repository.SyncStatus{LastSyncTimestamp: synced + 10}))
+	h.followStatus()
+	assert.Equal(t, 1, repos["default"].updates)
+
+	// the later failure is recorded and delays the refresh.
+	failed := time.Now().Add(time.Minute).Unix()
+	assert.NoError(t, op.SaveStatus(context.TODO(), "default", &repository.SyncStatus{
+		LastError:          "index unavailable",
+		LastErrorTimestamp: failed,
+	}))
+	h.followStatus()
+	assert.Equal(t, 1, repos["default"].updates)
+	assert.Equal(t, "index unavailable", h.Status("default").LastError)
+	assert.Equal(t, failed, h.states["default"].lastTry.Unix())
+}
+
+func TestConstructLoadError(t *testing.T) {
+	h := newTestHub(newFakeInfoOperator(), make(map[string]*fakeRepo))
+	info := repository.NewInfo("default", "https://default", nil)
+	info.LoadError = "error decrypt credentials"
+	_, err := h.construct(info)
+	assert.Error(t, err)
+	assert.Equal(t, []*repository.Info{info}, h.Broken())
+	assert.Contains(t, h.Status("default").LastError, "error decrypt credentials")
+}
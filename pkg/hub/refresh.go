@@ -0,0 +1,309 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hub
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+)
+
+// _defaultRefreshFactor the default refresh interval is the watch interval multiplied by the factor.
+const _defaultRefreshFactor = 100
+
+// repoState the sync state of the repository, the info is kept for
+// the broken repository which failed to construct.
+type repoState struct {
+	info     *repository.Info
+	interval time.Duration
+	status   repository.SyncStatus
+	lastTry  time.Time
+	broken   bool
+}
+
+// ParseRefreshInterval parse the refresh interval of the repository, zero if not set.
+func ParseRefreshInterval(interval string) (time.Duration, error) {
+	if interval == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(interval)
+	if err != nil || d <= 0 {
+		return 0, errors.Wrapf(ErrInvalidRefreshInterval, "%q", interval)
+	}
+	return d, nil
+}
+
+// state get the sync state of the repository, created if not found, the caller holds the state lock.
+func (h *Hub) state(name string) *repoState {
+	s, ok := h.states[name]
+	if !ok {
+		s = &repoState{}
+		h.states[name] = s
+	}
+	return s
+}
+
+// setInfo set the info of the state, the refresh interval is parsed only when changed,
+// the default is used if not set or invalid.
+func (h *Hub) setInfo(s *repoState, info *repository.Info) {
+	if s.info == nil || s.interval == 0 || s.info.RefreshInterval != info.RefreshInterval {
+		d, err := ParseRefreshInterval(info.RefreshInterval)
+		if err != nil {
+			log.Warnf("repo(%s) refresh interval use default %s: %s", info.Name, h.defaultRefresh, err)
+		}
+		if d == 0 {
+			d = h.defaultRefresh
+		}
+		s.interval = d
+	}
+	s.info = info
+	s.status.RefreshInterval = s.interval.String()
+}
+
+// synced record the successful sync of the repository.
+func (h *Hub) synced(repo repository.Repository) {
+	h.stateLock.Lock()
+	defer h.stateLock.Unlock()
+	info := repo.Info()
+	now := time.Now()
+	s := h.state(info.Name)
+	h.setInfo(s, info)
+	s.broken = false
+	s.lastTry = now
+	s.status.LastSyncTimestamp = now.Unix()
+	s.status.LastError = ""
+	s.status.ChartCount = repo.Len()
+	s.status.IndexGenerated = repo.Generated().Unix()
+}
+
+// syncFailed record the failed sync of the repository, broken if the repository failed to construct.
+func (h *Hub) syncFailed(info *repository.Info, err error, broken bool) {
+	h.stateLock.Lock()
+	defer h.stateLock.Unlock()
+	now := time.Now()
+	s := h.state(info.Name)
+	h.setInfo(s, info)
+	s.broken = broken
+	s.lastTry = now
+	s.status.LastError = err.Error()
+	s.status.LastErrorTimestamp = now.Unix()
+}
+
+// saveStatus save the sync status of the repository, so that the replicas show the same status
+// and the refresh schedule is kept when the leader changes.
+func (h *Hub) saveStatus(name string) {
+	status := h.Status(name)
+	if status == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	if err := h.infoOperator.SaveStatus(ctx, name, status); err != nil {
+		log.Errorf("error repo operator save repo(%s) status: %s", name, err)
+	}
+}
+
+// followStatus follow the sync status saved by the leader, the repository synced later by the
+// leader is updated, and the later failure is recorded without retry.
+func (h *Hub) followStatus() {
+	h.stateLock.Lock()
+	names := make([]string, 0, len(h.states))
+	for name := range h.states {
+		names = append(names, name)
+	}
+	h.stateLock.Unlock()
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	saved, err := h.infoOperator.ListStatus(ctx, names...)
+	if err != nil {
+		log.Errorf("error repo operator list status: %s", err)
+		return
+	}
+	for name, status := range saved {
+		local := h.Status(name)
+		if local == nil {
+			continue
+		}
+		if status.LastSyncTimestamp > local.LastSyncTimestamp {
+			if _, err = h.update(name); err != nil {
+				log.Warnf("repo follow update error: %s", err)
+			}
+			continue
+		}
+		if status.LastError != "" && status.LastErrorTimestamp > local.LastErrorTimestamp {
+			h.followFailed(name, status)
+		}
+	}
+}
+
+func (h *Hub) followFailed(name string, status *repository.SyncStatus) {
+	h.stateLock.Lock()
+	defer h.stateLock.Unlock()
+	s, ok := h.states[name]
+	if !ok {
+		return
+	}
+	s.lastTry = time.Unix(status.LastErrorTimestamp, 0)
+	s.status.LastError = status.LastError
+	s.status.LastErrorTimestamp = status.LastErrorTimestamp
+}
+
+func (h *Hub) deleteState(name string) {
+	h.stateLock.Lock()
+	defer h.stateLock.Unlock()
+	delete(h.states, name)
+}
+
+// construct the repository and record its sync state.
+func (h *Hub) construct(info *repository.Info) (repository.Repository, error) {
+	if info.LoadError != "" {
+		err := fmt.Errorf("load repo(%s): %s", info.Name, info.LoadError)
+		h.syncFailed(info, err, true)
+		return nil, err
+	}
+	repo, err := h.constructor(info, h.constructorArgs...)
+	if err != nil {
+		h.syncFailed(info, err, true)
+		return nil, err
+	}
+	h.synced(repo)
+	return repo, nil
+}
+
+// Status get the sync status of the repository, nil if not found.
+func (h *Hub) Status(name string) *repository.SyncStatus {
+	h.stateLock.Lock()
+	defer h.stateLock.Unlock()
+	s, ok := h.states[name]
+	if !ok {
+		return nil
+	}
+	status := s.status
+	return &status
+}
+
+// Broken get the repositories which failed to construct, they are retried by the refresh.
+func (h *Hub) Broken() []*repository.Info {
+	h.stateLock.Lock()
+	defer h.stateLock.Unlock()
+	ret := make([]*repository.Info, 0)
+	for _, s := range h.states {
+		if s.broken {
+			ret = append(ret, s.info)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
+// deleteBroken delete the broken repository info, ErrRepoNotFound if not broken.
+func (h *Hub) deleteBroken(name string) error {
+	h.stateLock.Lock()
+	s, ok := h.states[name]
+	broken := ok && s.broken
+	h.stateLock.Unlock()
+	if !broken {
+		return ErrRepoNotFound
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	if _, err := h.infoOperator.Delete(ctx, name); err != nil {
+		return fmt.Errorf("error repo operator delete repo(%s): %w", name, err)
+	}
+	h.deleteState(name)
+	return nil
+}
+
+// Refresh the repository index immediately, the broken repository is reconstructed.
+func (h *Hub) Refresh(name string) (repository.Repository, error) {
+	repo, err := h.refresh(name)
+	if err != nil {
+		return nil, err
+	}
+	h.notifyRefreshed()
+	return repo, nil
+}
+
+// refresh update the repository and save its sync status.
+func (h *Hub) refresh(name string) (repository.Repository, error) {
+	repo, err := h.update(name)
+	h.saveStatus(name)
+	return repo, err
+}
+
+// update the repository index, the broken repository is reconstructed.
+func (h *Hub) update(name string) (repository.Repository, error) {
+	h.stateLock.Lock()
+	s, ok := h.states[name]
+	broken := ok && s.broken
+	var info *repository.Info
+	if broken {
+		info = s.info
+	}
+	h.stateLock.Unlock()
+	if broken {
+		repo, err := h.construct(info)
+		if err != nil {
+			return nil, fmt.Errorf("error constructor(%s): %w", info.Name, err)
+		}
+		h.repoSet.Store(name, repo)
+		return repo, nil
+	}
+	repoIn, ok := h.repoSet.Load(name)
+	if !ok {
+		return nil, ErrRepoNotFound
+	}
+	repo, ok := repoIn.(repository.Repository)
+	if !ok {
+		return nil, ErrInternalError
+	}
+	if _, err := repo.Update(); err != nil {
+		h.syncFailed(repo.Info(), err, false)
+		return nil, fmt.Errorf("error update repo(%s): %w", name, err)
+	}
+	h.synced(repo)
+	return repo, nil
+}
+
+// refreshDue refresh the repositories whose refresh interval passed, return whether any refreshed.
+func (h *Hub) refreshDue() bool {
+	now := time.Now()
+	due := make([]string, 0)
+	h.stateLock.Lock()
+	for name, s := range h.states {
+		if now.Sub(s.lastTry) >= s.interval {
+			due = append(due, name)
+		}
+	}
+	h.stateLock.Unlock()
+	refreshed := false
+	for _, name := range due {
+		if _, err := h.refresh(name); err != nil {
+			log.Warnf("repo refresh error: %s", err)
+			continue
+		}
+		refreshed = true
+	}
+	return refreshed
+}
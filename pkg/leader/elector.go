@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+)
+
+// Runner start the background controller run by the leader until the ctx done,
+// it must not block.
+type Runner func(ctx context.Context)
+
+// Elector run the background controllers only when the identity holds the lock,
+// the controllers are stopped when the leadership is lost.
+type Elector struct {
+	sync.Mutex
+	lock          Lock
+	identity      string
+	leaseDuration time.Duration
+	retryPeriod   time.Duration
+	runners       []Runner
+	cancel        context.CancelFunc // cancel the controllers ctx, it is nil when not leading.
+	renewTime     time.Time
+}
+
+func NewElector(lock Lock, identity, leaseDuration, retryPeriod string) (*Elector, error) {
+	ld, err := time.ParseDuration(leaseDuration)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse lease duration(%s)", leaseDuration)
+	}
+	rp, err := time.ParseDuration(retryPeriod)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse retry period(%s)", retryPeriod)
+	}
+	if rp <= 0 || rp >= ld {
+		return nil, errors.Errorf("retry period(%s) must be positive and less than lease duration(%s)", retryPeriod, leaseDuration)
+	}
+	return &Elector{
+		lock:          lock,
+		identity:      identity,
+		leaseDuration: ld,
+		retryPeriod:   rp,
+	}, nil
+}
+
+// OnStartedLeading add the runner started every time the identity becomes the leader.
+func (e *Elector) OnStartedLeading(r Runner) {
+	e.Lock()
+	defer e.Unlock()
+	e.runners = append(e.runners, r)
+}
+
+// IsLeader get whether the identity is the leader.
+func (e *Elector) IsLeader() bool {
+	e.Lock()
+	defer e.Unlock()
+	return e.cancel != nil
+}
+
+// Run try to acquire and renew the lock in the background until the ctx done,
+// the lock is released when the ctx done.
+func (e *Elector) Run(ctx context.Context) {
+	log.Infof("leader elector(%s) is running", e.identity)
+	go func() {
+		ticker := time.NewTicker(e.retryPeriod)
+		defer ticker.Stop()
+		for {
+			e.tryAcquireOrRenew(ctx)
+			select {
+			case <-ctx.Done():
+				e.stopLeading()
+				releaseCtx, cancel := context.WithTimeout(context.Background(), e.retryPeriod)
+				if err := e.lock.Release(releaseCtx, e.identity); err != nil {
+					log.Errorf("error release leader lock(%s): %s", e.identity, err)
+				}
+				cancel()
+				log.Infof("leader elector(%s) stopped", e.identity)
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (e *Elector) tryAcquireOrRenew(ctx context.Context) {
+	acquireCtx, cancel := context.WithTimeout(ctx, e.retryPeriod)
+	defer cancel()
+	ok, err := e.lock.TryAcquire(acquireCtx, e.identity, e.leaseDuration)
+	if err != nil {
+		log.Warnf("leader elector(%s) acquire lock: %s", e.identity, err)
+		// step down before the lease expires and the others acquire it.
+		if e.IsLeader() && time.Since(e.lastRenew()) > e.leaseDuration-e.retryPeriod {
+			e.stopLeading()
+		}
+		return
+	}
+	if !ok {
+		e.stopLeading()
+		return
+	}
+	e.startLeading(ctx)
+}
+
+func (e *Elector) lastRenew() time.Time {
+	e.Lock()
+	defer e.Unlock()
+	return e.renewTime
+}
+
+func (e *Elector) startLeading(ctx context.Context) {
+	e.Lock()
+	defer e.Unlock()
+	e.renewTime = time.Now()
+	if e.cancel != nil {
+		return
+	}
+	log.Infof("leader elector(%s) started leading", e.identity)
+	leaderCtx, cancel := context.WithCancel(ctx)
+	e.cancel = cancel
+	for _, r := range e.runners {
+		r(leaderCtx)
+	}
+}
+
+func (e *Elector) stopLeading() {
+	e.Lock()
+	defer e.Unlock()
+	if e.cancel == nil {
+		return
+	}
+	log.Infof("leader elector(%s) stopped leading", e.identity)
+	e.cancel()
+	e.cancel = nil
+}
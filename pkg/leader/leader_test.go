@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLock(t *testing.T) {
+	locks := map[string]Lock{
+		"memory": NewMemoryLock(),
+		"file":   NewFileLock(filepath.Join(t.TempDir(), "rudder-leader")),
+	}
+	ctx := context.Background()
+	for name, l := range locks {
+		t.Run(name, func(t *testing.T) {
+			ok, err := l.TryAcquire(ctx, "a", 50*time.Millisecond)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			// held by a.
+			ok, err = l.TryAcquire(ctx, "b", 50*time.Millisecond)
+			assert.NoError(t, err)
+			assert.False(t, ok)
+			// renew.
+			ok, err = l.TryAcquire(ctx, "a", 50*time.Millisecond)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			// expired.
+			time.Sleep(60 * time.Millisecond)
+			ok, err = l.TryAcquire(ctx, "b", 50*time.Millisecond)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			// release by the holder only.
+			assert.NoError(t, l.Release(ctx, "a"))
+			ok, err = l.TryAcquire(ctx, "a", time.Second)
+			assert.NoError(t, err)
+			assert.False(t, ok)
+			assert.NoError(t, l.Release(ctx, "b"))
+			ok, err = l.TryAcquire(ctx, "a", time.Second)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestElector(t *testing.T) {
+	l := NewMemoryLock()
+	started := make(chan string, 2)
+	newElector := func(identity string) *Elector {
+		e, err := NewElector(l, identity, "100ms", "20ms")
+		assert.NoError(t, err)
+		e.OnStartedLeading(func(ctx context.Context) {
+			started <- identity
+		})
+		return e
+	}
+	ctxA, cancelA := context.WithCancel(context.Background())
+	a := newElector("a")
+	a.Run(ctxA)
+	assert.Equal(t, "a", <-started)
+	assert.True(t, a.IsLeader())
+
+	ctxB, cancelB := context.WithCancel(context.Background())
+	defer cancelB()
+	b := newElector("b")
+	b.Run(ctxB)
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, b.IsLeader())
+
+	// b takes over when a stopped and released the lock.
+	cancelA()
+	select {
+	case id := <-started:
+		assert.Equal(t, "b", id)
+	case <-time.After(time.Second):
+		t.Fatal("b not started leading")
+	}
+	assert.False(t, a.IsLeader())
+	assert.True(t, b.IsLeader())
+}
+
+func TestNewElector(t *testing.T) {
+	_, err := NewElector(NewMemoryLock(), "a", "5s", "5s")
+	assert.Error(t, err)
+	_, err = NewElector(NewMemoryLock(), "a", "invalid", "1s")
+	assert.Error(t, err)
+}
+
+func TestNewLock(t *testing.T) {
+	t.Setenv("KUBERNETES_SERVICE_HOST", "")
+	// the lease lock falls back to always leader when not in cluster.
+	l, err := NewLock(LockTypeLease, "rudder-leader", "tkeel-system")
+	assert.NoError(t, err)
+	assert.IsType(t, &NoneLock{}, l)
+	l, err = NewLock(LockTypeNone, "rudder-leader", "tkeel-system")
+	assert.NoError(t, err)
+	ok, err := l.TryAcquire(context.Background(), "a", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = l.TryAcquire(context.Background(), "b", time.Second)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	_, err = NewLock("invalid", "rudder-leader", "tkeel-system")
+	assert.Error(t, err)
+}
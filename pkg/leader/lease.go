@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	coordination_v1 "k8s.io/api/coordination/v1"
+	k_errors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	coordination_client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/client-go/rest"
+)
+
+// LeaseLock the lock saved in the kubernetes coordination lease.
+type LeaseLock struct {
+	name      string
+	namespace string
+	client    coordination_client.LeasesGetter
+}
+
+// NewLeaseLock create the lease lock with the in cluster config.
+func NewLeaseLock(name, namespace string) (*LeaseLock, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "rest in cluster config")
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "new k8s clientset")
+	}
+	return &LeaseLock{
+		name:      name,
+		namespace: namespace,
+		client:    clientset.CoordinationV1(),
+	}, nil
+}
+
+func (l *LeaseLock) TryAcquire(ctx context.Context, identity string, leaseDuration time.Duration) (bool, error) {
+	now := time.Now()
+	lease, err := l.client.Leases(l.namespace).Get(ctx, l.name, meta_v1.GetOptions{})
+	if err != nil {
+		if !k_errors.IsNotFound(err) {
+			return false, errors.Wrapf(err, "get lease %s", l.name)
+		}
+		r := &Record{}
+		r.renew(identity, leaseDuration, now)
+		lease = &coordination_v1.Lease{
+			ObjectMeta: meta_v1.ObjectMeta{Name: l.name, Namespace: l.namespace},
+		}
+		setLeaseRecord(lease, r)
+		if _, err = l.client.Leases(l.namespace).Create(ctx, lease, meta_v1.CreateOptions{}); err != nil {
+			if k_errors.IsAlreadyExists(err) {
+				return false, nil
+			}
+			return false, errors.Wrapf(err, "create lease %s", l.name)
+		}
+		return true, nil
+	}
+	r := getLeaseRecord(lease)
+	if r.HeldByOther(identity, now) {
+		return false, nil
+	}
+	if r.Holder != identity {
+		transitions := int32(1)
+		if lease.Spec.LeaseTransitions != nil {
+			transitions += *lease.Spec.LeaseTransitions
+		}
+		lease.Spec.LeaseTransitions = &transitions
+	}
+	r.renew(identity, leaseDuration, now)
+	setLeaseRecord(lease, r)
+	// the update conflicts when another identity acquired the lease at the same time.
+	if _, err = l.client.Leases(l.namespace).Update(ctx, lease, meta_v1.UpdateOptions{}); err != nil {
+		if k_errors.IsConflict(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "update lease %s", l.name)
+	}
+	return true, nil
+}
+
+func (l *LeaseLock) Release(ctx context.Context, identity string) error {
+	lease, err := l.client.Leases(l.namespace).Get(ctx, l.name, meta_v1.GetOptions{})
+	if err != nil {
+		if k_errors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "get lease %s", l.name)
+	}
+	if getLeaseRecord(lease).Holder != identity {
+		return nil
+	}
+	lease.Spec.HolderIdentity = nil
+	lease.Spec.RenewTime = nil
+	if _, err = l.client.Leases(l.namespace).Update(ctx, lease, meta_v1.UpdateOptions{}); err != nil {
+		return errors.Wrapf(err, "update lease %s", l.name)
+	}
+	return nil
+}
+
+func getLeaseRecord(lease *coordination_v1.Lease) *Record {
+	r := &Record{}
+	if lease.Spec.HolderIdentity != nil {
+		r.Holder = *lease.Spec.HolderIdentity
+	}
+	if lease.Spec.AcquireTime != nil {
+		r.AcquireTime = lease.Spec.AcquireTime.Time
+	}
+	if lease.Spec.RenewTime != nil {
+		r.RenewTime = lease.Spec.RenewTime.Time
+	}
+	if lease.Spec.LeaseDurationSeconds != nil {
+		r.LeaseDuration = time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	}
+	return r
+}
+
+func setLeaseRecord(lease *coordination_v1.Lease, r *Record) {
+	holder := r.Holder
+	seconds := int32(r.LeaseDuration / time.Second)
+	acquireTime := meta_v1.NewMicroTime(r.AcquireTime)
+	renewTime := meta_v1.NewMicroTime(r.RenewTime)
+	lease.Spec.HolderIdentity = &holder
+	lease.Spec.LeaseDurationSeconds = &seconds
+	lease.Spec.AcquireTime = &acquireTime
+	lease.Spec.RenewTime = &renewTime
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	LockTypeLease  = "lease"
+	LockTypeFile   = "file"
+	LockTypeMemory = "memory"
+	// LockTypeNone disable the leader election, the identity is always the leader.
+	LockTypeNone = "none"
+)
+
+// Lock the lock backend of the leader election.
+type Lock interface {
+	// TryAcquire acquire the lock or renew it when the identity is the holder,
+	// it returns whether the identity holds the lock for the lease duration.
+	TryAcquire(ctx context.Context, identity string, leaseDuration time.Duration) (bool, error)
+	// Release release the lock if the identity is the holder.
+	Release(ctx context.Context, identity string) error
+}
+
+// NewLock create the lock of the type, the name is the lease name or the lock file path.
+// The lease lock falls back to always leader when not running in the cluster.
+func NewLock(lockType, name, namespace string) (Lock, error) {
+	switch lockType {
+	case LockTypeLease:
+		l, err := NewLeaseLock(name, namespace)
+		if errors.Is(err, rest.ErrNotInCluster) {
+			log.Warnf("leader election lease(%s) disabled, not in cluster: %s", name, err)
+			return NewNoneLock(), nil
+		}
+		return l, err
+	case LockTypeFile:
+		return NewFileLock(name), nil
+	case LockTypeMemory:
+		return NewMemoryLock(), nil
+	case LockTypeNone, "":
+		return NewNoneLock(), nil
+	default:
+		return nil, errors.Errorf("invalid lock type(%s)", lockType)
+	}
+}
+
+// Record the leader election record saved by the lock.
+type Record struct {
+	Holder        string        `json:"holder,omitempty"`
+	AcquireTime   time.Time     `json:"acquire_time"`
+	RenewTime     time.Time     `json:"renew_time"`
+	LeaseDuration time.Duration `json:"lease_duration"`
+}
+
+// HeldByOther return whether the record is held by another identity and not expired.
+func (r *Record) HeldByOther(identity string, now time.Time) bool {
+	return r.Holder != "" && r.Holder != identity && now.Before(r.RenewTime.Add(r.LeaseDuration))
+}
+
+// renew the record for the identity.
+func (r *Record) renew(identity string, leaseDuration time.Duration, now time.Time) {
+	if r.Holder != identity {
+		r.AcquireTime = now
+	}
+	r.Holder = identity
+	r.RenewTime = now
+	r.LeaseDuration = leaseDuration
+}
+
+// NoneLock the lock always acquired, it is used when the leader election is disabled.
+type NoneLock struct{}
+
+func NewNoneLock() *NoneLock {
+	return &NoneLock{}
+}
+
+func (l *NoneLock) TryAcquire(ctx context.Context, identity string, leaseDuration time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (l *NoneLock) Release(ctx context.Context, identity string) error {
+	return nil
+}
+
+// MemoryLock the lock shared in the process, it is used in tests.
+type MemoryLock struct {
+	sync.Mutex
+	record Record
+}
+
+func NewMemoryLock() *MemoryLock {
+	return &MemoryLock{}
+}
+
+func (l *MemoryLock) TryAcquire(ctx context.Context, identity string, leaseDuration time.Duration) (bool, error) {
+	l.Lock()
+	defer l.Unlock()
+	now := time.Now()
+	if l.record.HeldByOther(identity, now) {
+		return false, nil
+	}
+	l.record.renew(identity, leaseDuration, now)
+	return true, nil
+}
+
+func (l *MemoryLock) Release(ctx context.Context, identity string) error {
+	l.Lock()
+	defer l.Unlock()
+	if l.record.Holder == identity {
+		l.record = Record{}
+	}
+	return nil
+}
+
+// FileLock the lock saved in a local file, it is used in tests and single host deployments.
+// The record is replaced atomically, but the processes acquiring the expired lock
+// at the same time may both hold it until the next renew.
+type FileLock struct {
+	sync.Mutex
+	path string
+}
+
+func NewFileLock(path string) *FileLock {
+	return &FileLock{path: path}
+}
+
+func (l *FileLock) TryAcquire(ctx context.Context, identity string, leaseDuration time.Duration) (bool, error) {
+	l.Lock()
+	defer l.Unlock()
+	r, err := l.read()
+	if err != nil {
+		return false, err
+	}
+	now := time.Now()
+	if r.HeldByOther(identity, now) {
+		return false, nil
+	}
+	r.renew(identity, leaseDuration, now)
+	if err = l.write(r); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (l *FileLock) Release(ctx context.Context, identity string) error {
+	l.Lock()
+	defer l.Unlock()
+	r, err := l.read()
+	if err != nil {
+		return err
+	}
+	if r.Holder != identity {
+		return nil
+	}
+	if err = os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove lock file %s", l.path)
+	}
+	return nil
+}
+
+func (l *FileLock) read() (*Record, error) {
+	r := &Record{}
+	b, err := ioutil.ReadFile(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return r, nil
+		}
+		return nil, errors.Wrapf(err, "read lock file %s", l.path)
+	}
+	if err = json.Unmarshal(b, r); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal lock file %s", l.path)
+	}
+	return r, nil
+}
+
+func (l *FileLock) write(r *Record) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return errors.Wrap(err, "marshal record")
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(l.path), filepath.Base(l.path)+".*")
+	if err != nil {
+		return errors.Wrapf(err, "create temp lock file %s", l.path)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "write temp lock file %s", tmp.Name())
+	}
+	if err = tmp.Close(); err != nil {
+		return errors.Wrapf(err, "close temp lock file %s", tmp.Name())
+	}
+	if err = os.Rename(tmp.Name(), l.path); err != nil {
+		return errors.Wrapf(err, "rename lock file %s", l.path)
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	APIVersion = "tkeel.io/v1"
+	Kind       = "Platform"
+)
+
+// Manifest the desired state of the tKeel platform.
+type Manifest struct {
+	APIVersion string    `json:"apiVersion"`
+	Kind       string    `json:"kind"`
+	Repos      []*Repo   `json:"repos,omitempty"`
+	Plugins    []*Plugin `json:"plugins,omitempty"`
+	// ExtraConfig platform extra config key map to the value, the empty key is the root config.
+	ExtraConfig map[string]interface{} `json:"extraConfig,omitempty"`
+}
+
+// Repo the plugin repository.
+type Repo struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// Plugin the installed plugin.
+type Plugin struct {
+	ID        string `json:"id"`
+	Repo      string `json:"repo"`
+	Installer string `json:"installer"`
+	Version   string `json:"version"`
+	// Configuration the values of the plugin, only the keys set are managed.
+	Configuration map[string]interface{} `json:"configuration,omitempty"`
+	// Tenants the tenants enabled the plugin, the tenants are not managed when nil(or null),
+	// the empty list is kept so that no tenant enabled is managed.
+	Tenants []string `json:"tenants"`
+}
+
+// Parse parse the YAML or JSON manifest and validate it.
+func Parse(b []byte) (*Manifest, error) {
+	m := &Manifest{}
+	if err := yaml.Unmarshal(b, m); err != nil {
+		return nil, errors.Wrap(err, "unmarshal manifest")
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Marshal marshal the manifest to YAML.
+func (m *Manifest) Marshal() ([]byte, error) {
+	b, err := yaml.Marshal(m)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal manifest")
+	}
+	return b, nil
+}
+
+func (m *Manifest) Validate() error {
+	if m.APIVersion != APIVersion || m.Kind != Kind {
+		return errors.Errorf("invalid manifest %s/%s, want %s/%s", m.APIVersion, m.Kind, APIVersion, Kind)
+	}
+	repos := make(map[string]bool, len(m.Repos))
+	for _, v := range m.Repos {
+		if v.Name == "" || v.URL == "" {
+			return errors.Errorf("invalid repo(%s): name and url required", v.Name)
+		}
+		if repos[v.Name] {
+			return errors.Errorf("duplicate repo(%s)", v.Name)
+		}
+		repos[v.Name] = true
+	}
+	plugins := make(map[string]bool, len(m.Plugins))
+	for _, v := range m.Plugins {
+		if v.ID == "" || v.Repo == "" || v.Installer == "" || v.Version == "" {
+			return errors.Errorf("invalid plugin(%s): id, repo, installer and version required", v.ID)
+		}
+		if plugins[v.ID] {
+			return errors.Errorf("duplicate plugin(%s)", v.ID)
+		}
+		plugins[v.ID] = true
+	}
+	return nil
+}
+
+// Sort sort the repos, plugins and tenants to make the manifest stable.
+func (m *Manifest) Sort() {
+	sort.Slice(m.Repos, func(i, j int) bool {
+		return m.Repos[i].Name < m.Repos[j].Name
+	})
+	sort.Slice(m.Plugins, func(i, j int) bool {
+		return m.Plugins[i].ID < m.Plugins[j].ID
+	})
+	for _, v := range m.Plugins {
+		sort.Strings(v.Tenants)
+	}
+}
+
+const (
+	KindRepo        = "repo"
+	KindPlugin      = "plugin"
+	KindTenant      = "tenant"
+	KindExtraConfig = "extra_config"
+
+	ActionCreate      = "create"
+	ActionUpdate      = "update"
+	ActionDelete      = "delete"
+	ActionInstall     = "install"
+	ActionUpgrade     = "upgrade"
+	ActionReconfigure = "reconfigure"
+	ActionUninstall   = "uninstall"
+	ActionEnable      = "enable"
+	ActionDisable     = "disable"
+	ActionSet         = "set"
+)
+
+// Change a difference between the desired and the current state.
+type Change struct {
+	Kind   string `json:"kind"`
+	Action string `json:"action"`
+	// Target the repo name, plugin id, plugin_id/tenant_id or extra config key.
+	Target string `json:"target"`
+	Detail string `json:"detail,omitempty"`
+
+	Repo   *Repo       `json:"-"`
+	Plugin *Plugin     `json:"-"`
+	Tenant string      `json:"-"`
+	Value  interface{} `json:"-"`
+}
+
+func (c *Change) String() string {
+	return fmt.Sprintf("%s %s %s %s", c.Action, c.Kind, c.Target, c.Detail)
+}
+
+// Diff get the changes to reconcile the current state to the desired state in the applying order,
+// the repos, plugins and tenants not in the desired state are removed only when prune is true.
+// The configuration of the current plugins is compared with the desired keys only.
+func Diff(desired, current *Manifest, prune bool) []*Change {
+	ret := make([]*Change, 0)
+	curRepos := make(map[string]*Repo, len(current.Repos))
+	for _, v := range current.Repos {
+		curRepos[v.Name] = v
+	}
+	desRepos := make(map[string]bool, len(desired.Repos))
+	for _, v := range desired.Repos {
+		desRepos[v.Name] = true
+		cur, ok := curRepos[v.Name]
+		switch {
+		case !ok:
+			ret = append(ret, &Change{Kind: KindRepo, Action: ActionCreate, Target: v.Name, Detail: v.URL, Repo: v})
+		case cur.URL != v.URL:
+			ret = append(ret, &Change{Kind: KindRepo, Action: ActionUpdate, Target: v.Name, Detail: cur.URL + " -> " + v.URL, Repo: v})
+		}
+	}
+	curPlugins := make(map[string]*Plugin, len(current.Plugins))
+	for _, v := range current.Plugins {
+		curPlugins[v.ID] = v
+	}
+	desPlugins := make(map[string]bool, len(desired.Plugins))
+	tenantChanges := make([]*Change, 0)
+	pruneTenants := make([]*Change, 0)
+	for _, v := range desired.Plugins {
+		desPlugins[v.ID] = true
+		cur, ok := curPlugins[v.ID]
+		switch {
+		case !ok:
+			ret = append(ret, &Change{Kind: KindPlugin, Action: ActionInstall, Target: v.ID, Detail: installerString(v), Plugin: v})
+			cur = &Plugin{ID: v.ID}
+		case cur.Repo != v.Repo || cur.Installer != v.Installer || cur.Version != v.Version:
+			ret = append(ret, &Change{
+				Kind: KindPlugin, Action: ActionUpgrade, Target: v.ID,
+				Detail: installerString(cur) + " -> " + installerString(v), Plugin: v,
+			})
+		default:
+			if keys := changedKeys(v.Configuration, cur.Configuration); len(keys) != 0 {
+				ret = append(ret, &Change{
+					Kind: KindPlugin, Action: ActionReconfigure, Target: v.ID,
+					Detail: "configuration " + strings.Join(keys, ","), Plugin: v,
+				})
+			}
+		}
+		if v.Tenants == nil {
+			continue
+		}
+		enabled := make(map[string]bool, len(cur.Tenants))
+		for _, t := range cur.Tenants {
+			enabled[t] = true
+		}
+		desTenants := make(map[string]bool, len(v.Tenants))
+		for _, t := range v.Tenants {
+			desTenants[t] = true
+			if !enabled[t] {
+				tenantChanges = append(tenantChanges, &Change{Kind: KindTenant, Action: ActionEnable, Target: v.ID + "/" + t, Plugin: v, Tenant: t})
+			}
+		}
+		if prune {
+			for _, t := range cur.Tenants {
+				if !desTenants[t] {
+					pruneTenants = append(pruneTenants, &Change{Kind: KindTenant, Action: ActionDisable, Target: v.ID + "/" + t, Plugin: v, Tenant: t})
+				}
+			}
+		}
+	}
+	ret = append(ret, tenantChanges...)
+	keys := make([]string, 0, len(desired.ExtraConfig))
+	for k := range desired.ExtraConfig {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		cur, ok := current.ExtraConfig[k]
+		if !ok || !jsonEqual(desired.ExtraConfig[k], cur) {
+			ret = append(ret, &Change{Kind: KindExtraConfig, Action: ActionSet, Target: k, Value: desired.ExtraConfig[k]})
+		}
+	}
+	if !prune {
+		return ret
+	}
+	ret = append(ret, pruneTenants...)
+	for _, v := range current.Plugins {
+		if !desPlugins[v.ID] {
+			ret = append(ret, &Change{Kind: KindPlugin, Action: ActionUninstall, Target: v.ID, Detail: installerString(v), Plugin: v})
+		}
+	}
+	for _, v := range current.Repos {
+		if !desRepos[v.Name] {
+			ret = append(ret, &Change{Kind: KindRepo, Action: ActionDelete, Target: v.Name, Detail: v.URL, Repo: v})
+		}
+	}
+	return ret
+}
+
+func installerString(p *Plugin) string {
+	return fmt.Sprintf("%s/%s@%s", p.Repo, p.Installer, p.Version)
+}
+
+// changedKeys get the desired keys whose value is not contained in the current.
+func changedKeys(desired, current map[string]interface{}) []string {
+	ret := make([]string, 0)
+	for k, v := range desired {
+		cur, ok := current[k]
+		if !ok || !jsonContains(v, cur) {
+			ret = append(ret, k)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// jsonContains whether the desired value is contained in the current, the tables are
+// contained if their desired keys are, as the configuration is merged into the values.
+func jsonContains(desired, current interface{}) bool {
+	d, ok := desired.(map[string]interface{})
+	if !ok {
+		return jsonEqual(desired, current)
+	}
+	c, ok := current.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	return len(changedKeys(d, c)) == 0
+}
+
+// jsonEqual compare the values by their JSON form, so that the numbers decoded
+// from YAML and JSON are compared equally.
+func jsonEqual(a, b interface{}) bool {
+	var na, nb interface{}
+	ba, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bb, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	if json.Unmarshal(ba, &na) != nil || json.Unmarshal(bb, &nb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(na, nb)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testManifest = `
+apiVersion: tkeel.io/v1
+kind: Platform
+repos:
+- name: tkeel
+  url: https://tkeel-io.github.io/helm-charts
+plugins:
+- id: iothub
+  repo: tkeel
+  installer: iothub
+  version: 0.4.1
+  configuration:
+    replicas: 2
+  tenants: [t1, t2]
+- id: rule-manager
+  repo: tkeel
+  installer: rule-manager
+  version: 0.4.0
+extraConfig:
+  "":
+    title: tKeel
+`
+
+func TestParse(t *testing.T) {
+	m, err := Parse([]byte(testManifest))
+	assert.Nil(t, err)
+	assert.Len(t, m.Plugins, 2)
+	assert.Nil(t, m.Plugins[1].Tenants)
+
+	_, err = Parse([]byte("apiVersion: v1\nkind: Platform\n"))
+	assert.NotNil(t, err)
+	_, err = Parse([]byte("apiVersion: tkeel.io/v1\nkind: Platform\nrepos:\n- name: a\n  url: b\n- name: a\n  url: c\n"))
+	assert.NotNil(t, err)
+}
+
+func TestMarshalTenants(t *testing.T) {
+	m := &Manifest{
+		APIVersion: APIVersion,
+		Kind:       Kind,
+		Plugins: []*Plugin{
+			{ID: "iothub", Repo: "tkeel", Installer: "iothub", Version: "0.4.1", Tenants: []string{}},
+			{ID: "rule-manager", Repo: "tkeel", Installer: "rule-manager", Version: "0.4.0"},
+		},
+	}
+	b, err := m.Marshal()
+	assert.Nil(t, err)
+	got, err := Parse(b)
+	assert.Nil(t, err)
+	// no tenant enabled is still managed after exported.
+	assert.NotNil(t, got.Plugins[0].Tenants)
+	assert.Empty(t, got.Plugins[0].Tenants)
+	assert.Nil(t, got.Plugins[1].Tenants)
+}
+
+func TestDiff(t *testing.T) {
+	desired, err := Parse([]byte(testManifest))
+	assert.Nil(t, err)
+	current := &Manifest{
+		Repos: []*Repo{
+			{Name: "tkeel", URL: "https://tkeel-io.github.io/helm-charts"},
+			{Name: "old", URL: "https://example.com/charts"},
+		},
+		Plugins: []*Plugin{
+			{
+				ID: "iothub", Repo: "tkeel", Installer: "iothub", Version: "0.4.1",
+				Configuration: map[string]interface{}{"replicas": 1, "secret": "s"},
+				Tenants:       []string{"t1", "t3"},
+			},
+			{ID: "old-plugin", Repo: "old", Installer: "old-plugin", Version: "0.1.0"},
+		},
+		ExtraConfig: map[string]interface{}{"": map[string]interface{}{"title": "tKeel"}},
+	}
+
+	got := make([]string, 0)
+	for _, v := range Diff(desired, current, false) {
+		got = append(got, v.Action+" "+v.Target)
+	}
+	assert.Equal(t, []string{"reconfigure iothub", "install rule-manager", "enable iothub/t2"}, got)
+
+	got = got[:0]
+	for _, v := range Diff(desired, current, true) {
+		got = append(got, v.Action+" "+v.Target)
+	}
+	assert.Equal(t, []string{
+		"reconfigure iothub", "install rule-manager", "enable iothub/t2",
+		"disable iothub/t3", "uninstall old-plugin", "delete old",
+	}, got)
+
+	current.Plugins[0].Configuration["replicas"] = 2
+	current.Plugins[0].Version = "0.4.0"
+	changes := Diff(desired, current, false)
+	assert.Equal(t, ActionUpgrade, changes[0].Action)
+	assert.Equal(t, "tkeel/iothub@0.4.0 -> tkeel/iothub@0.4.1", changes[0].Detail)
+}
+
+func TestChangedKeys(t *testing.T) {
+	current := map[string]interface{}{
+		"replicas": 1,
+		"image":    "iothub:v0.4.1",
+		"db":       map[string]interface{}{"host": "mysql", "port": 3306},
+	}
+	// the effective values contain the desired configuration.
+	assert.Empty(t, changedKeys(map[string]interface{}{
+		"replicas": float64(1),
+		"db":       map[string]interface{}{"host": "mysql"},
+	}, current))
+	assert.Equal(t, []string{"db", "replicas"}, changedKeys(map[string]interface{}{
+		"replicas": 2,
+		"image":    "iothub:v0.4.1",
+		"db":       map[string]interface{}{"host": "mysql", "user": "root"},
+	}, current))
+	assert.Equal(t, []string{"image"}, changedKeys(map[string]interface{}{
+		"image": map[string]interface{}{"tag": "v0.4.1"},
+	}, current))
+}
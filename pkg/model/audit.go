@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	AuditActionInstallPlugin       = "plugin.install"
+	AuditActionUpgradePlugin       = "plugin.upgrade"
+	AuditActionRollbackPlugin      = "plugin.rollback"
+	AuditActionUninstallPlugin     = "plugin.uninstall"
+	AuditActionReconfigurePlugin   = "plugin.reconfigure"
+	AuditActionRotatePluginSecret  = "plugin.rotate_secret"
+	AuditActionSetUpgradePolicy    = "plugin.set_upgrade_policy"
+	AuditActionSetMaintenance      = "plugin.set_maintenance"
+	AuditActionSetCandidateRoute   = "plugin.set_candidate_route"
+	AuditActionPromoteCandidate    = "plugin.promote_candidate"
+	AuditActionAbortCandidate      = "plugin.abort_candidate"
+	AuditActionTenantEnablePlugin  = "plugin.tenant_enable"
+	AuditActionTenantDisablePlugin = "plugin.tenant_disable"
+	AuditActionBatchTenantEnable   = "plugin.batch_tenant_enable"
+	AuditActionBatchTenantDisable  = "plugin.batch_tenant_disable"
+	AuditActionReconcilePlugins    = "plugin.reconcile"
+	AuditActionCreateTenant        = "tenant.create"
+	AuditActionUpdateTenant        = "tenant.update"
+	AuditActionDeleteTenant        = "tenant.delete"
+	AuditActionCreateRole          = "role.create"
+	AuditActionUpdateRole          = "role.update"
+	AuditActionDeleteRole          = "role.delete"
+	AuditActionCreateRoleBinding   = "role.create_binding"
+	AuditActionUpdateRoleBinding   = "role.update_binding"
+	AuditActionDeleteRoleBinding   = "role.delete_binding"
+	AuditActionUpdateAdminPassword = "admin.update_password"
+	AuditActionApplyManifest       = "manifest.apply"
+
+	AuditResultSuccess = "success"
+	AuditResultFailure = "failure"
+)
+
+type AuditLog struct {
+	ID          uint   `gorm:"primarykey" json:"id"`               // audit log id.
+	Actor       string `gorm:"size:128;index" json:"actor"`        // operator user id.
+	ActorTenant string `gorm:"size:128" json:"actor_tenant"`       // operator tenant id.
+	Tenant      string `gorm:"size:128;index" json:"tenant"`       // the tenant which the operation belongs to.
+	Action      string `gorm:"size:64;index" json:"action"`        // operation action.
+	Target      string `gorm:"size:256;index" json:"target"`       // operation target.
+	Params      string `gorm:"type:text" json:"params,omitempty"`  // operation parameters(json).
+	Result      string `gorm:"size:16;index" json:"result"`        // operation result.
+	Message     string `gorm:"type:text" json:"message,omitempty"` // the error message when failed.
+	Timestamp   int64  `gorm:"index" json:"timestamp"`             // operation timestamp.
+}
+
+func (*AuditLog) TableName() string {
+	return "tkeel_audit_log"
+}
+
+func (a *AuditLog) String() string {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+	return string(b)
+}
+
+func NewAuditLog(actor, actorTenant, tenant, action, target, params string, err error) *AuditLog {
+	ret := &AuditLog{
+		Actor:       actor,
+		ActorTenant: actorTenant,
+		Tenant:      tenant,
+		Action:      action,
+		Target:      target,
+		Params:      params,
+		Result:      AuditResultSuccess,
+		Timestamp:   time.Now().Unix(),
+	}
+	if err != nil {
+		ret.Result = AuditResultFailure
+		ret.Message = err.Error()
+	}
+	return ret
+}
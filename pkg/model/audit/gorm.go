@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package audit
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/tkeel-io/tkeel/pkg/model"
+)
+
+type GormOperator struct {
+	db *gorm.DB
+}
+
+func NewGormOperator(db *gorm.DB) (*GormOperator, error) {
+	if err := db.AutoMigrate(new(model.AuditLog)); err != nil {
+		return nil, errors.Wrap(err, "auto migrate audit log")
+	}
+	return &GormOperator{db: db}, nil
+}
+
+func (o *GormOperator) Create(ctx context.Context, a *model.AuditLog) error {
+	if err := o.db.WithContext(ctx).Create(a).Error; err != nil {
+		return errors.Wrapf(err, "create audit log(%s)", a)
+	}
+	return nil
+}
+
+func (o *GormOperator) List(ctx context.Context, q *Query) ([]*model.AuditLog, int64, error) {
+	tx := o.db.WithContext(ctx).Model(new(model.AuditLog))
+	for k, v := range map[string]string{
+		"tenant": q.Tenant,
+		"actor":  q.Actor,
+		"action": q.Action,
+		"target": q.Target,
+		"result": q.Result,
+	} {
+		if v != "" {
+			tx = tx.Where(k+" = ?", v)
+		}
+	}
+	if q.StartTime != 0 {
+		tx = tx.Where("timestamp >= ?", q.StartTime)
+	}
+	if q.EndTime != 0 {
+		tx = tx.Where("timestamp < ?", q.EndTime)
+	}
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "count audit log")
+	}
+	if q.PageNum > 0 && q.PageSize > 0 {
+		tx = tx.Offset((q.PageNum - 1) * q.PageSize).Limit(q.PageSize)
+	}
+	ret := make([]*model.AuditLog, 0)
+	if err := tx.Order("timestamp desc, id desc").Find(&ret).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "find audit log")
+	}
+	return ret, total, nil
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package audit
+
+import (
+	"context"
+
+	"github.com/tkeel-io/tkeel/pkg/model"
+)
+
+// Query the audit log query conditions, the empty condition is ignored.
+type Query struct {
+	Tenant    string
+	Actor     string
+	Action    string
+	Target    string
+	Result    string
+	StartTime int64 // include.
+	EndTime   int64 // exclude.
+	PageNum   int   // start from 1, 0 means no pagination.
+	PageSize  int
+}
+
+// Operator contains all operations to audit log.
+type Operator interface {
+	// Create audit log.
+	Create(context.Context, *model.AuditLog) error
+	// List audit logs matching the query ordered by time descending, return the total number matched.
+	List(context.Context, *Query) ([]*model.AuditLog, int64, error)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import "time"
+
+const (
+	HealthStateRunning     = "RUNNING"
+	HealthStateDegraded    = "DEGRADED"
+	HealthStateUnreachable = "UNREACHABLE"
+
+	// MaxHealthTransitions the max number of the health transitions kept on plugin.
+	MaxHealthTransitions = 20
+)
+
+type HealthTransition struct {
+	From      string `json:"from,omitempty"`      // previous health state.
+	To        string `json:"to,omitempty"`        // current health state.
+	Reason    string `json:"reason,omitempty"`    // transition reason.
+	Timestamp int64  `json:"timestamp,omitempty"` // transition timestamp.
+}
+
+type PluginHealth struct {
+	State       string              `json:"state,omitempty"`       // current health state.
+	Reason      string              `json:"reason,omitempty"`      // current health state reason.
+	Since       int64               `json:"since,omitempty"`       // timestamp of entering the current state.
+	Transitions []*HealthTransition `json:"transitions,omitempty"` // recent health transitions, the latest is last.
+}
+
+// UpdateHealth set the plugin health state and record the transition, return whether the state changed.
+func (p *Plugin) UpdateHealth(state, reason string) bool {
+	if p.Health == nil {
+		p.Health = &PluginHealth{}
+	}
+	h := p.Health
+	if h.State == state {
+		return false
+	}
+	now := time.Now().Unix()
+	h.Transitions = append(h.Transitions, &HealthTransition{
+		From:      h.State,
+		To:        state,
+		Reason:    reason,
+		Timestamp: now,
+	})
+	if len(h.Transitions) > MaxHealthTransitions {
+		h.Transitions = h.Transitions[len(h.Transitions)-MaxHealthTransitions:]
+	}
+	h.State = state
+	h.Reason = reason
+	h.Since = now
+	return true
+}
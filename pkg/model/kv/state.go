@@ -27,6 +27,7 @@ import (
 
 type DaprStateOprator struct {
 	storeName  string
+	interval   string
 	daprClient dapr.Client
 	cache      *sync.Map
 }
@@ -37,15 +38,23 @@ type DaprStateCache struct {
 	cb      func(value []byte, version string) error
 }
 
-// dapr state.
+// dapr state, the watched keys are polled by the interval after Run.
 func NewDaprStateOperator(interval, storeName string, c dapr.Client) *DaprStateOprator {
-	d := &DaprStateOprator{
+	return &DaprStateOprator{
 		cache:      new(sync.Map),
 		storeName:  storeName,
+		interval:   interval,
 		daprClient: c,
 	}
-	go d.watcher(context.TODO(), interval)
-	return d
+}
+
+// Run poll the watched keys until the ctx done.
+func (o *DaprStateOprator) Run(ctx context.Context) {
+	go func() {
+		if err := o.watcher(ctx, o.interval); err != nil {
+			log.Errorf("error dapr state oprator watch: %s", err)
+		}
+	}()
 }
 
 func (o *DaprStateOprator) Create(ctx context.Context, key string, value []byte) error {
@@ -169,7 +178,13 @@ func (o *DaprStateOprator) watcher(ctx context.Context, interval string) error {
 		return errors.Wrapf(err, "dapr state oprator watch parse interval(%s)", interval)
 	}
 	tick := time.NewTicker(in)
-	for range tick.C {
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tick.C:
+		}
 		o.cache.Range(func(key, value interface{}) bool {
 			k, ok := key.(string)
 			if !ok {
@@ -198,5 +213,4 @@ func (o *DaprStateOprator) watcher(ctx context.Context, interval string) error {
 		})
 		tick.Reset(in)
 	}
-	return nil
 }
@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"net/http"
 	"net/url"
 	"sort"
@@ -60,6 +61,8 @@ const (
 
 	KeyPlatExtraConfig = "platform_extra"
 
+	KeyPlatManifest = "platform_manifest"
+
 	AllowedPermissionAction = "_tkeel_allow"
 
 	_allowedPluginAccessName = " 允许访问"
@@ -80,6 +83,9 @@ var (
 var (
 	XPluginJwtHeader = http.CanonicalHeaderKey("x-plugin-jwt")
 	XtKeelAuthHeader = http.CanonicalHeaderKey("x-tKeel-auth")
+	// XtKeelMaintenanceBypassHeader the platform admin requests with this header are
+	// proxied to the plugin in maintenance.
+	XtKeelMaintenanceBypassHeader = http.CanonicalHeaderKey("x-tKeel-maintenance-bypass")
 
 	AuthorizationHeader = http.CanonicalHeaderKey("Authorization")
 
@@ -120,13 +126,27 @@ type Plugin struct {
 	ConsoleEntries          []*openapi_v1.ConsoleEntry      `json:"console_entries,omitempty"`           // plugin console entries.
 	PluginDependences       []*openapi_v1.BriefPluginInfo   `json:"plugin_dependences,omitempty"`        // plugin dependences.
 	Permissions             []*openapi_v1.Permission        `json:"permissions,omitempty"`               // plugin permissions.
-	Secret                  string                          `json:"secret,omitempty"`                    // plugin registered secret.
+	Secret                  string                          `json:"secret,omitempty"`                    // plugin secret hash.
+	PreviousSecret          string                          `json:"previous_secret,omitempty"`           // plugin previous secret hash accepted in the grace window.
+	PreviousSecretExpire    int64                           `json:"previous_secret_expire,omitempty"`    // previous secret expire timestamp.
 	RegisterTimestamp       int64                           `json:"register_timestamp,omitempty"`        // register timestamp.
 	Version                 string                          `json:"version,omitempty"`                   // model version.
 	Status                  openapi_v1.PluginStatus         `json:"status,omitempty"`                    // plugin state.
 	EnableTenantes          []*EnableTenant                 `json:"enable_tenantes,omitempty"`           // plugin active tenantes.
 	DisableManualActivation bool                            `json:"disable_manual_activation,omitempty"` // plugin disable manual activation.
 	Profiles                interface{}                     `json:"profiles,omitempty"`                  // plugin profile.
+	Health                  *PluginHealth                   `json:"health,omitempty"`                    // plugin health monitored.
+	UpgradePolicy           string                          `json:"upgrade_policy,omitempty"`            // plugin upgrade policy.
+	FailedAutoVersion       string                          `json:"failed_auto_version,omitempty"`       // the version the automatic upgrade failed to, skipped until a newer version found.
+	AppID                   string                          `json:"app_id,omitempty"`                    // helm release name and dapr app id of the plugin, empty means the plugin id.
+}
+
+// GetAppID get the helm release name and the dapr app id of the plugin.
+func (p *Plugin) GetAppID() string {
+	if p.AppID == "" {
+		return p.ID
+	}
+	return p.AppID
 }
 
 func (p *Plugin) String() string {
@@ -158,7 +178,7 @@ func (p *Plugin) Upgrade(installer *Installer) {
 	p.Status = openapi_v1.PluginStatus_WAIT_RUNNING
 }
 
-func (p *Plugin) Register(resp *openapi_v1.IdentifyResponse, secret string) {
+func (p *Plugin) Register(resp *openapi_v1.IdentifyResponse) {
 	p.PluginVersion = resp.Version
 	p.TkeelVersion = resp.TkeelVersion
 	p.AddonsPoint = resp.AddonsPoint
@@ -166,12 +186,34 @@ func (p *Plugin) Register(resp *openapi_v1.IdentifyResponse, secret string) {
 	p.PluginDependences = resp.Dependence
 	p.ConsoleEntries = resp.Entries
 	p.Permissions = resp.Permissions
-	p.Secret = secret
 	p.DisableManualActivation = resp.DisableManualActivation
 	p.RegisterTimestamp = time.Now().Unix()
 	p.Profiles = &resp.Profiles
 }
 
+// IdentifyEqual check whether the identify response is the same as the registered one.
+func (p *Plugin) IdentifyEqual(resp *openapi_v1.IdentifyResponse) bool {
+	newP := &Plugin{ID: p.ID}
+	newP.Register(resp)
+	// the registered plugin is restored from json, compare in the same form.
+	if newP = newP.Clone(); newP == nil {
+		return false
+	}
+	digest := func(v *Plugin) string {
+		b, err := json.Marshal([]interface{}{
+			v.PluginVersion, v.TkeelVersion, v.AddonsPoint, v.ImplementedPlugin, v.ConsoleEntries,
+			v.PluginDependences, v.Permissions, v.DisableManualActivation, v.Profiles,
+		})
+		if err != nil {
+			log.Errorf("error digest plugin(%s) identify: %s", v.ID, err)
+			return ""
+		}
+		return string(b)
+	}
+	d := digest(p)
+	return d != "" && d == digest(newP)
+}
+
 func (p *Plugin) Clone() *Plugin {
 	b, err := json.Marshal(p)
 	if err != nil {
@@ -227,9 +269,77 @@ type PluginRoute struct {
 	TkeelVersion      string                  `json:"tkeel_version,omitempty"`      // plugin depened tkeel version.
 	RegisterAddons    map[string]string       `json:"register_addons,omitempty"`    // plugin register addons route map.
 	ImplementedPlugin []string                `json:"implemented_plugin,omitempty"` // plugin implemented plugins.
+	Maintenance       *PluginMaintenance      `json:"maintenance,omitempty"`        // plugin maintenance mode, nil means disabled.
+	AppID             string                  `json:"app_id,omitempty"`             // dapr app id of the plugin, empty means the plugin id.
+	Candidate         *PluginCandidate        `json:"candidate,omitempty"`          // blue/green upgrade candidate of the plugin.
 	Version           string                  `json:"version,omitempty"`            // model version.
 }
 
+// GetAppID get the dapr app id of the plugin.
+func (pr *PluginRoute) GetAppID() string {
+	if pr.AppID == "" {
+		return pr.ID
+	}
+	return pr.AppID
+}
+
+// RouteAppID get the dapr app id serving the requests of the tenant,
+// the ready candidate serves the candidate tenants and the tenants in its percentage.
+func (pr *PluginRoute) RouteAppID(tenantID string) string {
+	if pr.Candidate != nil && pr.Candidate.Routed(tenantID) {
+		return pr.Candidate.AppID
+	}
+	return pr.GetAppID()
+}
+
+// PluginMaintenance the maintenance mode of the plugin, the gateway answers the
+// requests to the plugin with 503 until the maintenance is disabled, the end
+// timestamp is only the expected end told to the clients.
+type PluginMaintenance struct {
+	Message        string `json:"message,omitempty"`         // message told to the clients.
+	StartTimestamp int64  `json:"start_timestamp,omitempty"` // maintenance start time.
+	EndTimestamp   int64  `json:"end_timestamp,omitempty"`   // expected end time, 0 means unknown.
+	OperatorID     string `json:"operator_id,omitempty"`     // maintenance operator.
+}
+
+const (
+	CandidateStatusDeploying = "deploying"
+	CandidateStatusReady     = "ready"
+	CandidateStatusFailed    = "failed"
+)
+
+// PluginCandidate the new version of the plugin installed as a separate release with its own app id,
+// the tenants are routed to it once it is ready until it is promoted or aborted.
+type PluginCandidate struct {
+	AppID          string     `json:"app_id,omitempty"`          // candidate helm release name and dapr app id.
+	Installer      *Installer `json:"installer,omitempty"`       // candidate installer.
+	Tenants        []string   `json:"tenants,omitempty"`         // tenants routed to the candidate.
+	Percent        int32      `json:"percent,omitempty"`         // percentage of the other tenants routed to the candidate.
+	Status         string     `json:"status,omitempty"`          // candidate status.
+	Message        string     `json:"message,omitempty"`         // candidate status message.
+	OperatorID     string     `json:"operator_id,omitempty"`     // candidate operator.
+	StartTimestamp int64      `json:"start_timestamp,omitempty"` // candidate deploy time.
+}
+
+// Routed whether the requests of the tenant are routed to the candidate, the tenants
+// are bucketed by the hash of the tenant id so that a tenant always gets the same app.
+func (c *PluginCandidate) Routed(tenantID string) bool {
+	if c.Status != CandidateStatusReady {
+		return false
+	}
+	for _, v := range c.Tenants {
+		if v == tenantID {
+			return true
+		}
+	}
+	if c.Percent <= 0 {
+		return false
+	}
+	h := fnv.New32a()
+	h.Write([]byte(tenantID))
+	return int32(h.Sum32()%100) < c.Percent
+}
+
 // nolint
 type ProfileSchema struct {
 	Type        string `json:"type"`
@@ -298,6 +408,8 @@ type PluginRepo struct {
 	*repository.Info `json:",inline"`
 	UpsertTimestamp  int64  `json:"upsert_timestamp,omitempty"` // last upsert time stamp.
 	Version          string `json:"version,omitempty"`          // model version.
+	// EncryptedCredentials the encrypted auth and tls settings of the repository.
+	EncryptedCredentials string `json:"encrypted_credentials,omitempty"`
 }
 
 func NewPluginRepo(i *repository.Info) *PluginRepo {
@@ -509,6 +621,7 @@ func (ps *PermissionSet) Add(pluginID string, pb *openapi_v1.Permission) (bool,
 	}
 	ps.rwLock.RLock()
 	if _, ok := (ps.rawSet)[pluginID]; ok {
+		ps.rwLock.RUnlock()
 		return false, nil
 	}
 	ps.rwLock.RUnlock()
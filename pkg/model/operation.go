@@ -0,0 +1,206 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	OperationTypeInstall     = "install"
+	OperationTypeUpgrade     = "upgrade"
+	OperationTypeUninstall   = "uninstall"
+	OperationTypeRollback    = "rollback"
+	OperationTypeReconfigure = "reconfigure"
+	OperationTypePromote     = "promote"
+
+	OperationTypeBatchTenantEnable  = "batch_tenant_enable"
+	OperationTypeBatchTenantDisable = "batch_tenant_disable"
+
+	OperationStatusPending   = "pending"
+	OperationStatusRunning   = "running"
+	OperationStatusSucceeded = "succeeded"
+	OperationStatusFailed    = "failed"
+
+	PhaseHelmAction             = "helm_action"
+	PhaseWaitReady              = "wait_ready"
+	PhaseIdentify               = "identify"
+	PhaseRouteCreation          = "route_creation"
+	PhasePermissionRegistration = "permission_registration"
+	PhaseCleanup                = "cleanup"
+	PhaseCascade                = "cascade"
+	PhaseTenants                = "tenants"
+
+	ReportActionTenantEnable  = "tenant_enable"
+	ReportActionTenantDisable = "tenant_disable"
+	ReportActionAddonsRemove  = "addons_remove"
+	ReportActionPolicyRemove  = "policy_remove"
+)
+
+type OperationPhase struct {
+	Name           string `json:"name,omitempty"`            // phase name.
+	Status         string `json:"status,omitempty"`          // phase status.
+	Message        string `json:"message,omitempty"`         // phase message, the error message when failed.
+	StartTimestamp int64  `json:"start_timestamp,omitempty"` // phase start timestamp.
+	EndTimestamp   int64  `json:"end_timestamp,omitempty"`   // phase end timestamp.
+}
+
+// OperationReportItem an action done by the operation on the other resources.
+type OperationReportItem struct {
+	Action    string `json:"action,omitempty"`    // action name.
+	Target    string `json:"target,omitempty"`    // action target, tenant or plugin id.
+	Detail    string `json:"detail,omitempty"`    // action detail.
+	Status    string `json:"status,omitempty"`    // action status.
+	Message   string `json:"message,omitempty"`   // action message, the error message when failed.
+	Timestamp int64  `json:"timestamp,omitempty"` // action timestamp.
+}
+
+type Operation struct {
+	ID              string                 `json:"id,omitempty"`               // operation id.
+	Type            string                 `json:"type,omitempty"`             // operation type.
+	PluginID        string                 `json:"plugin_id,omitempty"`        // operation target plugin id.
+	Status          string                 `json:"status,omitempty"`           // operation status.
+	Message         string                 `json:"message,omitempty"`          // operation message, the error message when failed.
+	Phases          []*OperationPhase      `json:"phases,omitempty"`           // operation phases.
+	Report          []*OperationReportItem `json:"report,omitempty"`           // operation report of the actions done.
+	CreateTimestamp int64                  `json:"create_timestamp,omitempty"` // create timestamp.
+	UpdateTimestamp int64                  `json:"update_timestamp,omitempty"` // update timestamp.
+	Version         string                 `json:"version,omitempty"`          // model version.
+}
+
+func NewOperation(opType, pluginID string) *Operation {
+	now := time.Now().Unix()
+	return &Operation{
+		ID:              uuid.New().String(),
+		Type:            opType,
+		PluginID:        pluginID,
+		Status:          OperationStatusPending,
+		Phases:          make([]*OperationPhase, 0),
+		CreateTimestamp: now,
+		UpdateTimestamp: now,
+		Version:         "1",
+	}
+}
+
+func (o *Operation) String() string {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+	return string(b)
+}
+
+func (o *Operation) Clone() *Operation {
+	b, err := json.Marshal(o)
+	if err != nil {
+		log.Errorf("error clone operation(%s) marshal: %s", o.ID, err)
+		return nil
+	}
+	newO := new(Operation)
+	if err = json.Unmarshal(b, newO); err != nil {
+		log.Errorf("error clone operation(%s) unmarshal: %s", o.ID, err)
+		return nil
+	}
+	return newO
+}
+
+// StartPhase mark the phase running, a finished phase is restarted when retrying.
+func (o *Operation) StartPhase(name string) {
+	now := time.Now().Unix()
+	o.Status = OperationStatusRunning
+	o.Message = ""
+	o.UpdateTimestamp = now
+	for _, v := range o.Phases {
+		if v.Name == name {
+			v.Status = OperationStatusRunning
+			v.Message = ""
+			v.StartTimestamp = now
+			v.EndTimestamp = 0
+			return
+		}
+	}
+	o.Phases = append(o.Phases, &OperationPhase{
+		Name:           name,
+		Status:         OperationStatusRunning,
+		StartTimestamp: now,
+	})
+}
+
+// EndPhase mark the phase succeeded or failed with the error.
+func (o *Operation) EndPhase(name string, err error) {
+	now := time.Now().Unix()
+	o.UpdateTimestamp = now
+	for _, v := range o.Phases {
+		if v.Name == name {
+			v.EndTimestamp = now
+			if err != nil {
+				v.Status = OperationStatusFailed
+				v.Message = err.Error()
+				return
+			}
+			v.Status = OperationStatusSucceeded
+			return
+		}
+	}
+}
+
+// Finish mark the operation succeeded or failed with the error,
+// the phases still running are failed with the error too.
+func (o *Operation) Finish(err error) {
+	now := time.Now().Unix()
+	o.UpdateTimestamp = now
+	if err != nil {
+		o.Status = OperationStatusFailed
+		o.Message = err.Error()
+		for _, v := range o.Phases {
+			if v.Status == OperationStatusRunning {
+				v.Status = OperationStatusFailed
+				v.Message = err.Error()
+				v.EndTimestamp = now
+			}
+		}
+		return
+	}
+	o.Status = OperationStatusSucceeded
+}
+
+// AddReport record the action done by the operation with the result.
+func (o *Operation) AddReport(action, target, detail string, err error) {
+	now := time.Now().Unix()
+	o.UpdateTimestamp = now
+	item := &OperationReportItem{
+		Action:    action,
+		Target:    target,
+		Detail:    detail,
+		Status:    OperationStatusSucceeded,
+		Timestamp: now,
+	}
+	if err != nil {
+		item.Status = OperationStatusFailed
+		item.Message = err.Error()
+	}
+	o.Report = append(o.Report, item)
+}
+
+// IsFinished return whether the operation is over.
+func (o *Operation) IsFinished() bool {
+	return o.Status == OperationStatusSucceeded || o.Status == OperationStatusFailed
+}
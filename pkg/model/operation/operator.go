@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/tkeel-io/tkeel/pkg/model"
+)
+
+var (
+	ErrOperationExsist          = errors.New("error operation existed")
+	ErrOperationNotExsist       = errors.New("error operation not existed")
+	ErrOperationVersionMismatch = errors.New("error operation version mismatch")
+)
+
+// Operator contains all operations to plugin operation.
+type Operator interface {
+	// Create operation.
+	Create(context.Context, *model.Operation) error
+	// Update operation.
+	Update(context.Context, *model.Operation) error
+	// Get operation with the operationID.
+	Get(ctx context.Context, operationID string) (*model.Operation, error)
+	// List operation.
+	List(context.Context) ([]*model.Operation, error)
+	// Delete the operations with the operationIDs.
+	Delete(ctx context.Context, operationIDs ...string) error
+}
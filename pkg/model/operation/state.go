@@ -0,0 +1,263 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/tkeel-io/tkeel/pkg/model"
+
+	dapr "github.com/dapr/go-sdk/client"
+)
+
+const (
+	KeyPrefixOperation = "op_"
+	KeyAllOperation    = "all_operations"
+
+	_bulkParallelism = 10
+)
+
+// AllOperations operation id map to the plugin id.
+type AllOperations map[string]string
+
+func (a *AllOperations) String() string {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
+
+type DaprStateOprator struct {
+	storeName  string
+	daprClient dapr.Client
+}
+
+// dapr state.
+func NewDaprStateOperator(storeName string, c dapr.Client) *DaprStateOprator {
+	return &DaprStateOprator{
+		storeName:  storeName,
+		daprClient: c,
+	}
+}
+
+func (o *DaprStateOprator) Create(ctx context.Context, op *model.Operation) error {
+	// get all operation map.
+	item, err := o.daprClient.GetState(ctx, o.storeName, KeyAllOperation)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator create(%s) operation get all operation: %w", op.ID, err)
+	}
+	allOps := make(AllOperations)
+	if item.Etag != "" {
+		if err = json.Unmarshal(item.Value, &allOps); err != nil {
+			return fmt.Errorf("error dapr state oprator create(%s) operation unmarshal all operation(%s): %w", op.ID, item.Value, err)
+		}
+	}
+	// check exists.
+	if _, ok := allOps[op.ID]; ok {
+		return ErrOperationExsist
+	}
+	allOps[op.ID] = op.PluginID
+	// conver model version 2 etag.
+	vI, err := strconv.Atoi(op.Version)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator strconv model version(%s): %w", op.Version, err)
+	}
+	// marshal values.
+	allOpsByte, err := json.Marshal(allOps)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator json marshal(%s): %w", op, err)
+	}
+	opByte, err := json.Marshal(op)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator json marshal(%s): %w", op, err)
+	}
+	// save all operations and operation.
+	err = o.daprClient.SaveBulkState(ctx, o.storeName, []*dapr.SetStateItem{
+		{
+			Key:   KeyAllOperation,
+			Value: allOpsByte,
+			Etag: &dapr.ETag{
+				Value: func() string {
+					if item.Etag != "" {
+						return item.Etag
+					}
+					return "0"
+				}(),
+			},
+			Options: &dapr.StateOptions{
+				Concurrency: dapr.StateConcurrencyFirstWrite,
+				Consistency: dapr.StateConsistencyStrong,
+			},
+		},
+		{
+			Key:   getStoreKey(KeyPrefixOperation, op.ID),
+			Value: opByte,
+			Etag: &dapr.ETag{
+				Value: strconv.Itoa(vI - 1),
+			},
+			Options: &dapr.StateOptions{
+				Concurrency: dapr.StateConcurrencyFirstWrite,
+				Consistency: dapr.StateConsistencyStrong,
+			},
+		},
+	}...)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator save(%s): %w", op, err)
+	}
+	return nil
+}
+
+func (o *DaprStateOprator) Update(ctx context.Context, op *model.Operation) error {
+	// convert model version 2 etag.
+	old := op.Version
+	vI, err := strconv.Atoi(op.Version)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator strconv model version(%s): %w", op.Version, err)
+	}
+	op.Version = strconv.Itoa(vI + 1)
+	valueByte, err := json.Marshal(op)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator json marshal(%s): %w", op, err)
+	}
+	err = o.daprClient.SaveBulkState(ctx, o.storeName, &dapr.SetStateItem{
+		Key:   getStoreKey(KeyPrefixOperation, op.ID),
+		Value: valueByte,
+		Etag: &dapr.ETag{
+			Value: old,
+		},
+		Options: &dapr.StateOptions{
+			Concurrency: dapr.StateConcurrencyFirstWrite,
+			Consistency: dapr.StateConsistencyStrong,
+		},
+	})
+	if err != nil {
+		op.Version = old
+		return fmt.Errorf("error dapr state oprator save(%s): %w", op, err)
+	}
+	return nil
+}
+
+func (o *DaprStateOprator) Get(ctx context.Context, operationID string) (*model.Operation, error) {
+	item, err := o.daprClient.GetState(ctx, o.storeName, getStoreKey(KeyPrefixOperation, operationID))
+	if err != nil {
+		return nil, fmt.Errorf("error dapr state oprator get(%s): %w", operationID, err)
+	}
+	if item.Etag == "" {
+		return nil, ErrOperationNotExsist
+	}
+	op := &model.Operation{}
+	if err = json.Unmarshal(item.Value, op); err != nil {
+		return nil, fmt.Errorf("error dapr state oprator get(%s) json unmarshal(%s): %w", operationID, item.Value, err)
+	}
+	return op, nil
+}
+
+func (o *DaprStateOprator) List(ctx context.Context) ([]*model.Operation, error) {
+	// get all operation map.
+	item, err := o.daprClient.GetState(ctx, o.storeName, KeyAllOperation)
+	if err != nil {
+		return nil, fmt.Errorf("error dapr state oprator list operation get all operation: %w", err)
+	}
+	allOps := make(AllOperations)
+	if item.Etag != "" {
+		if err = json.Unmarshal(item.Value, &allOps); err != nil {
+			return nil, fmt.Errorf("error dapr state oprator list operation unmarshal all operation(%s): %w", item.Value, err)
+		}
+	}
+	ret := make([]*model.Operation, 0, len(allOps))
+	if len(allOps) == 0 {
+		return ret, nil
+	}
+	keys := make([]string, 0, len(allOps))
+	for operationID := range allOps {
+		keys = append(keys, getStoreKey(KeyPrefixOperation, operationID))
+	}
+	// get the operations in one request.
+	items, err := o.daprClient.GetBulkState(ctx, o.storeName, keys, nil, _bulkParallelism)
+	if err != nil {
+		return nil, fmt.Errorf("error dapr state oprator list operation get bulk state: %w", err)
+	}
+	for _, v := range items {
+		if v.Error != "" {
+			return nil, fmt.Errorf("error dapr state oprator list get operation(%s): %s", v.Key, v.Error)
+		}
+		// the operation is being deleted.
+		if v.Etag == "" {
+			continue
+		}
+		op := &model.Operation{}
+		if err = json.Unmarshal(v.Value, op); err != nil {
+			return nil, fmt.Errorf("error dapr state oprator list get operation(%s) json unmarshal(%s): %w", v.Key, v.Value, err)
+		}
+		ret = append(ret, op)
+	}
+	return ret, nil
+}
+
+func (o *DaprStateOprator) Delete(ctx context.Context, operationIDs ...string) error {
+	if len(operationIDs) == 0 {
+		return nil
+	}
+	// get all operation map.
+	item, err := o.daprClient.GetState(ctx, o.storeName, KeyAllOperation)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator delete operation get all operation: %w", err)
+	}
+	if item.Etag == "" {
+		return nil
+	}
+	allOps := make(AllOperations)
+	if err = json.Unmarshal(item.Value, &allOps); err != nil {
+		return fmt.Errorf("error dapr state oprator delete operation unmarshal all operation(%s): %w", item.Value, err)
+	}
+	keys := make([]string, 0, len(operationIDs))
+	for _, v := range operationIDs {
+		delete(allOps, v)
+		keys = append(keys, getStoreKey(KeyPrefixOperation, v))
+	}
+	allOpsByte, err := json.Marshal(allOps)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator json marshal(%s): %w", allOps.String(), err)
+	}
+	// remove the operations from all operations first, so that they are never listed after deleted.
+	err = o.daprClient.SaveBulkState(ctx, o.storeName, &dapr.SetStateItem{
+		Key:   KeyAllOperation,
+		Value: allOpsByte,
+		Etag: &dapr.ETag{
+			Value: item.Etag,
+		},
+		Options: &dapr.StateOptions{
+			Concurrency: dapr.StateConcurrencyFirstWrite,
+			Consistency: dapr.StateConsistencyStrong,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator save all operation: %w", err)
+	}
+	if err = o.daprClient.DeleteBulkState(ctx, o.storeName, keys); err != nil {
+		return fmt.Errorf("error dapr state oprator delete operations(%v): %w", operationIDs, err)
+	}
+	return nil
+}
+
+func getStoreKey(prefix, operationID string) string {
+	return prefix + operationID
+}
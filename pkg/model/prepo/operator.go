@@ -27,6 +27,7 @@ var (
 	ErrPluginRepoExsist          = errors.New("error plugin repo existed")
 	ErrPluginRepoNotExsist       = errors.New("error plugin repo not existed")
 	ErrPluginRepoVersionMismatch = errors.New("error plugin repo version mismatch")
+	ErrCredentialsKeyRequired    = errors.New("error repo credentials key required")
 )
 
 // Operator contains all operations to plugin repo.
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -33,7 +34,37 @@ import (
 	dapr "github.com/dapr/go-sdk/client"
 )
 
-const KeyPluginRepoMap = "plugin_repo_map"
+const (
+	KeyPluginRepoMap = "plugin_repo_map"
+	// KeyPrefixRepoStatus the prefix of the key of the repo sync status.
+	KeyPrefixRepoStatus = "plugin_repo_status_"
+
+	_bulkParallelism = 10
+)
+
+// _insecureCredentialsKey the former default key, the credentials sealed with it can be opened
+// but no more credentials are stored with it.
+const _insecureCredentialsKey = "changeme"
+
+var _credentialsKey string
+
+// SetCredentialsKey set the key to encrypt the repository credentials.
+func SetCredentialsKey(key string) {
+	_credentialsKey = key
+}
+
+// sealCredentials encrypt the credentials of the repo, the credentials are refused
+// when the key is not set or insecure.
+func sealCredentials(pr *model.PluginRepo) error {
+	if pr.Info != nil && !pr.Info.Credentials.IsZero() &&
+		(_credentialsKey == "" || _credentialsKey == _insecureCredentialsKey) {
+		return ErrCredentialsKeyRequired
+	}
+	if err := pr.SealCredentials(_credentialsKey); err != nil {
+		return fmt.Errorf("error dapr state oprator seal credentials: %w", err)
+	}
+	return nil
+}
 
 type DaprStateOprator struct {
 	storeName  string
@@ -98,6 +129,9 @@ func (o *DaprStateOprator) GetChanges(curr model.PluginRepoMap) (news, updates,
 
 func (o *DaprStateOprator) Create(ctx context.Context, i *repository.Info) error {
 	pr := o.Info2Model(i)
+	if err := sealCredentials(pr); err != nil {
+		return err
+	}
 	// get route map.
 	item, err := o.daprClient.GetState(ctx, o.storeName, KeyPluginRepoMap)
 	if err != nil {
@@ -142,15 +176,21 @@ func (o *DaprStateOprator) Create(ctx context.Context, i *repository.Info) error
 	return nil
 }
 
+// Update overwrite the repo info, the update is rejected if the repo changed since cached.
 func (o *DaprStateOprator) Update(ctx context.Context, i *repository.Info) error {
 	prIn, ok := o.cacheRepo.Load(i.Name)
 	if !ok {
 		return ErrPluginRepoNotExsist
 	}
-	pr, ok := prIn.(*model.PluginRepo)
+	cached, ok := prIn.(*model.PluginRepo)
 	if !ok {
 		return errors.New("plugin repo invalid type")
 	}
+	pr := o.Info2Model(i)
+	pr.Version = cached.Version
+	if err := sealCredentials(pr); err != nil {
+		return err
+	}
 	// get route map.
 	item, err := o.daprClient.GetState(ctx, o.storeName, KeyPluginRepoMap)
 	if err != nil {
@@ -196,6 +236,7 @@ func (o *DaprStateOprator) Update(ctx context.Context, i *repository.Info) error
 	if err != nil {
 		return fmt.Errorf("error dapr state oprator save(%s): %w", pr, err)
 	}
+	o.cacheRepo.Store(i.Name, pr)
 	return nil
 }
 
@@ -269,9 +310,53 @@ func (o *DaprStateOprator) Delete(ctx context.Context, name string) (*repository
 		return nil, fmt.Errorf("error dapr state oprator save(%s): %w", pr, err)
 	}
 	o.cacheRepo.Delete(name)
+	if err = o.daprClient.DeleteState(ctx, o.storeName, KeyPrefixRepoStatus+name); err != nil {
+		log.Warnf("error dapr state oprator delete repo(%s) status: %s", name, err)
+	}
 	return o.Model2Info(pr), nil
 }
 
+func (o *DaprStateOprator) SaveStatus(ctx context.Context, name string, status *repository.SyncStatus) error {
+	valueByte, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator json marshal repo(%s) status: %w", name, err)
+	}
+	if err = o.daprClient.SaveState(ctx, o.storeName, KeyPrefixRepoStatus+name, valueByte); err != nil {
+		return fmt.Errorf("error dapr state oprator save repo(%s) status: %w", name, err)
+	}
+	return nil
+}
+
+func (o *DaprStateOprator) ListStatus(ctx context.Context, names ...string) (map[string]*repository.SyncStatus, error) {
+	ret := make(map[string]*repository.SyncStatus, len(names))
+	if len(names) == 0 {
+		return ret, nil
+	}
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, KeyPrefixRepoStatus+name)
+	}
+	items, err := o.daprClient.GetBulkState(ctx, o.storeName, keys, nil, _bulkParallelism)
+	if err != nil {
+		return nil, fmt.Errorf("error dapr state oprator list repo status get bulk state: %w", err)
+	}
+	for _, v := range items {
+		if v.Error != "" {
+			return nil, fmt.Errorf("error dapr state oprator list get repo status(%s): %s", v.Key, v.Error)
+		}
+		// the status is not saved yet.
+		if v.Etag == "" {
+			continue
+		}
+		status := &repository.SyncStatus{}
+		if err = json.Unmarshal(v.Value, status); err != nil {
+			return nil, fmt.Errorf("error dapr state oprator list get repo status(%s) json unmarshal(%s): %w", v.Key, v.Value, err)
+		}
+		ret[strings.TrimPrefix(v.Key, KeyPrefixRepoStatus)] = status
+	}
+	return ret, nil
+}
+
 // Watch Block waiting for plugin proxy route map changes.
 // when it changes, call callback function.
 func (o *DaprStateOprator) Watch(ctx context.Context, interval string, callback func(news, updates, deletes []*repository.Info) error) error {
@@ -296,6 +381,13 @@ func (o *DaprStateOprator) Watch(ctx context.Context, interval string, callback
 			o.modelSli2Infos(updates), o.modelSli2Infos(deletes)); err != nil {
 			return fmt.Errorf("error dapr state oprator watch callback(%s): %w", rMap, err)
 		}
+		// cache the handled changes so that they are not reported again.
+		for _, v := range append(news, updates...) {
+			o.cacheRepo.Store(v.Name, v)
+		}
+		for _, v := range deletes {
+			o.cacheRepo.Delete(v.Name)
+		}
 		tick.Reset(in)
 	}
 	return nil
@@ -308,13 +400,22 @@ func (o *DaprStateOprator) List(ctx context.Context) ([]*repository.Info, error)
 		if !ok {
 			return true
 		}
-		ret = append(ret, pr.Info)
+		ret = append(ret, modelConvertInfo(pr))
 		return true
 	})
 	return ret, nil
 }
 
+// modelConvertInfo get the info with the credentials opened, the info is marked with the load error
+// if the credentials can not be opened, so that the repo is not loaded without them.
 func modelConvertInfo(pr *model.PluginRepo) *repository.Info {
+	if pr.Info != nil && pr.Info.Credentials == nil {
+		pr.Info.LoadError = ""
+		if err := pr.OpenCredentials(_credentialsKey); err != nil {
+			log.Errorf("error open plugin repo(%s) credentials: %s", pr.Name, err)
+			pr.Info.LoadError = err.Error()
+		}
+	}
 	return pr.Info
 }
 
@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prepo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+)
+
+func TestSealCredentials(t *testing.T) {
+	defer SetCredentialsKey(_credentialsKey)
+	newRepo := func(c *repository.Credentials) *model.PluginRepo {
+		return model.NewPluginRepo(&repository.Info{Name: "test", Credentials: c})
+	}
+
+	// the repo without credentials is stored without the key.
+	SetCredentialsKey("")
+	assert.NoError(t, sealCredentials(newRepo(nil)))
+
+	// the credentials are refused without a secure key.
+	for _, key := range []string{"", _insecureCredentialsKey} {
+		SetCredentialsKey(key)
+		assert.ErrorIs(t, sealCredentials(newRepo(&repository.Credentials{Token: "token"})), ErrCredentialsKeyRequired)
+	}
+
+	SetCredentialsKey("secret")
+	pr := newRepo(&repository.Credentials{Token: "token"})
+	assert.NoError(t, sealCredentials(pr))
+	assert.NotEmpty(t, pr.EncryptedCredentials)
+
+	// the credentials opened with another key mark the load error.
+	pr.Info.Credentials = nil
+	SetCredentialsKey("another")
+	assert.NotEmpty(t, modelConvertInfo(pr).LoadError)
+	assert.Nil(t, pr.Info.Credentials)
+
+	SetCredentialsKey("secret")
+	info := modelConvertInfo(pr)
+	assert.Empty(t, info.LoadError)
+	assert.Equal(t, "token", info.Credentials.Token)
+}
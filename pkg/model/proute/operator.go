@@ -41,6 +41,8 @@ type Operator interface {
 	Get(ctx context.Context, pluginID string) (*model.PluginRoute, error)
 	// Delete plugin route with the pluginID.
 	Delete(ctx context.Context, pluginID string) (*model.PluginRoute, error)
+	// List all plugin routes.
+	List(ctx context.Context) (model.PluginProxyRouteMap, error)
 	// Watch plugin proxy route map change.
 	Watch(ctx context.Context, interval string, callback func(model.PluginProxyRouteMap) error) error
 }
@@ -155,6 +155,21 @@ func (o *DaprStateOprator) Get(ctx context.Context, pluginID string) (*model.Plu
 	return pr, nil
 }
 
+func (o *DaprStateOprator) List(ctx context.Context) (model.PluginProxyRouteMap, error) {
+	item, err := o.daprClient.GetState(ctx, o.storeName, KeyPluginProxyRouteMap)
+	if err != nil {
+		return nil, fmt.Errorf("error dapr state oprator get plugin_proxy_route_map: %w", err)
+	}
+	pluginProxyMap := make(model.PluginProxyRouteMap)
+	if item.Etag == "" {
+		return pluginProxyMap, nil
+	}
+	if err = json.Unmarshal(item.Value, &pluginProxyMap); err != nil {
+		return nil, fmt.Errorf("error dapr state oprator unmarshal plugin_proxy_route_map(%s): %w", item.Value, err)
+	}
+	return pluginProxyMap, nil
+}
+
 func (o *DaprStateOprator) Delete(ctx context.Context, pluginID string) (*model.PluginRoute, error) {
 	item, err := o.daprClient.GetState(ctx, o.storeName, KeyPluginProxyRouteMap)
 	if err != nil {
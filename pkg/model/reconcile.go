@@ -0,0 +1,46 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+const (
+	DriftOrphanRelease     = "orphan_release"     // helm release of a plugin chart without plugin.
+	DriftMissingRelease    = "missing_release"    // plugin without helm release.
+	DriftOrphanRoute       = "orphan_route"       // plugin route without plugin.
+	DriftMissingRoute      = "missing_route"      // running plugin without plugin route.
+	DriftOrphanPermission  = "orphan_permission"  // permissions in the permission set without plugin.
+	DriftMissingPermission = "missing_permission" // running plugin without permissions in the permission set.
+)
+
+// DriftKinds all the kinds of the drift between the helm releases and the plugin state.
+var DriftKinds = []string{
+	DriftOrphanRelease, DriftMissingRelease, DriftOrphanRoute,
+	DriftMissingRoute, DriftOrphanPermission, DriftMissingPermission,
+}
+
+// Drift an inconsistency between the helm releases, the plugins, the plugin routes and the permission set.
+type Drift struct {
+	Kind     string `json:"kind,omitempty"`     // drift kind.
+	Target   string `json:"target,omitempty"`   // plugin id or release name.
+	Detail   string `json:"detail,omitempty"`   // drift detail.
+	Repaired bool   `json:"repaired,omitempty"` // whether the drift is repaired.
+	Error    string `json:"error,omitempty"`    // the error message when the repair failed.
+}
+
+// Key the identity of the drift.
+func (d *Drift) Key() string {
+	return d.Kind + "/" + d.Target
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	RegistrationActionInstall     = "install"
+	RegistrationActionUpgrade     = "upgrade"
+	RegistrationActionRollback    = "rollback"
+	RegistrationActionReconfigure = "reconfigure"
+	RegistrationActionCandidate   = "candidate"
+)
+
+// Registration a plugin waiting for its workload ready to be registered,
+// it is persisted so that the pending registrations are replayed when rudder restarts.
+type Registration struct {
+	PluginID           string   `json:"plugin_id,omitempty"`            // plugin id.
+	AppID              string   `json:"app_id,omitempty"`               // the app whose workload is waited for, empty means the plugin id.
+	Action             string   `json:"action,omitempty"`               // the action waiting for the registration.
+	OperationID        string   `json:"operation_id,omitempty"`         // the operation tracking the action.
+	Revision           int      `json:"revision,omitempty"`             // the release revision rolled back to when the upgrade registration failed.
+	OldPlugin          *Plugin  `json:"old_plugin,omitempty"`           // the plugin restored when the upgrade registration failed.
+	Dependences        []string `json:"dependences,omitempty"`          // the dependences installed along with the plugin, uninstalled when the registration failed.
+	Attempts           int      `json:"attempts,omitempty"`             // failed register attempts.
+	LastError          string   `json:"last_error,omitempty"`           // the error of the last failed attempt.
+	NextRetryTimestamp int64    `json:"next_retry_timestamp,omitempty"` // the registration is not retried before the timestamp.
+	CreateTimestamp    int64    `json:"create_timestamp,omitempty"`     // create timestamp.
+	UpdateTimestamp    int64    `json:"update_timestamp,omitempty"`     // update timestamp.
+}
+
+func NewRegistration(action, pluginID, operationID string) *Registration {
+	now := time.Now().Unix()
+	return &Registration{
+		PluginID:        pluginID,
+		Action:          action,
+		OperationID:     operationID,
+		CreateTimestamp: now,
+		UpdateTimestamp: now,
+	}
+}
+
+func (r *Registration) String() string {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+	return string(b)
+}
+
+// GetAppID get the app whose workload is waited for.
+func (r *Registration) GetAppID() string {
+	if r.AppID == "" {
+		return r.PluginID
+	}
+	return r.AppID
+}
+
+// IsUpgrade return whether the plugin is registered already before the action.
+func (r *Registration) IsUpgrade() bool {
+	return r.Action != RegistrationActionInstall
+}
+
+// Due return whether the registration can be attempted at the time.
+func (r *Registration) Due(now time.Time) bool {
+	return r.NextRetryTimestamp <= now.Unix()
+}
+
+// Expired return whether the timeout passed since the registration created.
+func (r *Registration) Expired(now time.Time, timeout time.Duration) bool {
+	return r.CreateTimestamp > 0 && now.Sub(time.Unix(r.CreateTimestamp, 0)) >= timeout
+}
+
+// Retry record the failed attempt and delay the next attempt with the backoff.
+func (r *Registration) Retry(err error, backoff time.Duration) {
+	now := time.Now()
+	r.Attempts++
+	if err != nil {
+		r.LastError = err.Error()
+	}
+	r.NextRetryTimestamp = now.Add(backoff).Unix()
+	r.UpdateTimestamp = now.Unix()
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registration
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/tkeel-io/tkeel/pkg/model"
+)
+
+var ErrRegistrationNotExsist = errors.New("error registration not existed")
+
+// Operator contains all operations to pending plugin registration.
+type Operator interface {
+	// Save create or overwrite the registration of the plugin.
+	Save(context.Context, *model.Registration) error
+	// Get registration with the pluginID.
+	Get(ctx context.Context, pluginID string) (*model.Registration, error)
+	// Delete registration with the pluginID.
+	Delete(ctx context.Context, pluginID string) error
+	// List registration.
+	List(context.Context) ([]*model.Registration, error)
+}
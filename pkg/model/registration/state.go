@@ -0,0 +1,202 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tkeel-io/tkeel/pkg/model"
+
+	dapr "github.com/dapr/go-sdk/client"
+)
+
+const (
+	KeyPrefixRegistration = "reg_"
+	KeyAllRegistration    = "all_registrations"
+)
+
+// AllRegistrations plugin id map to the registration action.
+type AllRegistrations map[string]string
+
+func (a *AllRegistrations) String() string {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return err.Error()
+	}
+	return string(b)
+}
+
+type DaprStateOprator struct {
+	storeName  string
+	daprClient dapr.Client
+}
+
+// dapr state.
+func NewDaprStateOperator(storeName string, c dapr.Client) *DaprStateOprator {
+	return &DaprStateOprator{
+		storeName:  storeName,
+		daprClient: c,
+	}
+}
+
+func (o *DaprStateOprator) Save(ctx context.Context, r *model.Registration) error {
+	// get all registration map.
+	item, err := o.daprClient.GetState(ctx, o.storeName, KeyAllRegistration)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator save(%s) registration get all registration: %w", r.PluginID, err)
+	}
+	allRegs := make(AllRegistrations)
+	if item.Etag != "" {
+		if err = json.Unmarshal(item.Value, &allRegs); err != nil {
+			return fmt.Errorf("error dapr state oprator save(%s) registration unmarshal all registration(%s): %w", r.PluginID, item.Value, err)
+		}
+	}
+	allRegs[r.PluginID] = r.Action
+	// marshal values.
+	allRegsByte, err := json.Marshal(allRegs)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator json marshal(%s): %w", allRegs.String(), err)
+	}
+	regByte, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator json marshal(%s): %w", r, err)
+	}
+	// save all registrations and registration, the registration of the plugin is overwritten.
+	err = o.daprClient.SaveBulkState(ctx, o.storeName, []*dapr.SetStateItem{
+		{
+			Key:   KeyAllRegistration,
+			Value: allRegsByte,
+			Etag: &dapr.ETag{
+				Value: func() string {
+					if item.Etag != "" {
+						return item.Etag
+					}
+					return "0"
+				}(),
+			},
+			Options: &dapr.StateOptions{
+				Concurrency: dapr.StateConcurrencyFirstWrite,
+				Consistency: dapr.StateConsistencyStrong,
+			},
+		},
+		{
+			Key:   getStoreKey(KeyPrefixRegistration, r.PluginID),
+			Value: regByte,
+			Options: &dapr.StateOptions{
+				Concurrency: dapr.StateConcurrencyLastWrite,
+				Consistency: dapr.StateConsistencyStrong,
+			},
+		},
+	}...)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator save(%s): %w", r, err)
+	}
+	return nil
+}
+
+func (o *DaprStateOprator) Get(ctx context.Context, pluginID string) (*model.Registration, error) {
+	item, err := o.daprClient.GetState(ctx, o.storeName, getStoreKey(KeyPrefixRegistration, pluginID))
+	if err != nil {
+		return nil, fmt.Errorf("error dapr state oprator get(%s): %w", pluginID, err)
+	}
+	if item.Etag == "" {
+		return nil, ErrRegistrationNotExsist
+	}
+	r := &model.Registration{}
+	if err = json.Unmarshal(item.Value, r); err != nil {
+		return nil, fmt.Errorf("error dapr state oprator get(%s) json unmarshal(%s): %w", pluginID, item.Value, err)
+	}
+	return r, nil
+}
+
+func (o *DaprStateOprator) Delete(ctx context.Context, pluginID string) error {
+	// get all registration map.
+	item, err := o.daprClient.GetState(ctx, o.storeName, KeyAllRegistration)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator delete(%s) registration get all registration: %w", pluginID, err)
+	}
+	allRegs := make(AllRegistrations)
+	if item.Etag != "" {
+		if err = json.Unmarshal(item.Value, &allRegs); err != nil {
+			return fmt.Errorf("error dapr state oprator delete(%s) registration unmarshal all registration(%s): %w", pluginID, item.Value, err)
+		}
+	}
+	// check exists.
+	if _, ok := allRegs[pluginID]; !ok {
+		return ErrRegistrationNotExsist
+	}
+	delete(allRegs, pluginID)
+	allRegsByte, err := json.Marshal(allRegs)
+	if err != nil {
+		return fmt.Errorf("error dapr state oprator delete(%s) json marshal(%s): %w", pluginID, allRegs.String(), err)
+	}
+	// delete registration and update all map.
+	if err = o.daprClient.ExecuteStateTransaction(ctx, o.storeName, nil, []*dapr.StateOperation{
+		{
+			Type: dapr.StateOperationTypeDelete,
+			Item: &dapr.SetStateItem{
+				Key: getStoreKey(KeyPrefixRegistration, pluginID),
+			},
+		},
+		{
+			Type: dapr.StateOperationTypeUpsert,
+			Item: &dapr.SetStateItem{
+				Key:   item.Key,
+				Value: allRegsByte,
+				Etag: &dapr.ETag{
+					Value: item.Etag,
+				},
+				Options: &dapr.StateOptions{
+					Concurrency: dapr.StateConcurrencyFirstWrite,
+					Consistency: dapr.StateConsistencyStrong,
+				},
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("error dapr state oprator delete execute state transaction(%s/%s): %w", pluginID, allRegsByte, err)
+	}
+	return nil
+}
+
+func (o *DaprStateOprator) List(ctx context.Context) ([]*model.Registration, error) {
+	// get all registration map.
+	item, err := o.daprClient.GetState(ctx, o.storeName, KeyAllRegistration)
+	if err != nil {
+		return nil, fmt.Errorf("error dapr state oprator list registration get all registration: %w", err)
+	}
+	allRegs := make(AllRegistrations)
+	if item.Etag != "" {
+		if err = json.Unmarshal(item.Value, &allRegs); err != nil {
+			return nil, fmt.Errorf("error dapr state oprator list registration unmarshal all registration(%s): %w", item.Value, err)
+		}
+	}
+	ret := make([]*model.Registration, 0, len(allRegs))
+	for pluginID := range allRegs {
+		r, err := o.Get(ctx, pluginID)
+		if err != nil {
+			return nil, fmt.Errorf("error dapr state oprator list get registration(%s): %w", pluginID, err)
+		}
+		ret = append(ret, r)
+	}
+	return ret, nil
+}
+
+func getStoreKey(prefix, pluginID string) string {
+	return prefix + pluginID
+}
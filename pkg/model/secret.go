@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+)
+
+const (
+	secretHashPrefix    = "sha256:"
+	secretEncryptPrefix = "aes-gcm:"
+)
+
+var ErrInvalidCiphertext = errors.New("invalid ciphertext")
+
+// HashPluginSecret hash the plugin secret which is stored on the plugin.
+func HashPluginSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return secretHashPrefix + hex.EncodeToString(sum[:])
+}
+
+// SetSecret reset the plugin secret, the previous secret is no longer accepted.
+func (p *Plugin) SetSecret(secret string) {
+	p.Secret = HashPluginSecret(secret)
+	p.PreviousSecret = ""
+	p.PreviousSecretExpire = 0
+}
+
+// RotateSecret replace the plugin secret, the current secret is
+// still accepted until the grace period passed.
+func (p *Plugin) RotateSecret(secret string, grace time.Duration) {
+	p.PreviousSecret = p.Secret
+	p.PreviousSecretExpire = time.Now().Add(grace).Unix()
+	p.Secret = HashPluginSecret(secret)
+}
+
+// CheckSecret check the secret against the plugin secret,
+// or the previous secret in the grace window.
+func (p *Plugin) CheckSecret(secret string) bool {
+	if matchSecret(p.Secret, secret) {
+		return true
+	}
+	return p.PreviousSecretExpire > time.Now().Unix() && matchSecret(p.PreviousSecret, secret)
+}
+
+func matchSecret(stored, secret string) bool {
+	if stored == "" {
+		return false
+	}
+	if !strings.HasPrefix(stored, secretHashPrefix) {
+		// compatible with the plain secret registered by the previous versions.
+		return subtle.ConstantTimeCompare([]byte(stored), []byte(secret)) == 1
+	}
+	return subtle.ConstantTimeCompare([]byte(stored), []byte(HashPluginSecret(secret))) == 1
+}
+
+// EncryptSecret encrypt the data with AES-GCM by the key, the key is
+// hashed to the 256 bits AES key.
+func EncryptSecret(key string, data []byte) (string, error) {
+	gcm, err := newSecretCipher(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("error generate nonce: %w", err)
+	}
+	sealed := gcm.Seal(nonce, nonce, data, nil)
+	return secretEncryptPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptSecret decrypt the data encrypted by EncryptSecret.
+func DecryptSecret(key, ciphertext string) ([]byte, error) {
+	if !strings.HasPrefix(ciphertext, secretEncryptPrefix) {
+		return nil, ErrInvalidCiphertext
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(ciphertext, secretEncryptPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("error decode ciphertext: %w", err)
+	}
+	gcm, err := newSecretCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, ErrInvalidCiphertext
+	}
+	data, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypt ciphertext: %w", err)
+	}
+	return data, nil
+}
+
+func newSecretCipher(key string) (cipher.AEAD, error) {
+	sum := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, fmt.Errorf("error new cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("error new gcm: %w", err)
+	}
+	return gcm, nil
+}
+
+// SealCredentials encrypt the credentials of the repository info into the stored model.
+func (pr *PluginRepo) SealCredentials(key string) error {
+	pr.EncryptedCredentials = ""
+	if pr.Info == nil || pr.Info.Credentials.IsZero() {
+		return nil
+	}
+	b, err := json.Marshal(pr.Info.Credentials)
+	if err != nil {
+		return fmt.Errorf("error marshal repo(%s) credentials: %w", pr.Name, err)
+	}
+	if pr.EncryptedCredentials, err = EncryptSecret(key, b); err != nil {
+		return fmt.Errorf("error encrypt repo(%s) credentials: %w", pr.Name, err)
+	}
+	return nil
+}
+
+// OpenCredentials decrypt the stored credentials into the repository info.
+func (pr *PluginRepo) OpenCredentials(key string) error {
+	if pr.Info == nil || pr.EncryptedCredentials == "" {
+		return nil
+	}
+	b, err := DecryptSecret(key, pr.EncryptedCredentials)
+	if err != nil {
+		return fmt.Errorf("error decrypt repo(%s) credentials: %w", pr.Name, err)
+	}
+	c := &repository.Credentials{}
+	if err = json.Unmarshal(b, c); err != nil {
+		return fmt.Errorf("error unmarshal repo(%s) credentials: %w", pr.Name, err)
+	}
+	pr.Info.Credentials = c
+	return nil
+}
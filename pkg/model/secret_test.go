@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashPluginSecret(t *testing.T) {
+	h := HashPluginSecret("s1")
+	assert.True(t, strings.HasPrefix(h, secretHashPrefix))
+	assert.Len(t, strings.TrimPrefix(h, secretHashPrefix), 64)
+	assert.Equal(t, h, HashPluginSecret("s1"))
+	assert.NotEqual(t, h, HashPluginSecret("s2"))
+	assert.NotContains(t, h, "s1")
+}
+
+func TestCheckSecret(t *testing.T) {
+	p := &Plugin{ID: "iothub"}
+	assert.False(t, p.CheckSecret(""))
+	p.SetSecret("s1")
+	assert.True(t, p.CheckSecret("s1"))
+	assert.False(t, p.CheckSecret("s2"))
+	assert.False(t, p.CheckSecret(p.Secret))
+
+	// the plain secret registered by the previous versions.
+	assert.True(t, (&Plugin{Secret: "plain"}).CheckSecret("plain"))
+
+	// the previous secret is accepted in the grace period.
+	p.RotateSecret("s2", time.Hour)
+	assert.True(t, p.CheckSecret("s2"))
+	assert.True(t, p.CheckSecret("s1"))
+	assert.False(t, p.CheckSecret("s3"))
+
+	// the grace period ends at the expire timestamp.
+	now := time.Now().Unix()
+	p.PreviousSecretExpire = now + 60
+	assert.True(t, p.CheckSecret("s1"))
+	p.PreviousSecretExpire = now
+	assert.False(t, p.CheckSecret("s1"))
+	p.PreviousSecretExpire = now - 1
+	assert.False(t, p.CheckSecret("s1"))
+	assert.True(t, p.CheckSecret("s2"))
+
+	// the previous secret is no longer accepted after reset.
+	p.RotateSecret("s3", time.Hour)
+	p.SetSecret("s4")
+	assert.False(t, p.CheckSecret("s3"))
+	assert.True(t, p.CheckSecret("s4"))
+	assert.Equal(t, int64(0), p.PreviousSecretExpire)
+}
+
+func TestEncryptSecret(t *testing.T) {
+	ciphertext, err := EncryptSecret("key", []byte("data"))
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(ciphertext, secretEncryptPrefix))
+	data, err := DecryptSecret("key", ciphertext)
+	assert.Nil(t, err)
+	assert.Equal(t, "data", string(data))
+	_, err = DecryptSecret("other", ciphertext)
+	assert.NotNil(t, err)
+	_, err = DecryptSecret("key", "data")
+	assert.ErrorIs(t, err, ErrInvalidCiphertext)
+}
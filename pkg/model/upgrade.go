@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package model
+
+import "encoding/json"
+
+const (
+	// UpgradePolicyManual the plugin is upgraded manually, the default policy.
+	UpgradePolicyManual = "manual"
+	// UpgradePolicyNotify the new version is reported when found, the plugin is upgraded manually.
+	UpgradePolicyNotify = "notify"
+	// UpgradePolicyPatchAuto the plugin is upgraded to the latest patch version in the maintenance window.
+	UpgradePolicyPatchAuto = "patch-auto"
+	// UpgradePolicyMinorAuto the plugin is upgraded to the latest minor version in the maintenance window.
+	UpgradePolicyMinorAuto = "minor-auto"
+)
+
+// ValidUpgradePolicy return whether the upgrade policy is supported, empty means manual.
+func ValidUpgradePolicy(policy string) bool {
+	switch policy {
+	case "", UpgradePolicyManual, UpgradePolicyNotify, UpgradePolicyPatchAuto, UpgradePolicyMinorAuto:
+		return true
+	}
+	return false
+}
+
+// AvailableUpgrade the newer installer version of the installed plugin found in the repository index.
+type AvailableUpgrade struct {
+	PluginID        string `json:"plugin_id"`              // plugin id.
+	Repo            string `json:"repo"`                   // repo name.
+	Installer       string `json:"installer"`              // installer name.
+	CurrentVersion  string `json:"current_version"`        // installed installer version.
+	LatestVersion   string `json:"latest_version"`         // latest installer version.
+	Policy          string `json:"policy"`                 // plugin upgrade policy.
+	AutoVersion     string `json:"auto_version,omitempty"` // the version upgraded to automatically by the policy.
+	DetectTimestamp int64  `json:"detect_timestamp"`       // the timestamp the latest version found.
+}
+
+func (u *AvailableUpgrade) String() string {
+	b, err := json.Marshal(u)
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+	return string(b)
+}
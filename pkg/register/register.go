@@ -1,11 +1,15 @@
 package register
 
 import (
+	"context"
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/tkeel-io/kit/log"
-	openapi_v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
+	"github.com/tkeel-io/tkeel/pkg/health"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/registration"
 	apps_v1 "k8s.io/api/apps/v1"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -13,27 +17,53 @@ import (
 	"k8s.io/client-go/tools/cache"
 )
 
+const (
+	// MaxAttempts the registration is given up after the attempts failed.
+	MaxAttempts = 8
+	// ReadyTimeout the registration is given up if the plugin is not ready in the timeout since registered.
+	ReadyTimeout = 30 * time.Minute
+
+	// _appIDAnnotation the annotation of the dapr app id of the plugin workload.
+	_appIDAnnotation = "dapr.io/app-id"
+
+	_replayInterval = 5 * time.Second
+	_backoffBase    = 5 * time.Second
+	_backoffMax     = 5 * time.Minute
+)
+
+var (
+	// ErrNotReady the plugin is not ready to be registered, the attempt is not counted.
+	ErrNotReady = errors.New("error plugin not ready")
+	// ErrReadyTimeout the plugin is not ready before the ready timeout, the registration is given up.
+	ErrReadyTimeout = errors.New("error plugin not ready in time")
+)
+
 var once sync.Once
 var _pluginRegistry *PluginRegistry
 
-type PluginInfo struct {
-	ID        string
-	Status    openapi_v1.PluginStatus
-	IsUpgrade bool
-	Callback  func() bool
-}
+// Handler register the plugin of the pending registration,
+// final is true when the registration is not retried after the attempt failed,
+// the handler gives up the registration of the plugin not ready on the final attempt.
+type Handler func(ctx context.Context, reg *model.Registration, final bool) error
 
+// PluginRegistry register the pending plugins when their workload become ready,
+// the pending registrations are persisted and replayed when rudder restarts.
+// The lock is not held while the registration is handled, the plugins being handled
+// are recorded in processing so that each plugin is handled once at a time.
 type PluginRegistry struct {
-	sync.RWMutex
-	Plugins map[string]*PluginInfo
-	stopCh  chan struct{}
+	sync.Mutex
+	regOp      registration.Operator
+	workload   health.WorkloadChecker
+	handler    Handler
+	processing map[string]bool
 }
 
-func Init() {
+func Init(regOp registration.Operator, workload health.WorkloadChecker) {
 	once.Do(func() {
 		_pluginRegistry = &PluginRegistry{
-			Plugins: make(map[string]*PluginInfo),
-			stopCh:  make(chan struct{}),
+			regOp:      regOp,
+			workload:   workload,
+			processing: make(map[string]bool),
 		}
 	})
 }
@@ -42,25 +72,28 @@ func Instance() *PluginRegistry {
 	return _pluginRegistry
 }
 
-func (pr *PluginRegistry) Register(pluginID string, isUpgrade bool, callback func() bool) {
+// SetHandler set the handler called when the plugin of the pending registration is ready.
+func (pr *PluginRegistry) SetHandler(h Handler) {
 	pr.Lock()
 	defer pr.Unlock()
-	log.Debugf("register new plugin: %s, upgrade: %v", pluginID, isUpgrade)
-	if plugin, ok := pr.Plugins[pluginID]; ok {
-		plugin.Status = openapi_v1.PluginStatus_WAIT_RUNNING
-		plugin.Callback = callback
-	} else {
-		pr.Plugins[pluginID] = &PluginInfo{
-			ID:        pluginID,
-			Status:    openapi_v1.PluginStatus_WAIT_RUNNING,
-			IsUpgrade: isUpgrade,
-			Callback:  callback,
-		}
+	pr.handler = h
+}
+
+// Register persist the pending registration, the registration of the same plugin is replaced.
+func (pr *PluginRegistry) Register(ctx context.Context, reg *model.Registration) error {
+	pr.Lock()
+	defer pr.Unlock()
+	log.Debugf("register new plugin: %s, action: %s", reg.PluginID, reg.Action)
+	if err := pr.regOp.Save(ctx, reg); err != nil {
+		return errors.Wrapf(err, "save registration(%s)", reg.PluginID)
 	}
+	return nil
 }
 
-func (pr *PluginRegistry) Run(namespace string) {
+// Run watch the plugin workloads and replay the pending registrations until the ctx done.
+func (pr *PluginRegistry) Run(ctx context.Context, namespace string) {
 	log.Info("plugin registry is running")
+	go pr.replayLoop(ctx)
 	config, err := rest.InClusterConfig()
 	if err != nil {
 		log.Error("init cluster config error", err.Error())
@@ -83,29 +116,13 @@ func (pr *PluginRegistry) Run(namespace string) {
 				return
 			}
 			if oDep.Status.ReadyReplicas == oDep.Status.Replicas {
-				pr.Lock()
-				defer pr.Unlock()
-				plugin, ok := pr.Plugins[oDep.Name]
-				if !ok {
-					return
-				}
 				log.Debugf("pod %s status updated, ready replicas : %d/%d", oDep.Name, oDep.Status.ReadyReplicas, oDep.Status.Replicas)
-				if plugin.Status == openapi_v1.PluginStatus_RUNNING {
-					log.Debugf("plugin %s registered, skip", oDep.Name)
-					return
-				}
-				if plugin.Callback() {
-					log.Debugf("plugin %s registered successfully", oDep.Name)
-					plugin.Status = openapi_v1.PluginStatus_RUNNING
-				} else {
-					log.Debugf("plugin %s fail to register", oDep.Name)
-					plugin.Status = openapi_v1.PluginStatus_ERR_REGISTER
-				}
+				pr.processApp(ctx, workloadAppID(oDep.Name, oDep.Annotations, oDep.Spec.Template.Annotations))
 			}
 		},
 		DeleteFunc: func(obj interface{}) {},
 	})
-	go deployInformer.Run(pr.stopCh)
+	go deployInformer.Run(ctx.Done())
 
 	statefulInformer := sharedInformers.Apps().V1().StatefulSets().Informer()
 	statefulInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -116,31 +133,170 @@ func (pr *PluginRegistry) Run(namespace string) {
 				return
 			}
 			if oSta.Status.ReadyReplicas == oSta.Status.Replicas {
-				pr.Lock()
-				defer pr.Unlock()
-				plugin, ok := pr.Plugins[oSta.Name]
-				if !ok {
-					return
-				}
 				log.Debugf("pod %s status updated, ready replicas : %d/%d", oSta.Name, oSta.Status.ReadyReplicas, oSta.Status.Replicas)
-				if plugin.Status == openapi_v1.PluginStatus_RUNNING {
-					log.Debugf("plugin %s registered, skip", oSta.Name)
-					return
-				}
-				if plugin.Callback() {
-					log.Debugf("plugin %s registered successfully", oSta.Name)
-					plugin.Status = openapi_v1.PluginStatus_RUNNING
-				} else {
-					log.Debugf("plugin %s fail to register", oSta.Name)
-					plugin.Status = openapi_v1.PluginStatus_ERR_REGISTER
-				}
+				pr.processApp(ctx, workloadAppID(oSta.Name, oSta.Annotations, oSta.Spec.Template.Annotations))
 			}
 		},
 		DeleteFunc: func(obj interface{}) {},
 	})
-	go statefulInformer.Run(pr.stopCh)
+	go statefulInformer.Run(ctx.Done())
+}
+
+// replayLoop replay the due pending registrations against the current workload readiness,
+// the registrations persisted before rudder restarted are replayed on the first round.
+func (pr *PluginRegistry) replayLoop(ctx context.Context) {
+	ticker := time.NewTicker(_replayInterval)
+	defer ticker.Stop()
+	for {
+		pr.replay(ctx)
+		select {
+		case <-ctx.Done():
+			log.Info("plugin registry stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (pr *PluginRegistry) replay(ctx context.Context) {
+	regs, err := pr.regOp.List(ctx)
+	if err != nil {
+		log.Errorf("error list pending registrations: %s", err)
+		return
+	}
+	now := time.Now()
+	for _, v := range regs {
+		if v.Due(now) {
+			pr.process(ctx, v.PluginID, false)
+		}
+	}
 }
 
-func (pr *PluginRegistry) Stop() {
-	close(pr.stopCh)
+// workloadAppID get the dapr app id of the workload from its annotations, the name if not annotated.
+func workloadAppID(name string, annotations ...map[string]string) string {
+	for _, v := range annotations {
+		if appID := v[_appIDAnnotation]; appID != "" {
+			return appID
+		}
+	}
+	return name
+}
+
+// processApp attempt the pending registrations waiting for the workload of the app,
+// the app id differs from the plugin id for the candidate release.
+func (pr *PluginRegistry) processApp(ctx context.Context, appID string) {
+	regs, err := pr.regOp.List(ctx)
+	if err != nil {
+		log.Errorf("error list pending registrations: %s", err)
+		return
+	}
+	for _, v := range regs {
+		if v.GetAppID() == appID {
+			pr.process(ctx, v.PluginID, true)
+		}
+	}
+}
+
+// begin get the due registration of the plugin and mark the plugin processing,
+// false if the plugin is being processed or no registration is due.
+func (pr *PluginRegistry) begin(ctx context.Context, pluginID string) (*model.Registration, Handler, bool) {
+	pr.Lock()
+	defer pr.Unlock()
+	if pr.handler == nil || pr.processing[pluginID] {
+		return nil, nil, false
+	}
+	reg, err := pr.regOp.Get(ctx, pluginID)
+	if err != nil {
+		if !errors.Is(err, registration.ErrRegistrationNotExsist) {
+			log.Errorf("error get plugin(%s) registration: %s", pluginID, err)
+		}
+		return nil, nil, false
+	}
+	if !reg.Due(time.Now()) {
+		return nil, nil, false
+	}
+	pr.processing[pluginID] = true
+	return reg, pr.handler, true
+}
+
+func (pr *PluginRegistry) done(pluginID string) {
+	pr.Lock()
+	defer pr.Unlock()
+	delete(pr.processing, pluginID)
+}
+
+// process attempt the pending registration of the plugin if it is due,
+// the workload readiness is checked unless ready is true. The lock is released
+// while the registration is handled, the registration replaced meanwhile is kept.
+func (pr *PluginRegistry) process(ctx context.Context, pluginID string, ready bool) {
+	reg, handler, ok := pr.begin(ctx, pluginID)
+	if !ok {
+		return
+	}
+	defer pr.done(pluginID)
+	// the expired registration is handled as the final attempt even if the workload is not ready.
+	expired := reg.Expired(time.Now(), ReadyTimeout)
+	if !ready && !expired && pr.workload != nil {
+		ok, detail, err := pr.workload.WorkloadReady(ctx, reg.GetAppID())
+		if err != nil {
+			log.Warnf("check plugin(%s) workload: %s", pluginID, err)
+			return
+		}
+		if !ok {
+			log.Debugf("plugin %s workload not ready: %s", pluginID, detail)
+			return
+		}
+	}
+	final := expired || reg.Attempts+1 >= MaxAttempts
+	err := handler(ctx, reg, final)
+	if errors.Is(err, ErrNotReady) && !final {
+		return
+	}
+	pr.Lock()
+	defer pr.Unlock()
+	if !pr.pending(ctx, reg) {
+		log.Debugf("plugin %s registration replaced while registering", pluginID)
+		return
+	}
+	if err != nil && !final {
+		reg.Retry(err, backoff(reg.Attempts+1))
+		log.Warnf("plugin %s fail to register(attempt %d), retry after %s: %s", pluginID, reg.Attempts, time.Unix(reg.NextRetryTimestamp, 0), err)
+		if err = pr.regOp.Save(ctx, reg); err != nil {
+			log.Errorf("error save plugin(%s) registration: %s", pluginID, err)
+		}
+		return
+	}
+	if err != nil {
+		log.Errorf("error plugin %s register given up after %d attempts: %s", pluginID, reg.Attempts+1, err)
+	} else {
+		log.Debugf("plugin %s registered successfully", pluginID)
+	}
+	if err = pr.regOp.Delete(ctx, pluginID); err != nil {
+		log.Errorf("error delete plugin(%s) registration: %s", pluginID, err)
+	}
+}
+
+// pending whether the registration is still the pending one of the plugin.
+func (pr *PluginRegistry) pending(ctx context.Context, reg *model.Registration) bool {
+	cur, err := pr.regOp.Get(ctx, reg.PluginID)
+	if err != nil {
+		if !errors.Is(err, registration.ErrRegistrationNotExsist) {
+			log.Errorf("error get plugin(%s) registration: %s", reg.PluginID, err)
+		}
+		return false
+	}
+	return cur.OperationID == reg.OperationID && cur.Action == reg.Action &&
+		cur.CreateTimestamp == reg.CreateTimestamp
+}
+
+// backoff get the delay before the next attempt after the attempts failed.
+func backoff(attempts int) time.Duration {
+	d := _backoffBase
+	for i := 1; i < attempts && d < _backoffMax; i++ {
+		d *= 2
+	}
+	if d > _backoffMax {
+		d = _backoffMax
+	}
+	return d
 }
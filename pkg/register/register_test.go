@@ -0,0 +1,197 @@
+package register
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/registration"
+)
+
+// fakeRegistrationOperator keep the registrations in memory.
+type fakeRegistrationOperator struct {
+	regs map[string]*model.Registration
+}
+
+func (o *fakeRegistrationOperator) Save(ctx context.Context, reg *model.Registration) error {
+	r := *reg
+	o.regs[reg.PluginID] = &r
+	return nil
+}
+
+func (o *fakeRegistrationOperator) Get(ctx context.Context, pluginID string) (*model.Registration, error) {
+	reg, ok := o.regs[pluginID]
+	if !ok {
+		return nil, registration.ErrRegistrationNotExsist
+	}
+	r := *reg
+	return &r, nil
+}
+
+func (o *fakeRegistrationOperator) Delete(ctx context.Context, pluginID string) error {
+	delete(o.regs, pluginID)
+	return nil
+}
+
+func (o *fakeRegistrationOperator) List(ctx context.Context) ([]*model.Registration, error) {
+	ret := make([]*model.Registration, 0, len(o.regs))
+	for _, v := range o.regs {
+		r := *v
+		ret = append(ret, &r)
+	}
+	return ret, nil
+}
+
+// fakeWorkload return the readiness of the workloads.
+type fakeWorkload map[string]bool
+
+func (w fakeWorkload) WorkloadReady(ctx context.Context, name string) (bool, string, error) {
+	return w[name], "", nil
+}
+
+// fakeHandler record the final flags of the calls, call the hook and return the err.
+type fakeHandler struct {
+	err   error
+	calls []bool
+	hook  func()
+}
+
+func (h *fakeHandler) handle(ctx context.Context, reg *model.Registration, final bool) error {
+	h.calls = append(h.calls, final)
+	if h.hook != nil {
+		h.hook()
+	}
+	return h.err
+}
+
+func newTestRegistry(h *fakeHandler, workload fakeWorkload) (*PluginRegistry, *fakeRegistrationOperator) {
+	regOp := &fakeRegistrationOperator{regs: make(map[string]*model.Registration)}
+	return &PluginRegistry{regOp: regOp, workload: workload, handler: h.handle, processing: make(map[string]bool)}, regOp
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 5*time.Second, backoff(1))
+	assert.Equal(t, 10*time.Second, backoff(2))
+	assert.Equal(t, 20*time.Second, backoff(3))
+	assert.Equal(t, _backoffMax, backoff(MaxAttempts))
+	assert.Equal(t, _backoffMax, backoff(100))
+}
+
+func TestProcessRetry(t *testing.T) {
+	ctx := context.Background()
+	h := &fakeHandler{err: errors.New("identify failed")}
+	pr, regOp := newTestRegistry(h, fakeWorkload{"iothub": true})
+	assert.NoError(t, pr.Register(ctx, model.NewRegistration(model.RegistrationActionInstall, "iothub", "op")))
+
+	pr.process(ctx, "iothub", false)
+	reg, err := regOp.Get(ctx, "iothub")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reg.Attempts)
+	assert.Equal(t, "identify failed", reg.LastError)
+	assert.Greater(t, reg.NextRetryTimestamp, time.Now().Unix())
+	assert.Equal(t, []bool{false}, h.calls)
+
+	// the attempt is skipped before the backoff passed.
+	pr.process(ctx, "iothub", true)
+	assert.Len(t, h.calls, 1)
+
+	// the final attempt gives up the registration.
+	reg.Attempts, reg.NextRetryTimestamp = MaxAttempts-1, 0
+	assert.NoError(t, regOp.Save(ctx, reg))
+	pr.process(ctx, "iothub", true)
+	assert.Equal(t, []bool{false, true}, h.calls)
+	_, err = regOp.Get(ctx, "iothub")
+	assert.ErrorIs(t, err, registration.ErrRegistrationNotExsist)
+}
+
+func TestProcessNotReady(t *testing.T) {
+	ctx := context.Background()
+	h := &fakeHandler{err: ErrNotReady}
+	pr, regOp := newTestRegistry(h, fakeWorkload{})
+	assert.NoError(t, pr.Register(ctx, model.NewRegistration(model.RegistrationActionInstall, "iothub", "op")))
+
+	// the workload not ready is not handled.
+	pr.process(ctx, "iothub", false)
+	assert.Empty(t, h.calls)
+
+	// the plugin not ready is not counted.
+	pr.process(ctx, "iothub", true)
+	reg, err := regOp.Get(ctx, "iothub")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, reg.Attempts)
+	assert.Equal(t, int64(0), reg.NextRetryTimestamp)
+	assert.Equal(t, []bool{false}, h.calls)
+}
+
+func TestProcessReadyTimeout(t *testing.T) {
+	ctx := context.Background()
+	h := &fakeHandler{err: ErrNotReady}
+	pr, regOp := newTestRegistry(h, fakeWorkload{})
+	reg := model.NewRegistration(model.RegistrationActionInstall, "iothub", "op")
+	reg.CreateTimestamp = time.Now().Add(-ReadyTimeout).Unix()
+	assert.NoError(t, pr.Register(ctx, reg))
+
+	// the expired registration is handled as the final attempt even if the workload is not ready,
+	// and given up even if the handler still returns not ready.
+	pr.process(ctx, "iothub", false)
+	assert.Equal(t, []bool{true}, h.calls)
+	_, err := regOp.Get(ctx, "iothub")
+	assert.ErrorIs(t, err, registration.ErrRegistrationNotExsist)
+}
+
+func TestRegistrationExpired(t *testing.T) {
+	now := time.Now()
+	reg := &model.Registration{CreateTimestamp: now.Add(-time.Minute).Unix()}
+	assert.False(t, reg.Expired(now, ReadyTimeout))
+	assert.True(t, reg.Expired(now, time.Minute))
+	reg.CreateTimestamp = 0
+	assert.False(t, reg.Expired(now, time.Minute))
+}
+
+func TestProcessRegisterWhileHandling(t *testing.T) {
+	ctx := context.Background()
+	h := &fakeHandler{err: errors.New("identify failed")}
+	pr, regOp := newTestRegistry(h, fakeWorkload{"iothub": true})
+	assert.NoError(t, pr.Register(ctx, model.NewRegistration(model.RegistrationActionInstall, "iothub", "op1")))
+
+	// the registry is not locked while handling, the plugin is not handled again meanwhile
+	// and the registration replaced is kept.
+	h.hook = func() {
+		pr.process(ctx, "iothub", true)
+		assert.NoError(t, pr.Register(ctx, model.NewRegistration(model.RegistrationActionUpgrade, "iothub", "op2")))
+	}
+	pr.process(ctx, "iothub", true)
+	assert.Len(t, h.calls, 1)
+	reg, err := regOp.Get(ctx, "iothub")
+	assert.NoError(t, err)
+	assert.Equal(t, "op2", reg.OperationID)
+	assert.Equal(t, 0, reg.Attempts)
+	assert.Empty(t, pr.processing)
+}
+
+func TestProcessApp(t *testing.T) {
+	ctx := context.Background()
+	h := &fakeHandler{}
+	pr, regOp := newTestRegistry(h, fakeWorkload{})
+	reg := model.NewRegistration(model.RegistrationActionUpgrade, "iothub", "op")
+	reg.AppID = "iothub-green"
+	assert.NoError(t, pr.Register(ctx, reg))
+
+	// the workload of the plugin id is not the candidate app.
+	pr.processApp(ctx, "iothub")
+	assert.Empty(t, h.calls)
+
+	pr.processApp(ctx, workloadAppID("green", nil, map[string]string{_appIDAnnotation: "iothub-green"}))
+	assert.Equal(t, []bool{false}, h.calls)
+	_, err := regOp.Get(ctx, "iothub")
+	assert.ErrorIs(t, err, registration.ErrRegistrationNotExsist)
+}
+
+func TestWorkloadAppID(t *testing.T) {
+	assert.Equal(t, "iothub", workloadAppID("iothub", nil, nil))
+	assert.Equal(t, "iothub-green", workloadAppID("iothub", map[string]string{_appIDAnnotation: "iothub-green"}, nil))
+	assert.Equal(t, "iothub-green", workloadAppID("iothub", map[string]string{}, map[string]string{_appIDAnnotation: "iothub-green"}))
+}
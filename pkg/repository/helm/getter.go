@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+	"helm.sh/helm/v3/pkg/getter"
+)
+
+const _getterTimeout = 60 * time.Second
+
+var ErrInvalidCredentials = errors.New("invalid repository credentials")
+
+var _ getter.Getter = &repoGetter{}
+
+// repoGetter get the index and the charts of the repository with its auth and
+// tls settings, the auth is only sent to the host of the repository.
+type repoGetter struct {
+	host   string
+	cred   *repository.Credentials
+	client *http.Client
+}
+
+func newRepoGetter(repoURL string, cred *repository.Credentials) (*repoGetter, error) {
+	host := ""
+	if IsOCI(repoURL) {
+		ref, err := parseOCIReference(repoURL)
+		if err != nil {
+			return nil, err
+		}
+		host = ref.Host
+	} else {
+		u, err := url.Parse(repoURL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse repository url %s", repoURL)
+		}
+		host = u.Host
+	}
+	client, err := newHTTPClient(cred, _getterTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &repoGetter{
+		host:   host,
+		cred:   cred,
+		client: client,
+	}, nil
+}
+
+// Get the file of the url, the oci reference is pulled from the registry.
+func (g *repoGetter) Get(u string, _ ...getter.Option) (*bytes.Buffer, error) {
+	if IsOCI(u) {
+		return pullOCIChart(u, g.credentials(u))
+	}
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "new request %s", u)
+	}
+	req.Header.Set("User-Agent", "tkeel-rudder")
+	setAuth(req, g.credentials(u))
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", u)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errors.Wrapf(ErrNotFound, "failed to fetch %s : %s", u, resp.Status)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to fetch %s : %s", u, resp.Status)
+	}
+	buf := bytes.NewBuffer(nil)
+	if _, err = io.Copy(buf, resp.Body); err != nil {
+		return nil, errors.Wrapf(err, "read %s", u)
+	}
+	return buf, nil
+}
+
+// credentials get the credentials used for the url, only the tls settings are
+// kept for the hosts other than the repository host.
+func (g *repoGetter) credentials(u string) *repository.Credentials {
+	if g.cred == nil {
+		return nil
+	}
+	host := ""
+	if IsOCI(u) {
+		if ref, err := parseOCIReference(u); err == nil {
+			host = ref.Host
+		}
+	} else if parsed, err := url.Parse(u); err == nil {
+		host = parsed.Host
+	}
+	if host == g.host {
+		return g.cred
+	}
+	return &repository.Credentials{
+		CertData:              g.cred.CertData,
+		KeyData:               g.cred.KeyData,
+		CAData:                g.cred.CAData,
+		InsecureSkipTLSVerify: g.cred.InsecureSkipTLSVerify,
+	}
+}
+
+// setAuth set the bearer token or the basic auth of the request.
+func setAuth(req *http.Request, cred *repository.Credentials) {
+	switch {
+	case cred == nil:
+	case cred.Token != "":
+		req.Header.Set("Authorization", "Bearer "+cred.Token)
+	case cred.Username != "" || cred.Password != "":
+		req.SetBasicAuth(cred.Username, cred.Password)
+	}
+}
+
+// newHTTPClient create the http client with the tls settings of the credentials.
+func newHTTPClient(cred *repository.Credentials, timeout time.Duration) (*http.Client, error) {
+	tlsConf, err := newTLSConfig(cred)
+	if err != nil {
+		return nil, err
+	}
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, errors.New("invalid default transport")
+	}
+	transport = transport.Clone()
+	transport.TLSClientConfig = tlsConf
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}, nil
+}
+
+func newTLSConfig(cred *repository.Credentials) (*tls.Config, error) {
+	conf := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cred == nil {
+		return conf, nil
+	}
+	conf.InsecureSkipVerify = cred.InsecureSkipTLSVerify // nolint
+	if cred.CAData != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(cred.CAData)) {
+			return nil, errors.Wrap(ErrInvalidCredentials, "no valid certificate in the CA bundle")
+		}
+		conf.RootCAs = pool
+	}
+	if cred.CertData != "" || cred.KeyData != "" {
+		cert, err := tls.X509KeyPair([]byte(cred.CertData), []byte(cred.KeyData))
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidCredentials, "load client certificate: %s", err)
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+	return conf, nil
+}
@@ -21,13 +21,17 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/tkeel-io/kit/log"
 	pb "github.com/tkeel-io/tkeel/api/repo/v1"
 	"github.com/tkeel-io/tkeel/pkg/repository"
+	"golang.org/x/crypto/openpgp" // nolint
 	helmAction "helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/getter"
 	"helm.sh/helm/v3/pkg/release"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
@@ -77,14 +81,57 @@ type Repo struct {
 	driver       Driver
 	namespace    string
 	index        *Index
+	getter       getter.Getter
+	keyring      openpgp.EntityList
 }
 
 func NewHelmRepo(info *repository.Info, driver Driver, namespace string) (*Repo, error) {
+	return newRepo(info, driver, namespace, func(g getter.Getter) (*Index, error) {
+		return newIndexWithGetter(info.URL, info.Name, g)
+	})
+}
+
+// NewOCIRepo creates the repository of the helm charts stored in the oci registry,
+// the url is like "oci://registry.example.com/tkeel" and the charts are pulled by digest.
+func NewOCIRepo(info *repository.Info, driver Driver, namespace string) (*Repo, error) {
+	return newRepo(info, driver, namespace, func(getter.Getter) (*Index, error) {
+		return NewOCIIndex(info.URL, info.Name, ociCharts(info.Annotations), info.Credentials)
+	})
+}
+
+// ociCharts get the chart names set in the repository annotations.
+func ociCharts(annotations repository.Annotations) []string {
+	v, ok := annotations[OCIChartsKey].(string)
+	if !ok || v == "" {
+		return nil
+	}
+	ret := make([]string, 0)
+	for _, name := range strings.Split(v, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
+
+func newRepo(info *repository.Info, driver Driver, namespace string,
+	newIndex func(g getter.Getter) (*Index, error),
+) (*Repo, error) {
 	var index *Index
+	var keyring openpgp.EntityList
+	g := _getter
 	if info != nil {
+		if !repository.ValidVerifyPolicy(info.Verify) {
+			return nil, errors.Errorf("invalid repository(%s) verify policy: %s", info.Name, info.Verify)
+		}
+		kr, err := ParseKeyring(info.Keyring)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse repository(%s) keyring", info.Name)
+		}
+		keyring = kr
 		// make repository directory.
 		repoDirName := _repoDirName + "/" + info.Name + "/"
-		_, err := os.Stat(repoDirName)
+		_, err = os.Stat(repoDirName)
 		if err != nil {
 			if os.IsExist(err) {
 				if err = os.RemoveAll(repoDirName); err != nil {
@@ -99,7 +146,12 @@ func NewHelmRepo(info *repository.Info, driver Driver, namespace string) (*Repo,
 				return nil, errors.Wrapf(err, "make repository directory %s", repoDirName)
 			}
 		}
-		i, err := NewIndex(info.URL, info.Name)
+		rg, err := newRepoGetter(info.URL, info.Credentials)
+		if err != nil {
+			return nil, errors.Wrapf(err, "new repository(%s) getter", info.Name)
+		}
+		g = rg
+		i, err := newIndex(g)
 		if err != nil {
 			return nil, errors.Wrapf(err, "new index %s", info.Name)
 		}
@@ -111,6 +163,8 @@ func NewHelmRepo(info *repository.Info, driver Driver, namespace string) (*Repo,
 		namespace: namespace,
 		driver:    driver,
 		index:     index,
+		getter:    g,
+		keyring:   keyring,
 	}
 	if err := repo.configSetup(); err != nil {
 		return nil, errors.Wrap(err, "setup helm action configuration failed")
@@ -212,12 +266,22 @@ func (r *Repo) Get(name, version string) (repository.Installer, error) {
 		}
 	}
 	res := resList[0]
+	if version == "" {
+		// prefer the latest stable version, the highest version if only pre-releases.
+		latest, err := index.Search(name, LatestVersion)
+		if err != nil {
+			return nil, errors.Wrapf(err, "repo search %s/%s", name, LatestVersion)
+		}
+		if len(latest) != 0 {
+			res = latest[0]
+		}
+	}
 	// check cache chart.
 	chartFile := _repoDirName + "/" + r.info.Name + "/" + res.Name + "-" + res.Version + ".tgz"
 	_, err = os.Stat(chartFile)
 	if os.IsNotExist(err) {
 		log.Debugf("stat err: %s", err)
-		if err = downloadChart(chartFile, res.URLs...); err != nil {
+		if err = downloadChart(r.getter, chartFile, res.URLs...); err != nil {
 			return nil, errors.Wrapf(err, "download chart %s", chartFile)
 		}
 	} else if err != nil {
@@ -228,12 +292,19 @@ func (r *Repo) Get(name, version string) (repository.Installer, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "read chart %s", chartFile)
 	}
-	d := fmt.Sprintf("%x", sha256.Sum256(body))
+	d := sha256Hex(body)
 	log.Debugf("check sha256: %s -- %s", res.ChartInfo.Digest, d)
 	if res.ChartInfo.Digest != d {
-		if err = updateChart(chartFile, res.URLs...); err != nil {
+		if err = updateChart(r.getter, chartFile, res.URLs...); err != nil {
 			return nil, errors.Wrapf(err, "update chart %s", chartFile)
 		}
+		if body, err = os.ReadFile(chartFile); err != nil {
+			return nil, errors.Wrapf(err, "read chart %s", chartFile)
+		}
+	}
+	prov := verifyProvenance(r.info.Verify, r.keyring, r.getter, chartFile, r.index.ProvenanceURL(res.ChartInfo))
+	if prov.Status == repository.ProvenanceFailed {
+		log.Warnf("chart %s provenance verification failed: %s", chartFile, prov.Message)
 	}
 	ch, err := loader.LoadArchive(bytes.NewBuffer(body))
 	if err != nil {
@@ -255,6 +326,7 @@ func (r *Repo) Get(name, version string) (repository.Installer, error) {
 		}
 	}
 	brief.VersionList = versionList
+	brief.Provenance = prov
 	i := NewHelmInstaller(brief.Name, ch, *brief, r.namespace, r.actionConfig)
 	return &i, nil
 }
@@ -276,6 +348,8 @@ func (r *Repo) Update() (bool, error) {
 
 func (r *Repo) Len() int {
 	if r.index != nil {
+		r.index.lock.RLock()
+		defer r.index.lock.RUnlock()
 		count := 0
 		for _, vs := range r.index.helmIndex.Entries {
 			for _, v := range vs {
@@ -289,6 +363,15 @@ func (r *Repo) Len() int {
 	return 0
 }
 
+func (r *Repo) Generated() time.Time {
+	if r.index == nil {
+		return time.Time{}
+	}
+	r.index.lock.RLock()
+	defer r.index.lock.RUnlock()
+	return r.index.helmIndex.Generated
+}
+
 func (r *Repo) Close() error {
 	if r.info != nil {
 		if err := os.RemoveAll(_repoDirName + "/" + r.info.Name); err != nil {
@@ -298,18 +381,26 @@ func (r *Repo) Close() error {
 	return nil
 }
 
-func updateChart(chartFile string, urls ...string) error {
+func sha256Hex(b []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(b))
+}
+
+func updateChart(g getter.Getter, chartFile string, urls ...string) error {
 	log.Debug("update chart")
 	if err := os.Remove(chartFile); err != nil {
 		return errors.Wrapf(err, "remove chart %s", chartFile)
 	}
-	if err := downloadChart(chartFile, urls...); err != nil {
+	// the provenance of the previous chart is stale.
+	if err := os.Remove(chartFile + _provenanceSuffix); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove chart provenance %s", chartFile)
+	}
+	if err := downloadChart(g, chartFile, urls...); err != nil {
 		return errors.Wrapf(err, "download chart %s", chartFile)
 	}
 	return nil
 }
 
-func downloadChart(chartFile string, urls ...string) error {
+func downloadChart(g getter.Getter, chartFile string, urls ...string) error {
 	log.Debug("download chart")
 	if len(urls) == 0 {
 		return ErrNoValidURL
@@ -318,7 +409,7 @@ func downloadChart(chartFile string, urls ...string) error {
 	var b *bytes.Buffer
 	var err error
 	for _, url := range urls {
-		b, err = _getter.Get(url)
+		b, err = g.Get(url)
 		if err != nil {
 			continue
 		}
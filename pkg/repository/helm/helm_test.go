@@ -17,15 +17,32 @@ limitations under the License.
 package helm
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tkeel-io/tkeel/pkg/repository"
+	"golang.org/x/crypto/openpgp"       // nolint
+	"golang.org/x/crypto/openpgp/armor" // nolint
 	helmAction "helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/provenance"
+	"helm.sh/helm/v3/pkg/repo"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
 )
 
 func TestDriver_String(t *testing.T) {
@@ -443,3 +460,558 @@ func TestSearch(t *testing.T) {
 
 // Test Search / Install / Uninstall / Get.
 */
+
+func TestRedactValues(t *testing.T) {
+	values := map[string]interface{}{
+		"pluginID": "test",
+		"secret":   "changeme",
+	}
+	got := redactValues(values)
+	assert.Equal(t, "******", got["secret"])
+	assert.Equal(t, "test", got["pluginID"])
+	assert.Equal(t, "changeme", values["secret"])
+
+	nested := map[string]interface{}{
+		"mysql": map[string]interface{}{
+			"rootPassword": "root",
+			"host":         "localhost",
+			"secretName":   "mysql-secret",
+		},
+	}
+	got = redactValues(nested)
+	mysql, ok := got["mysql"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, "******", mysql["rootPassword"])
+	assert.Equal(t, "localhost", mysql["host"])
+	assert.Equal(t, "mysql-secret", mysql["secretName"])
+	assert.Equal(t, "root", nested["mysql"].(map[string]interface{})["rootPassword"])
+}
+
+// ociChartArchive build a chart archive with the Chart.yaml only.
+func ociChartArchive(t *testing.T, name, version string) []byte {
+	t.Helper()
+	chartYaml := "apiVersion: v2\nname: " + name + "\nversion: " + version +
+		"\ndescription: test\nannotations:\n  " + tKeelPluginEnableKey + ": \"true\"\n"
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	err := tw.WriteHeader(&tar.Header{Name: name + "/Chart.yaml", Mode: 0o644, Size: int64(len(chartYaml))})
+	assert.Nil(t, err)
+	_, err = tw.Write([]byte(chartYaml))
+	assert.Nil(t, err)
+	assert.Nil(t, tw.Close())
+	assert.Nil(t, gw.Close())
+	return buf.Bytes()
+}
+
+// newOCIRegistry serve the charts like a registry which requires the anonymous bearer token.
+func newOCIRegistry(t *testing.T, charts map[string][]string) *httptest.Server {
+	t.Helper()
+	blobs := make(map[string][]byte)
+	manifests := make(map[string][]byte)
+	addBlob := func(b []byte) string {
+		d := "sha256:" + sha256Hex(b)
+		blobs[d] = b
+		return d
+	}
+	for name, versions := range charts {
+		for _, v := range versions {
+			archive := ociChartArchive(t, name, v)
+			config := []byte(`{"name":"` + name + `","version":"` + v + `","apiVersion":"v2","description":"test",` +
+				`"annotations":{"` + tKeelPluginEnableKey + `":"true"}}`)
+			m := ociManifest{
+				SchemaVersion: 2,
+				Config:        ociDescriptor{MediaType: _ociHelmConfigMediaType, Digest: addBlob(config), Size: int64(len(config))},
+				Layers:        []ociDescriptor{{MediaType: _ociHelmChartMediaType, Digest: addBlob(archive), Size: int64(len(archive))}},
+			}
+			b, err := json.Marshal(m)
+			assert.Nil(t, err)
+			manifests["charts/"+name+":"+strings.ReplaceAll(v, "+", "_")] = b
+		}
+	}
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			w.Write([]byte(`{"token":"anonymous"}`)) // nolint
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer anonymous" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+srv.URL+`/token",service="test"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/v2/")
+		switch {
+		case path == "_catalog":
+			repos := make([]string, 0, len(charts)+1)
+			for name := range charts {
+				repos = append(repos, "charts/"+name)
+			}
+			repos = append(repos, "other/image")
+			json.NewEncoder(w).Encode(map[string][]string{"repositories": repos}) // nolint
+		case strings.HasSuffix(path, "/tags/list"):
+			name := strings.TrimPrefix(strings.TrimSuffix(path, "/tags/list"), "charts/")
+			tags := []string{"not-semver"}
+			for _, v := range charts[name] {
+				tags = append(tags, strings.ReplaceAll(v, "+", "_"))
+			}
+			json.NewEncoder(w).Encode(map[string][]string{"tags": tags}) // nolint
+		case strings.Contains(path, "/manifests/"):
+			i := strings.Index(path, "/manifests/")
+			b, ok := manifests[path[:i]+":"+path[i+len("/manifests/"):]]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", _ociManifestMediaType)
+			w.Write(b) // nolint
+		case strings.Contains(path, "/blobs/"):
+			b, ok := blobs[path[strings.Index(path, "/blobs/")+len("/blobs/"):]]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(b) // nolint
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return srv
+}
+
+func TestOCIIndex(t *testing.T) {
+	srv := newOCIRegistry(t, map[string][]string{
+		"iothub":  {"0.4.0", "0.4.1+build.1"},
+		"console": {"0.3.0"},
+	})
+	defer srv.Close()
+	u := OCIScheme + strings.TrimPrefix(srv.URL, "http://") + "/charts"
+
+	t.Run("catalog", func(t *testing.T) {
+		index, err := NewOCIIndex(u, "oci", nil, nil)
+		assert.Nil(t, err)
+		list, err := index.Search("iothub", "")
+		assert.Nil(t, err)
+		versions := make([]string, 0, len(list))
+		for _, v := range list {
+			versions = append(versions, v.Version)
+		}
+		assert.ElementsMatch(t, []string{"0.4.0", "0.4.1+build.1"}, versions)
+		list, err = index.Search("iothub", LatestVersion)
+		assert.Nil(t, err)
+		assert.Len(t, list, 1)
+		assert.Equal(t, "0.4.1+build.1", list[0].Version)
+
+		list, err = index.Search("iothub", "0.4.0")
+		assert.Nil(t, err)
+		assert.Len(t, list, 1)
+		assert.Equal(t, "oci", list[0].Repo)
+		assert.True(t, strings.HasPrefix(list[0].URLs[0], u+"/iothub@sha256:"))
+
+		b, err := pullOCIChart(list[0].URLs[0], nil)
+		assert.Nil(t, err)
+		assert.Equal(t, list[0].ChartInfo.Digest, sha256Hex(b.Bytes()))
+		assert.Equal(t, ociChartArchive(t, "iothub", "0.4.0"), b.Bytes())
+	})
+
+	t.Run("annotated charts", func(t *testing.T) {
+		index, err := NewOCIIndex(u, "oci", ociCharts(repository.Annotations{OCIChartsKey: "console, "}), nil)
+		assert.Nil(t, err)
+		list, err := index.Search("iothub", "")
+		assert.Nil(t, err)
+		assert.Len(t, list, 0)
+		list, err = index.Search("console", "")
+		assert.Nil(t, err)
+		assert.Len(t, list, 1)
+	})
+
+	t.Run("invalid reference", func(t *testing.T) {
+		_, err := pullOCIChart(u+"/iothub", nil)
+		assert.ErrorIs(t, err, ErrInvalidOCIReference)
+		_, err = pullOCIChart(u+"/iothub@sha256:0000", nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestRepoGetter(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Header.Get("Authorization"))) // nolint
+	}))
+	defer srv.Close()
+	ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	t.Run("untrusted server", func(t *testing.T) {
+		g, err := newRepoGetter(srv.URL, nil)
+		assert.Nil(t, err)
+		_, err = g.Get(srv.URL + "/index.yaml")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("bearer token", func(t *testing.T) {
+		g, err := newRepoGetter(srv.URL, &repository.Credentials{Token: "t0ken", CAData: ca})
+		assert.Nil(t, err)
+		b, err := g.Get(srv.URL + "/index.yaml")
+		assert.Nil(t, err)
+		assert.Equal(t, "Bearer t0ken", b.String())
+	})
+
+	t.Run("basic auth", func(t *testing.T) {
+		g, err := newRepoGetter(srv.URL, &repository.Credentials{Username: "admin", Password: "pass", InsecureSkipTLSVerify: true})
+		assert.Nil(t, err)
+		b, err := g.Get(srv.URL + "/charts/a-0.1.0.tgz")
+		assert.Nil(t, err)
+		assert.Equal(t, "Basic YWRtaW46cGFzcw==", b.String())
+	})
+
+	t.Run("other host", func(t *testing.T) {
+		g, err := newRepoGetter("https://charts.example.com", &repository.Credentials{Token: "t0ken", CAData: ca})
+		assert.Nil(t, err)
+		b, err := g.Get(srv.URL + "/index.yaml")
+		assert.Nil(t, err)
+		assert.Equal(t, "", b.String())
+	})
+
+	t.Run("invalid CA", func(t *testing.T) {
+		_, err := newRepoGetter(srv.URL, &repository.Credentials{CAData: "invalid"})
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+}
+
+func TestVerifyProvenance(t *testing.T) {
+	dir := t.TempDir()
+	signed := dir + "/signed-0.1.0.tgz"
+	assert.Nil(t, os.WriteFile(signed, ociChartArchive(t, "signed", "0.1.0"), os.ModePerm))
+	entity, err := openpgp.NewEntity("tkeel", "test", "test@tkeel.io", nil)
+	assert.Nil(t, err)
+	prov, err := (&provenance.Signatory{Entity: entity, KeyRing: openpgp.EntityList{entity}}).ClearSign(signed)
+	assert.Nil(t, err)
+	var armored bytes.Buffer
+	w, err := armor.Encode(&armored, openpgp.PublicKeyType, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, entity.Serialize(w))
+	assert.Nil(t, w.Close())
+	keyring, err := ParseKeyring(armored.String())
+	assert.Nil(t, err)
+	_, err = ParseKeyring("invalid")
+	assert.ErrorIs(t, err, ErrInvalidKeyring)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/signed-0.1.0.tgz.prov":
+		case "/broken-0.1.0.tgz.prov":
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(prov)) // nolint
+	}))
+	defer srv.Close()
+	g, err := newRepoGetter(srv.URL, nil)
+	assert.Nil(t, err)
+	// copy the chart so that each case fetches the provenance file, the
+	// provenance is bound to the chart file name.
+	chart := func(name string, content []byte) string {
+		assert.Nil(t, os.Mkdir(dir+"/"+name, os.ModePerm))
+		f := dir + "/" + name + "/signed-0.1.0.tgz"
+		assert.Nil(t, os.WriteFile(f, content, os.ModePerm))
+		return f
+	}
+	signedBody, err := os.ReadFile(signed)
+	assert.Nil(t, err)
+	signedURL := srv.URL + "/signed-0.1.0.tgz.prov"
+	unsignedURL := srv.URL + "/unsigned-0.1.0.tgz.prov"
+	brokenURL := srv.URL + "/broken-0.1.0.tgz.prov"
+
+	tests := []struct {
+		name    string
+		policy  string
+		keyring openpgp.EntityList
+		file    string
+		provURL string
+		status  string
+	}{
+		{"none", "", nil, chart("a", signedBody), signedURL, repository.ProvenanceSkipped},
+		{"verified", repository.VerifyRequired, keyring, chart("b", signedBody), signedURL, repository.ProvenanceVerified},
+		{"if-present verified", repository.VerifyIfPresent, keyring, chart("c", signedBody), signedURL, repository.ProvenanceVerified},
+		{"tampered", repository.VerifyRequired, keyring, chart("d", ociChartArchive(t, "signed", "0.1.1")), signedURL, repository.ProvenanceFailed},
+		{"if-present unsigned", repository.VerifyIfPresent, keyring, chart("e", signedBody), unsignedURL, repository.ProvenanceUnsigned},
+		{"required unsigned", repository.VerifyRequired, keyring, chart("f", signedBody), unsignedURL, repository.ProvenanceFailed},
+		{"no keyring", repository.VerifyRequired, nil, chart("g", signedBody), signedURL, repository.ProvenanceFailed},
+		{"if-present no url", repository.VerifyIfPresent, keyring, chart("h", signedBody), "", repository.ProvenanceUnsigned},
+		{"if-present fetch failed", repository.VerifyIfPresent, keyring, chart("i", signedBody), brokenURL, repository.ProvenanceFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := verifyProvenance(tt.policy, tt.keyring, g, tt.file, tt.provURL)
+			assert.Equal(t, tt.status, got.Status, got.Message)
+			if tt.status == repository.ProvenanceVerified {
+				assert.Equal(t, "tkeel (test) <test@tkeel.io>", got.SignedBy)
+				assert.Equal(t, entity.PrimaryKey.KeyIdString(), got.KeyID)
+				fi, err := os.Stat(tt.file + _provenanceSuffix)
+				assert.Nil(t, err)
+				assert.Equal(t, os.FileMode(0o644), fi.Mode().Perm()&0o644)
+			}
+			if tt.status == repository.ProvenanceFailed {
+				assert.ErrorIs(t, got.Err(), repository.ErrProvenanceVerification)
+			} else {
+				assert.Nil(t, got.Err())
+			}
+		})
+	}
+}
+
+func TestIndexVersions(t *testing.T) {
+	indexFile := func(versions ...string) *repo.IndexFile {
+		i := repo.NewIndexFile()
+		for _, v := range versions {
+			i.Entries["iothub"] = append(i.Entries["iothub"], &repo.ChartVersion{
+				Metadata: &chart.Metadata{
+					Name:        "iothub",
+					Version:     v,
+					Annotations: map[string]string{tKeelPluginEnableKey: "true"},
+				},
+			})
+		}
+		// the entries are not sorted by the semantic version in the index file.
+		return i
+	}
+	file := indexFile("0.4.0", "0.10.0-beta.1", "0.4.2", "0.9.0", "0.4.10", "not-semver")
+	index, err := newIndex("https://charts.tkeel.io", "tkeel", func() (*repo.IndexFile, error) {
+		return file, nil
+	})
+	assert.Nil(t, err)
+	versions := func(version string) []string {
+		list, err := index.Search("iothub", version)
+		assert.Nil(t, err)
+		ret := make([]string, 0, len(list))
+		for _, v := range list {
+			ret = append(ret, v.Version)
+		}
+		return ret
+	}
+
+	assert.Equal(t, []string{"0.10.0-beta.1", "0.9.0", "0.4.10", "0.4.2", "0.4.0", "not-semver"}, versions(""))
+	assert.Equal(t, []string{"0.9.0"}, versions(LatestVersion))
+	assert.Equal(t, []string{"0.10.0-beta.1"}, versions(LatestPrereleaseVersion))
+	assert.Equal(t, []string{"0.4.10", "0.4.2", "0.4.0"}, versions("~0.4"))
+	assert.Equal(t, []string{"0.9.0", "0.4.10"}, versions(">0.4.2"))
+	assert.Equal(t, []string{"0.4.2"}, versions("0.4.2"))
+	assert.Equal(t, []string{"not-semver"}, versions("not-semver"))
+	assert.Empty(t, versions("0.5.0"))
+	assert.Empty(t, versions("~invalid"))
+
+	t.Run("update", func(t *testing.T) {
+		file = indexFile("0.4.0", "1.0.0-rc.1", "0.9.1")
+		ok, err := index.Update()
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []string{"0.9.1"}, versions(LatestVersion))
+		assert.Equal(t, []string{"1.0.0-rc.1"}, versions(LatestPrereleaseVersion))
+		assert.Equal(t, []string{"1.0.0-rc.1", "0.9.1", "0.4.0"}, versions(""))
+
+		file = indexFile("1.0.0-rc.2")
+		_, err = index.Update()
+		assert.Nil(t, err)
+		assert.Empty(t, versions(LatestVersion))
+		assert.Equal(t, []string{"1.0.0-rc.2"}, versions(LatestPrereleaseVersion))
+	})
+}
+
+func TestInjectConfig(t *testing.T) {
+	ch := &chart.Chart{
+		Metadata:  &chart.Metadata{Name: "iothub"},
+		Values:    map[string]interface{}{},
+		Templates: []*chart.File{{Name: "templates/deployment.yaml"}},
+	}
+	assert.Nil(t, InjectConfig(ch, "iothub", "iothub", "s1"))
+	assert.Nil(t, InjectConfig(ch, "iothub", "iothub-green", "s2"))
+	names := make([]string, 0, len(ch.Templates))
+	for _, v := range ch.Templates {
+		names = append(names, v.Name)
+	}
+	assert.Equal(t, []string{"templates/deployment.yaml", _pluginConfigTemplate, _pluginOAuth2Template}, names)
+	assert.Equal(t, "iothub-green", ch.Values["appID"])
+	assert.Equal(t, "s2", ch.Values["secret"])
+}
+
+func TestReleaseRedacted(t *testing.T) {
+	const (
+		pluginSecret = "plugin-s3cr3t-value"
+		dbPassword   = "db-p@ssw0rd"
+		apiToken     = "api-t0k3n-value"
+	)
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{Name: "iothub", Version: "0.4.1", APIVersion: chart.APIVersionV2},
+		Values: map[string]interface{}{
+			"image":   "iothub:v0.4.1",
+			"db":      map[string]interface{}{"password": ""},
+			"clients": []interface{}{map[string]interface{}{"name": "c1", "token": ""}},
+		},
+		Templates: []*chart.File{
+			{Name: "templates/deployment.yaml", Data: []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: iothub
+spec:
+  template:
+    spec:
+      containers:
+      - name: iothub
+        image: {{ .Values.image }}
+        env:
+        - name: DB_PASSWORD
+          value: {{ .Values.db.password | quote }}
+        - name: DB_URL
+          value: "mysql://root:{{ .Values.db.password }}@mysql"
+`)},
+			{Name: "templates/secret.yaml", Data: []byte(`apiVersion: v1
+kind: Secret
+metadata:
+  name: iothub
+data:
+  token: {{ (index .Values.clients 0).token | b64enc }}
+`)},
+		},
+	}
+	assert.Nil(t, InjectConfig(ch, "iothub", "iothub", pluginSecret))
+	config := &helmAction.Configuration{
+		Releases:     storage.Init(driver.NewMemory()),
+		KubeClient:   &kubefake.PrintingKubeClient{Out: io.Discard},
+		Capabilities: chartutil.DefaultCapabilities,
+		Log:          func(format string, v ...interface{}) {},
+	}
+	install := helmAction.NewInstall(config)
+	install.ReleaseName, install.Namespace = "iothub", "keel-system"
+	_, err := install.Run(ch, map[string]interface{}{
+		"db":      map[string]interface{}{"password": dbPassword},
+		"clients": []interface{}{map[string]interface{}{"name": "c1", "token": apiToken}},
+	})
+	assert.Nil(t, err)
+
+	rel, err := (Installer{helmConfig: config, id: "iothub"}).Release(0)
+	assert.Nil(t, err)
+	b, err := json.Marshal(rel)
+	assert.Nil(t, err)
+	for _, secret := range []string{pluginSecret, dbPassword, apiToken} {
+		assert.NotContains(t, string(b), secret)
+		assert.NotContains(t, string(b), base64.StdEncoding.EncodeToString([]byte(secret)))
+	}
+	assert.Contains(t, rel.Manifest, "# Source: iothub/templates/plugin_oauth2.yaml")
+	assert.Contains(t, rel.Manifest, "clientSecret")
+	assert.Contains(t, rel.Manifest, "image: iothub:v0.4.1")
+	assert.Equal(t, _redactedValue, rel.Values["clients"].([]interface{})[0].(map[string]interface{})["token"])
+}
+
+func TestDryRunRedacted(t *testing.T) {
+	const pluginSecret = "plugin-s3cr3t-value"
+	newChart := func() *chart.Chart {
+		return &chart.Chart{
+			Metadata: &chart.Metadata{Name: "iothub", Version: "0.4.1", APIVersion: chart.APIVersionV2},
+			Values:   map[string]interface{}{"image": "iothub:v0.4.1"},
+			Templates: []*chart.File{
+				{Name: "templates/configmap.yaml", Data: []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: iothub
+data:
+  image: {{ .Values.image }}
+`)},
+			},
+		}
+	}
+	config := &helmAction.Configuration{
+		Releases:     storage.Init(driver.NewMemory()),
+		KubeClient:   &kubefake.PrintingKubeClient{Out: io.Discard},
+		Capabilities: chartutil.DefaultCapabilities,
+		Log:          func(format string, v ...interface{}) {},
+	}
+	installed := Installer{chart: newChart(), helmConfig: config, id: "iothub", namespace: "keel-system", secret: pluginSecret}
+	installed.options = installed.chart.Values
+	assert.Nil(t, installed.Install())
+
+	// the upgrade dry run injects the secret of the installed release.
+	upgrade := Installer{chart: newChart(), helmConfig: config, id: "iothub", namespace: "keel-system"}
+	upgrade.options = upgrade.chart.Values
+	ret, err := upgrade.DryRun(true)
+	assert.Nil(t, err)
+	assert.Contains(t, ret.Manifest, "clientSecret")
+	assert.Contains(t, ret.Manifest, "image: iothub:v0.4.1")
+	assert.NotContains(t, ret.Manifest, pluginSecret)
+	assert.NotContains(t, ret.Manifest, base64.StdEncoding.EncodeToString([]byte(pluginSecret)))
+	assert.Equal(t, _redactedValue, ret.Values["secret"])
+}
+
+func TestUpgradeAfterReconfigure(t *testing.T) {
+	const pluginSecret = "plugin-s3cr3t-value"
+	newChart := func() *chart.Chart {
+		return &chart.Chart{
+			Metadata: &chart.Metadata{Name: "iothub", Version: "0.4.1", APIVersion: chart.APIVersionV2},
+			Values:   map[string]interface{}{"image": "iothub:v0.4.1", "replicas": 1},
+			Templates: []*chart.File{
+				{Name: "templates/configmap.yaml", Data: []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: iothub
+data:
+  replicas: {{ .Values.replicas | quote }}
+`)},
+			},
+		}
+	}
+	config := &helmAction.Configuration{
+		Releases:     storage.Init(driver.NewMemory()),
+		KubeClient:   &kubefake.PrintingKubeClient{Out: io.Discard},
+		Capabilities: chartutil.DefaultCapabilities,
+		Log:          func(format string, v ...interface{}) {},
+	}
+	newInstaller := func(secret string) Installer {
+		ch := newChart()
+		return Installer{chart: ch, options: ch.Values, helmConfig: config, id: "iothub", namespace: "keel-system", secret: secret}
+	}
+	userValues := func() map[string]interface{} {
+		vals, err := newInstaller("").UserValues()
+		assert.Nil(t, err)
+		return vals
+	}
+
+	// the options are kept as the user values, not mixed with the chart defaults.
+	ch := newChart()
+	installed := Installer{chart: ch, options: ch.Values, helmConfig: config, id: "iothub", namespace: "keel-system", secret: pluginSecret}
+	assert.Nil(t, installed.Install(&repository.Option{Key: "replicas", Value: 2}))
+	assert.Equal(t, map[string]interface{}{"replicas": float64(2)}, jsonValues(t, userValues()))
+	assert.Equal(t, 1, ch.Values["replicas"])
+
+	assert.Nil(t, newInstaller("").Reconfigure(&repository.Option{Key: "replicas", Value: 3}))
+	assert.Equal(t, map[string]interface{}{"replicas": float64(3)}, jsonValues(t, userValues()))
+
+	// the upgrade options replace the reconfigured values.
+	assert.Nil(t, newInstaller("").Upgrade(&repository.Option{Key: "replicas", Value: 4}))
+	assert.Equal(t, map[string]interface{}{"replicas": float64(4)}, jsonValues(t, userValues()))
+	rel, err := newInstaller("").Release(0)
+	assert.Nil(t, err)
+	assert.Contains(t, rel.Manifest, `replicas: "4"`)
+	// the effective values coalesce the user values with the chart values.
+	values, err := newInstaller("").Values()
+	assert.Nil(t, err)
+	assert.Equal(t, float64(4), jsonValues(t, values)["replicas"])
+	assert.Equal(t, "iothub:v0.4.1", values["image"])
+
+	// the upgrade without options uses the chart defaults and keeps the secret.
+	assert.Nil(t, newInstaller("").Upgrade())
+	assert.Empty(t, userValues())
+	secret, err := newInstaller("").ReleaseSecret()
+	assert.Nil(t, err)
+	assert.Equal(t, pluginSecret, secret)
+}
+
+// jsonValues normalize the values by their JSON form.
+func jsonValues(t *testing.T, values map[string]interface{}) map[string]interface{} {
+	t.Helper()
+	b, err := json.Marshal(values)
+	assert.Nil(t, err)
+	ret := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(b, &ret))
+	return ret
+}
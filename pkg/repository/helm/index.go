@@ -19,9 +19,11 @@ package helm
 import (
 	"net/url"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
+	"github.com/Masterminds/semver/v3"
 	regexp "github.com/dlclark/regexp2"
 
 	"github.com/pkg/errors"
@@ -35,7 +37,12 @@ import (
 
 var _getter getter.Getter
 
-const LatestVersion = "latest"
+const (
+	// LatestVersion the latest stable version.
+	LatestVersion = "latest"
+	// LatestPrereleaseVersion the latest version including the pre-releases.
+	LatestPrereleaseVersion = "latest-prerelease"
+)
 
 func init() {
 	g, err := getter.NewHTTPGetter()
@@ -93,137 +100,233 @@ type Index struct {
 	RepoName  string
 	helmIndex *repo.IndexFile
 	charts    map[string]map[string]*repo.ChartVersion
+	latest    map[string]*repo.ChartVersion // the latest stable versions.
+	latestPre map[string]*repo.ChartVersion // the latest versions including the pre-releases.
 	lock      *sync.RWMutex
+	load      func() (*repo.IndexFile, error)
+	provURL   func(cv *repo.ChartVersion) string
 }
 
 // NewIndex creates a new Index.
 func NewIndex(url, repoName string) (*Index, error) {
-	i, err := getIndex(url, _getter)
+	return newIndexWithGetter(url, repoName, _getter)
+}
+
+// NewOCIIndex creates a new Index of the charts in the oci registry,
+// all the repositories under the url path are listed if charts is empty.
+func NewOCIIndex(url, repoName string, charts []string, cred *repository.Credentials) (*Index, error) {
+	l, err := newOCIIndexLoader(url, charts, cred)
+	if err != nil {
+		return nil, errors.Wrapf(err, "new oci index loader %s", url)
+	}
+	index, err := newIndex(url, repoName, l.load)
+	if err != nil {
+		return nil, err
+	}
+	index.provURL = l.provenanceURL
+	return index, nil
+}
+
+// newIndexWithGetter creates a new Index fetched by the getter.
+func newIndexWithGetter(url, repoName string, g getter.Getter) (*Index, error) {
+	return newIndex(url, repoName, func() (*repo.IndexFile, error) {
+		return getIndex(url, g)
+	})
+}
+
+func newIndex(url, repoName string, load func() (*repo.IndexFile, error)) (*Index, error) {
+	i, err := load()
 	if err != nil {
 		return nil, errors.Wrapf(err, "get repository(%s) index", url)
 	}
 	index := &Index{
-		URL:       url,
-		RepoName:  repoName,
-		helmIndex: i,
-		charts:    make(map[string]map[string]*repo.ChartVersion),
-		lock:      new(sync.RWMutex),
+		URL:      url,
+		RepoName: repoName,
+		lock:     new(sync.RWMutex),
+		load:     load,
 	}
+	index.setIndexFile(i)
+	return index, nil
+}
+
+// setIndexFile replace the index file and rebuild the versions of the charts.
+func (r *Index) setIndexFile(i *repo.IndexFile) {
+	charts := make(map[string]map[string]*repo.ChartVersion, len(i.Entries))
+	latest := make(map[string]*repo.ChartVersion, len(i.Entries))
+	latestPre := make(map[string]*repo.ChartVersion, len(i.Entries))
 	for name, ref := range i.Entries {
 		if len(ref) == 0 {
 			continue
 		}
-		versionMap, ok := index.charts[name]
-		if !ok {
-			versionMap = make(map[string]*repo.ChartVersion)
-			index.charts[name] = versionMap
-		}
-		if len(ref) > 0 {
-			versionMap[LatestVersion] = ref[0]
-		}
+		versionMap := make(map[string]*repo.ChartVersion, len(ref))
 		for _, rr := range ref {
 			versionMap[rr.Version] = rr
 		}
+		charts[name] = versionMap
+		stable, pre := latestVersions(ref)
+		if stable != nil {
+			latest[name] = stable
+		}
+		if pre != nil {
+			latestPre[name] = pre
+		}
 	}
-	return index, nil
+	r.helmIndex = i
+	r.charts = charts
+	r.latest = latest
+	r.latestPre = latestPre
+}
+
+// latestVersions get the highest stable version and the highest version including
+// the pre-releases by semantic version ordering, the invalid versions are ignored.
+func latestVersions(cvs repo.ChartVersions) (stable, pre *repo.ChartVersion) {
+	var stableV, preV *semver.Version
+	for _, cv := range cvs {
+		v, err := semver.NewVersion(cv.Version)
+		if err != nil {
+			continue
+		}
+		if preV == nil || v.GreaterThan(preV) {
+			preV, pre = v, cv
+		}
+		if v.Prerelease() == "" && (stableV == nil || v.GreaterThan(stableV)) {
+			stableV, stable = v, cv
+		}
+	}
+	return stable, pre
 }
 
+// Search the plugins whose name matches the word, "*" matches all. The version is
+//   - empty: all the versions.
+//   - "latest": the latest stable version.
+//   - "latest-prerelease": the latest version including the pre-releases.
+//   - the version or a semantic version constraint such as "~0.4" or ">=0.4.0, <0.5.0".
+//
+// The result is sorted by name and by version in descending order.
 func (r *Index) Search(word string, version string) (PluginResList, error) {
 	r.lock.RLock()
 	defer r.lock.RUnlock()
+	var exp *regexp.Regexp
+	if word != "*" {
+		var err error
+		if exp, err = regexp.Compile(word, regexp.None); err != nil {
+			return nil, errors.Wrapf(err, "%s is not a valid regular expression", word)
+		}
+	}
+	match := r.versionMatcher(version)
 	list := make(PluginResList, 0, len(r.helmIndex.Entries))
-	if word == "*" {
-		for _, vMap := range r.charts {
+	for chartName, vMap := range r.charts {
+		if exp != nil {
+			m, err := exp.FindStringMatch(chartName)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s is not a valid regular expression", word)
+			}
+			if m == nil || m.Capture.Index != 0 || m.Capture.Length != len(chartName) {
+				continue
+			}
+		}
+		for _, ch := range match(chartName, vMap) {
+			if _, ok := ch.Metadata.Annotations[tKeelPluginEnableKey]; ok {
+				list = append(list, &PluginRes{
+					Name:        ch.Name,
+					Version:     ch.Version,
+					Repo:        r.RepoName,
+					URLs:        ch.URLs,
+					Description: ch.Description,
+					ChartInfo:   ch,
+				})
+			}
+		}
+	}
+	sortPluginResList(list)
+	return list, nil
+}
+
+// versionMatcher get the matcher of the chart versions by the version of Search,
+// the version is matched exactly before it is parsed as a constraint.
+func (r *Index) versionMatcher(version string) func(name string, vMap map[string]*repo.ChartVersion) []*repo.ChartVersion {
+	switch version {
+	case "":
+		return func(_ string, vMap map[string]*repo.ChartVersion) []*repo.ChartVersion {
+			ret := make([]*repo.ChartVersion, 0, len(vMap))
 			for _, ch := range vMap {
-				if _, ok := ch.Metadata.Annotations[tKeelPluginEnableKey]; ok {
-					res := PluginRes{
-						Name:        ch.Name,
-						Version:     ch.Version,
-						Repo:        r.RepoName,
-						URLs:        ch.URLs,
-						Description: ch.Description,
-						ChartInfo:   ch,
-					}
-					list = append(list, &res)
-				}
+				ret = append(ret, ch)
+			}
+			return ret
+		}
+	case LatestVersion, LatestPrereleaseVersion:
+		latest := r.latest
+		if version == LatestPrereleaseVersion {
+			latest = r.latestPre
+		}
+		return func(name string, _ map[string]*repo.ChartVersion) []*repo.ChartVersion {
+			if ch, ok := latest[name]; ok {
+				return []*repo.ChartVersion{ch}
 			}
+			return nil
 		}
-		return list, nil
 	}
-	exp, err := regexp.Compile(word, regexp.None)
+	c, err := semver.NewConstraint(version)
 	if err != nil {
-		return nil, errors.Wrapf(err, "%s is not a valid regular expression", word)
+		log.Debugf("search version %q is not a constraint: %s", version, err)
 	}
-	for chartName, vMap := range r.charts {
-		match, err := exp.FindStringMatch(chartName)
-		if err != nil {
-			return nil, errors.Wrapf(err, "%s is not a valid regular expression", word)
+	return func(_ string, vMap map[string]*repo.ChartVersion) []*repo.ChartVersion {
+		if ch, ok := vMap[version]; ok {
+			return []*repo.ChartVersion{ch}
+		}
+		if c == nil {
+			return nil
 		}
-		if match != nil &&
-			match.Capture.Index == 0 &&
-			match.Capture.Length == len(chartName) {
-			for v, ch := range vMap {
-				if version == "" && v != LatestVersion || version == v {
-					if _, ok := ch.Metadata.Annotations[tKeelPluginEnableKey]; ok {
-						res := PluginRes{
-							Name:        ch.Name,
-							Version:     ch.Version,
-							Repo:        r.RepoName,
-							URLs:        ch.URLs,
-							Description: ch.Description,
-							ChartInfo:   ch,
-						}
-						list = append(list, &res)
-					}
-				}
+		ret := make([]*repo.ChartVersion, 0)
+		for v, ch := range vMap {
+			if sv, err := semver.NewVersion(v); err == nil && c.Check(sv) {
+				ret = append(ret, ch)
 			}
 		}
+		return ret
 	}
-	return list, nil
 }
 
+// sortPluginResList sort the list by name and by version in descending order,
+// the invalid semantic versions are placed after the valid ones.
+func sortPluginResList(list PluginResList) {
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Name != list[j].Name {
+			return list[i].Name < list[j].Name
+		}
+		vi, erri := semver.NewVersion(list[i].Version)
+		vj, errj := semver.NewVersion(list[j].Version)
+		switch {
+		case erri == nil && errj == nil:
+			return vi.GreaterThan(vj)
+		case erri == nil || errj == nil:
+			return erri == nil
+		}
+		return list[i].Version > list[j].Version
+	})
+}
+
+// ProvenanceURL get the url of the chart provenance file, which is
+// the chart url with the ".prov" suffix in the helm repositories.
+func (r *Index) ProvenanceURL(cv *repo.ChartVersion) string {
+	if r.provURL != nil {
+		return r.provURL(cv)
+	}
+	if len(cv.URLs) == 0 {
+		return ""
+	}
+	return cv.URLs[0] + _provenanceSuffix
+}
+
+// Update reload the index file and recompute the latest versions.
 func (r *Index) Update() (bool, error) {
-	iFile, err := getIndex(r.URL, _getter)
+	iFile, err := r.load()
 	if err != nil {
 		return false, errors.Wrapf(err, "get repository(%s) index", r.URL)
 	}
-	if iFile.Generated.After(r.helmIndex.Generated) {
-		r.helmIndex = iFile
-	}
 	r.lock.Lock()
 	defer r.lock.Unlock()
-	for name, ref := range iFile.Entries {
-		if len(ref) == 0 {
-			continue
-		}
-		for _, rr := range ref {
-			versionMap, ok := r.charts[name]
-			if !ok {
-				versionMap = make(map[string]*repo.ChartVersion)
-				r.charts[name] = versionMap
-			}
-			versionMap[rr.Version] = rr
-		}
-	}
-	for k, vMap := range r.charts {
-		vList, ok := iFile.Entries[k]
-		if !ok {
-			delete(r.charts, k)
-		} else {
-			for ver := range vMap {
-				exist := false
-				for _, v := range vList {
-					if v.Version == ver {
-						exist = true
-						break
-					}
-				}
-				if !exist {
-					delete(vMap, ver)
-				}
-			}
-		}
-	}
+	r.setIndexFile(iFile)
 	return true, nil
 }
 
@@ -29,7 +29,9 @@ import (
 	"gopkg.in/yaml.v3"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/postrender"
+	"helm.sh/helm/v3/pkg/storage/driver"
 )
 
 const (
@@ -40,8 +42,6 @@ const (
 	ChartMetaDataKey = "_Tkeel_Chart_Metadata_"
 )
 
-var SecretContext = "changeme"
-
 var _ repository.Installer = &Installer{}
 
 var PullPolicy = "IfNotPresent"
@@ -59,9 +59,11 @@ type Installer struct {
 	helmConfig  *action.Configuration
 	options     map[string]interface{}
 	id          string
+	appID       string
 	brief       repository.InstallerBrief
 	annotations repository.Annotations
 	namespace   string
+	secret      string
 }
 
 func NewHelmInstaller(id string, ch *chart.Chart, brief repository.InstallerBrief,
@@ -128,6 +130,16 @@ func NewHelmInstallerQuick(id, namespace string, config *action.Configuration) I
 	}
 }
 
+// NewReleaseInstaller return an installer without chart which only operates the installed release.
+func NewReleaseInstaller(id, namespace string, driver Driver) (*Installer, error) {
+	config, err := initActionConfig(namespace, driver)
+	if err != nil {
+		return nil, errors.Wrap(err, "init helm action configuration")
+	}
+	installer := NewHelmInstallerQuick(id, namespace, config)
+	return &installer, nil
+}
+
 func (h *Installer) SetChart(ch *chart.Chart) {
 	h.chart = ch
 }
@@ -140,6 +152,23 @@ func (h *Installer) SetPluginID(id string) {
 	h.id = id
 }
 
+// SetAppID set the release name and the dapr app id, the plugin id is used if not set.
+func (h *Installer) SetAppID(appID string) {
+	h.appID = appID
+}
+
+// releaseName get the release name which is also the dapr app id of the plugin.
+func (h Installer) releaseName() string {
+	if h.appID == "" {
+		return h.id
+	}
+	return h.appID
+}
+
+func (h *Installer) SetSecret(secret string) {
+	h.secret = secret
+}
+
 func (h Installer) Annotations() repository.Annotations {
 	return h.annotations
 }
@@ -169,15 +198,9 @@ func (h *Installer) SetOption(ops ...*repository.Option) error {
 }
 
 func (h Installer) Install(ops ...*repository.Option) error {
-	for _, v := range ops {
-		_, ok := h.options[v.Key]
-		if ok {
-			h.options[v.Key] = v.Value
-		}
-	}
-	_, err := json.Marshal(h.options)
+	render, vals, err := h.prepare(ops...)
 	if err != nil {
-		return fmt.Errorf("error check opthion: %w", err)
+		return err
 	}
 
 	installer := action.NewInstall(h.helmConfig)
@@ -185,42 +208,21 @@ func (h Installer) Install(ops ...*repository.Option) error {
 	installer.Version = h.brief.Version
 
 	installer.Namespace = h.namespace
-	installer.ReleaseName = h.id
-
-	if err = checkIfInstallable(h.chart); err != nil {
-		return fmt.Errorf("error installer installable: %w", err)
-	}
-
-	if h.chart.Metadata.Deprecated {
-		log.Warn("This chart is deprecated")
-	}
-	err = InjectConfig(h.chart, h.id, _componentSecret)
-	if err != nil {
-		return err
-	}
-	// inject dapr annotation.
-	render, err := h.inject()
-	if err != nil {
-		return errors.Wrap(err, "inject err")
-	}
+	installer.ReleaseName = h.releaseName()
 	installer.PostRenderer = render
 
-	if _, err := installer.Run(h.chart, nil); err != nil {
+	if _, err := installer.Run(h.chart, vals); err != nil {
 		return errors.Wrap(err, "INSTALLATION FAILED")
 	}
 	return nil
 }
 
+// Upgrade the release with the chart, the options replace the user values of the release,
+// the plugin secret of the release is kept by the injected config.
 func (h Installer) Upgrade(ops ...*repository.Option) error {
-	for _, v := range ops {
-		_, ok := h.options[v.Key]
-		if ok {
-			h.options[v.Key] = v.Value
-		}
-	}
-	_, err := json.Marshal(h.options)
+	render, vals, err := h.prepare(ops...)
 	if err != nil {
-		return fmt.Errorf("error check opthion: %w", err)
+		return err
 	}
 
 	upgrader := action.NewUpgrade(h.helmConfig)
@@ -228,52 +230,209 @@ func (h Installer) Upgrade(ops ...*repository.Option) error {
 	upgrader.Version = h.brief.Version
 
 	upgrader.Namespace = h.namespace
+	upgrader.ResetValues = true
+	upgrader.PostRenderer = render
 
-	if err = checkIfInstallable(h.chart); err != nil {
-		return fmt.Errorf("error installer installable: %w", err)
+	if _, err := upgrader.Run(h.releaseName(), h.chart, vals); err != nil {
+		return errors.Wrap(err, "INSTALLATION FAILED")
 	}
+	return nil
+}
 
-	if h.chart.Metadata.Deprecated {
-		log.Warn("This chart is deprecated")
+// DryRun render the chart in client only mode, the release storage and
+// the kubernetes client are replaced by a copy of the helm configuration.
+func (h Installer) DryRun(isUpgrade bool, ops ...*repository.Option) (*repository.DryRunResult, error) {
+	render, vals, err := h.prepare(ops...)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := *h.helmConfig
+	installer := action.NewInstall(&cfg)
+
+	installer.Version = h.brief.Version
+
+	installer.Namespace = h.namespace
+	installer.ReleaseName = h.releaseName()
+	installer.DryRun = true
+	installer.ClientOnly = true
+	installer.IsUpgrade = isUpgrade
+	installer.PostRenderer = render
+
+	rel, err := installer.Run(h.chart, vals)
+	if err != nil {
+		return nil, errors.Wrap(err, "DRY RUN FAILED")
 	}
-	err = InjectConfig(h.chart, h.id, _componentSecret)
+	effective, err := chartutil.CoalesceValues(rel.Chart, rel.Config)
 	if err != nil {
+		return nil, errors.Wrapf(err, "coalesce release %s values", h.releaseName())
+	}
+	// the injected plugin secret is rendered into the manifest.
+	ret := &repository.DryRunResult{
+		Manifest: redactManifest(rel.Manifest, effective.AsMap()),
+		Values:   redactValues(effective.AsMap()),
+	}
+	if rel.Info != nil {
+		ret.Notes = rel.Info.Notes
+	}
+	return ret, nil
+}
+
+func (h Installer) Uninstall() error {
+	uninstallClint := action.NewUninstall(h.helmConfig)
+	_, err := uninstallClint.Run(h.releaseName())
+	if err != nil {
+		err = errors.Wrap(err, "call uninstall err")
 		return err
 	}
-	// inject dapr annotation.
+
+	return nil
+}
+
+func (h Installer) Revision() (int, error) {
+	rel, err := action.NewGet(h.helmConfig).Run(h.releaseName())
+	if err != nil {
+		return 0, errors.Wrapf(err, "get release %s", h.releaseName())
+	}
+	return rel.Version, nil
+}
+
+// Reconfigure upgrade the release with the chart of the current release,
+// the options are validated against the chart schema and merged into the current values.
+func (h Installer) Reconfigure(ops ...*repository.Option) error {
+	rel, err := action.NewGet(h.helmConfig).Run(h.releaseName())
+	if err != nil {
+		return errors.Wrapf(err, "get release %s", h.releaseName())
+	}
+	if rel.Chart == nil {
+		return errors.Wrapf(ErrNoChartInfoSet, "release %s", h.releaseName())
+	}
+	vals := make(map[string]interface{}, len(ops))
+	for _, v := range ops {
+		vals[v.Key] = v.Value
+	}
+	current, err := chartutil.CoalesceValues(rel.Chart, rel.Config)
+	if err != nil {
+		return errors.Wrapf(err, "coalesce release %s values", h.releaseName())
+	}
+	merged := chartutil.CoalesceTables(copyValues(vals), current.AsMap())
+	if err = chartutil.ValidateAgainstSchema(rel.Chart, merged); err != nil {
+		return errors.Wrapf(repository.ErrInvalidOptions, "validate configuration: %s", err)
+	}
+
+	h.chart = rel.Chart
 	render, err := h.inject()
 	if err != nil {
 		return errors.Wrap(err, "inject err")
 	}
+
+	upgrader := action.NewUpgrade(h.helmConfig)
+	upgrader.Namespace = h.namespace
+	upgrader.ReuseValues = true
 	upgrader.PostRenderer = render
 
-	if _, err := upgrader.Run(h.id, h.chart, nil); err != nil {
-		return errors.Wrap(err, "INSTALLATION FAILED")
+	if _, err := upgrader.Run(h.releaseName(), rel.Chart, vals); err != nil {
+		return errors.Wrap(err, "RECONFIGURATION FAILED")
 	}
 	return nil
 }
 
-func (h Installer) Uninstall() error {
-	uninstallClint := action.NewUninstall(h.helmConfig)
-	_, err := uninstallClint.Run(h.id)
+// ReleaseSecret get the plugin secret injected into the installed release,
+// the component secret is returned if the release is not installed.
+func (h Installer) ReleaseSecret() (string, error) {
+	get := action.NewGetValues(h.helmConfig)
+	get.AllValues = true
+	vals, err := get.Run(h.releaseName())
 	if err != nil {
-		err = errors.Wrap(err, "call uninstall err")
-		return err
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return _componentSecret, nil
+		}
+		return "", errors.Wrapf(err, "get release %s values", h.releaseName())
 	}
+	if secret, ok := vals["secret"].(string); ok && secret != "" {
+		return secret, nil
+	}
+	return _componentSecret, nil
+}
 
-	return nil
+func (h Installer) Rollback(revision int) (*repository.InstallerBrief, error) {
+	rollback := action.NewRollback(h.helmConfig)
+	rollback.Version = revision
+	if err := rollback.Run(h.releaseName()); err != nil {
+		return nil, errors.Wrapf(err, "rollback release %s to revision %d", h.releaseName(), revision)
+	}
+	rel, err := action.NewGet(h.helmConfig).Run(h.releaseName())
+	if err != nil {
+		return nil, errors.Wrapf(err, "get release %s", h.releaseName())
+	}
+	brief := h.brief
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		brief.Name = rel.Chart.Metadata.Name
+		brief.Version = rel.Chart.Metadata.Version
+		brief.Desc = rel.Chart.Metadata.Description
+		brief.Icon = rel.Chart.Metadata.Icon
+		brief.State = repository.StateInstalled
+	}
+	return &brief, nil
 }
 
 func (h Installer) Brief() *repository.InstallerBrief {
 	return &h.brief
 }
 
+// prepare inject the plugin config, return the post renderer and the values of the options
+// used by install, upgrade and dry run. The options of the chart values are kept as the
+// user values of the release, so that they are not mixed with the chart defaults.
+func (h *Installer) prepare(ops ...*repository.Option) (postrender.PostRenderer, map[string]interface{}, error) {
+	vals := make(map[string]interface{}, len(ops))
+	for _, v := range ops {
+		_, ok := h.options[v.Key]
+		if ok {
+			vals[v.Key] = v.Value
+		}
+	}
+	_, err := json.Marshal(vals)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error check opthion: %w", err)
+	}
+
+	if err = checkIfInstallable(h.chart); err != nil {
+		return nil, nil, fmt.Errorf("error installer installable: %w", err)
+	}
+	if err = h.brief.Provenance.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error installer provenance: %w", err)
+	}
+
+	if h.chart.Metadata.Deprecated {
+		log.Warn("This chart is deprecated")
+	}
+	secret := h.secret
+	if secret == "" {
+		// keep the secret of the installed release when upgrading.
+		if secret, err = h.ReleaseSecret(); err != nil {
+			return nil, nil, errors.Wrap(err, "get release secret")
+		}
+	}
+	err = InjectConfig(h.chart, h.id, h.releaseName(), secret)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the plugin secret is injected, not supplied by the options.
+	delete(vals, "secret")
+	// inject dapr annotation.
+	render, err := h.inject()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "inject err")
+	}
+	return render, vals, nil
+}
+
 func (h *Installer) inject() (postrender.PostRenderer, error) {
 	enableAutoInject := getBoolAnnotationOrDefault(h.chart.Metadata.Annotations,
 		tKeelPluginEnableKey, false)
 	if !enableAutoInject {
 		// Compatible with versions prior to 0.4.0.
-		h.chart.Values["daprConfig"] = h.id
+		h.chart.Values["daprConfig"] = h.releaseName()
 		return nil, nil
 	}
 	deployment := getStringAnnotation(h.chart.Metadata.Annotations, tKeelPluginDeploymentKey)
@@ -281,7 +440,11 @@ func (h *Installer) inject() (postrender.PostRenderer, error) {
 	if deployment == "" || appPort == "" {
 		return nil, errors.New("get plugin annotations err")
 	}
-	return newKustomizeRender(deployment, h.id, appPort), nil
+	// the deployment named after the plugin is named after the release in a separate release.
+	if deployment == h.id {
+		deployment = h.releaseName()
+	}
+	return newKustomizeRender(deployment, h.releaseName(), appPort), nil
 }
 
 func checkIfInstallable(ch *chart.Chart) error {
@@ -295,13 +458,46 @@ func checkIfInstallable(ch *chart.Chart) error {
 	return errors.Errorf("%s charts are not installable", ch.Metadata.Type)
 }
 
-func InjectConfig(root *chart.Chart, name, secret string) error {
+// InjectConfig inject the plugin config, the dapr configuration and the oauth2 client
+// component are named after the app id so that the releases of the same plugin can coexist.
+// The injected templates replace the ones of the same name, so the chart can be injected again.
+func InjectConfig(root *chart.Chart, name, appID, secret string) error {
 	root.Values["pluginID"] = name
+	root.Values["appID"] = appID
 	root.Values["secret"] = secret
 	root.Values["rudderPort"] = 31234
-	root.Templates = append(root.Templates,
-		&chart.File{Name: "templates/plugin_config.yaml", Data: []byte(PluginConfig)},
-		&chart.File{Name: "templates/plugin_oauth2.yaml", Data: []byte(PluginOAuth2)},
+	root.Templates = setTemplates(root.Templates,
+		&chart.File{Name: _pluginConfigTemplate, Data: []byte(PluginConfig)},
+		&chart.File{Name: _pluginOAuth2Template, Data: []byte(PluginOAuth2)},
 	)
 	return nil
 }
+
+// setTemplates replace the templates of the same name, or append them.
+func setTemplates(templates []*chart.File, files ...*chart.File) []*chart.File {
+	for _, f := range files {
+		replaced := false
+		for i, v := range templates {
+			if v.Name == f.Name {
+				templates[i] = f
+				replaced = true
+			}
+		}
+		if !replaced {
+			templates = append(templates, f)
+		}
+	}
+	return templates
+}
+
+// copyValues return a deep copy of values, chartutil.CoalesceTables modifies the destination.
+func copyValues(values map[string]interface{}) map[string]interface{} {
+	ret := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if m, ok := v.(map[string]interface{}); ok {
+			v = copyValues(m)
+		}
+		ret[k] = v
+	}
+	return ret
+}
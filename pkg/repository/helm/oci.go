@@ -0,0 +1,567 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/repo"
+)
+
+const (
+	OCIScheme = "oci://"
+
+	// OCIChartsKey the repository annotation of the comma separated chart names in the registry,
+	// the charts are listed by the registry catalog if not set.
+	OCIChartsKey = "tkeel.io/oci-charts"
+
+	_ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	_ociHelmConfigMediaType  = "application/vnd.cncf.helm.config.v1+json"
+	_ociHelmChartMediaType   = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	_ociLegacyChartMediaType = "application/tar+gzip"
+	_ociProvenanceMediaType  = "application/vnd.cncf.helm.chart.provenance.v1.prov"
+	_ociCreatedAnnotation    = "org.opencontainers.image.created"
+	_ociCatalogPageSize      = 100
+	_ociRequestTimeout       = 30 * time.Second
+)
+
+var ErrInvalidOCIReference = errors.New("invalid oci reference")
+
+// IsOCI whether the repository url is an oci registry.
+func IsOCI(u string) bool {
+	return strings.HasPrefix(u, OCIScheme)
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+type ociManifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	Config        ociDescriptor     `json:"config"`
+	Layers        []ociDescriptor   `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// chartLayer get the layer of the chart archive.
+func (m *ociManifest) chartLayer() (*ociDescriptor, error) {
+	for i, v := range m.Layers {
+		if v.MediaType == _ociHelmChartMediaType || v.MediaType == _ociLegacyChartMediaType {
+			return &m.Layers[i], nil
+		}
+	}
+	return nil, errors.New("chart layer not found")
+}
+
+// provenanceLayer get the layer of the chart provenance, nil if not signed.
+func (m *ociManifest) provenanceLayer() *ociDescriptor {
+	for i, v := range m.Layers {
+		if v.MediaType == _ociProvenanceMediaType {
+			return &m.Layers[i]
+		}
+	}
+	return nil
+}
+
+// ociReference the reference of the repository or the blob in the registry,
+// "oci://host/path" or "oci://host/path@sha256:...".
+type ociReference struct {
+	Host   string
+	Path   string
+	Digest string
+}
+
+func parseOCIReference(ref string) (*ociReference, error) {
+	if !IsOCI(ref) {
+		return nil, errors.Wrapf(ErrInvalidOCIReference, "%s", ref)
+	}
+	s := strings.TrimSuffix(strings.TrimPrefix(ref, OCIScheme), "/")
+	ret := &ociReference{}
+	if i := strings.Index(s, "@"); i >= 0 {
+		s, ret.Digest = s[:i], s[i+1:]
+	}
+	if i := strings.Index(s, "/"); i >= 0 {
+		ret.Host, ret.Path = s[:i], s[i+1:]
+	} else {
+		ret.Host = s
+	}
+	if ret.Host == "" {
+		return nil, errors.Wrapf(ErrInvalidOCIReference, "%s", ref)
+	}
+	return ret, nil
+}
+
+func (r *ociReference) String() string {
+	s := OCIScheme + r.Host
+	if r.Path != "" {
+		s += "/" + r.Path
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// ociClient the client of the registry http api v2, the bearer token is requested
+// with the basic auth of the credentials when the registry asks for it.
+type ociClient struct {
+	base   string
+	cred   *repository.Credentials
+	client *http.Client
+	lock   sync.Mutex
+	tokens map[string]string // scope map to the bearer token.
+}
+
+func newOCIClient(host string, cred *repository.Credentials) (*ociClient, error) {
+	scheme := "https"
+	// the loopback registries are accessed without tls like docker does.
+	if isLoopbackHost(host) {
+		scheme = "http"
+	}
+	client, err := newHTTPClient(cred, _ociRequestTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &ociClient{
+		base:   scheme + "://" + host,
+		cred:   cred,
+		client: client,
+		tokens: make(map[string]string),
+	}, nil
+}
+
+func isLoopbackHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func (c *ociClient) get(path, scope string, accept ...string) (*http.Response, error) {
+	do := func() (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodGet, c.base+path, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "new request %s", path)
+		}
+		for _, v := range accept {
+			req.Header.Add("Accept", v)
+		}
+		c.lock.Lock()
+		token := c.tokens[scope]
+		c.lock.Unlock()
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		} else {
+			setAuth(req, c.cred)
+		}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", c.base+path)
+		}
+		return resp, nil
+	}
+	resp, err := do()
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err = c.fetchToken(challenge, scope); err != nil {
+			return nil, errors.Wrapf(err, "authorize GET %s", c.base+path)
+		}
+		if resp, err = do(); err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, errors.Wrapf(ErrNotFound, "GET %s: %s", c.base+path, resp.Status)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.Errorf("GET %s: %s", c.base+path, resp.Status)
+	}
+	return resp, nil
+}
+
+// fetchToken get the bearer token of the scope by the challenge of the registry.
+func (c *ociClient) fetchToken(challenge, scope string) error {
+	if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+		return errors.Errorf("unsupported auth challenge: %q", challenge)
+	}
+	params := parseAuthParams(challenge[len("bearer "):])
+	realm := params["realm"]
+	if realm == "" {
+		return errors.Errorf("no realm in auth challenge: %q", challenge)
+	}
+	u, err := url.Parse(realm)
+	if err != nil {
+		return errors.Wrapf(err, "parse realm %s", realm)
+	}
+	q := u.Query()
+	if v := params["service"]; v != "" {
+		q.Set("service", v)
+	}
+	if v := params["scope"]; v != "" {
+		q.Set("scope", v)
+	} else if scope != "" {
+		q.Set("scope", scope)
+	}
+	u.RawQuery = q.Encode()
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return errors.Wrapf(err, "new request %s", realm)
+	}
+	if c.cred != nil && (c.cred.Username != "" || c.cred.Password != "") {
+		req.SetBasicAuth(c.cred.Username, c.cred.Password)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "GET %s", realm)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("GET %s: %s", realm, resp.Status)
+	}
+	ret := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err = json.NewDecoder(resp.Body).Decode(&ret); err != nil {
+		return errors.Wrap(err, "decode token")
+	}
+	token := ret.Token
+	if token == "" {
+		token = ret.AccessToken
+	}
+	if token == "" {
+		return errors.New("empty token")
+	}
+	c.lock.Lock()
+	c.tokens[scope] = token
+	c.lock.Unlock()
+	return nil
+}
+
+// parseAuthParams parse the comma separated key="value" pairs of the auth challenge.
+func parseAuthParams(s string) map[string]string {
+	ret := make(map[string]string)
+	for s = strings.TrimSpace(s); s != ""; s = strings.TrimSpace(s) {
+		i := strings.Index(s, "=")
+		if i < 0 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(s[:i]))
+		s = strings.TrimSpace(s[i+1:])
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			end := strings.Index(s[1:], `"`)
+			if end < 0 {
+				value, s = s[1:], ""
+			} else {
+				value, s = s[1:end+1], s[end+2:]
+			}
+		} else if end := strings.Index(s, ","); end >= 0 {
+			value, s = s[:end], s[end:]
+		} else {
+			value, s = s, ""
+		}
+		ret[key] = value
+		s = strings.TrimPrefix(strings.TrimSpace(s), ",")
+	}
+	return ret
+}
+
+func pullScope(name string) string {
+	return "repository:" + name + ":pull"
+}
+
+// catalog list the repositories in the registry with the prefix.
+func (c *ociClient) catalog(prefix string) ([]string, error) {
+	ret := make([]string, 0)
+	path := fmt.Sprintf("/v2/_catalog?n=%d", _ociCatalogPageSize)
+	for path != "" {
+		resp, err := c.get(path, "registry:catalog:*")
+		if err != nil {
+			return nil, err
+		}
+		page := struct {
+			Repositories []string `json:"repositories"`
+		}{}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		next := nextLink(resp.Header.Get("Link"))
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "decode catalog")
+		}
+		for _, v := range page.Repositories {
+			if prefix == "" || strings.HasPrefix(v, prefix+"/") {
+				ret = append(ret, v)
+			}
+		}
+		path = next
+	}
+	return ret, nil
+}
+
+// tags list the tags of the repository.
+func (c *ociClient) tags(name string) ([]string, error) {
+	ret := make([]string, 0)
+	path := fmt.Sprintf("/v2/%s/tags/list", name)
+	for path != "" {
+		resp, err := c.get(path, pullScope(name))
+		if err != nil {
+			return nil, err
+		}
+		page := struct {
+			Tags []string `json:"tags"`
+		}{}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		next := nextLink(resp.Header.Get("Link"))
+		resp.Body.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "decode %s tags", name)
+		}
+		ret = append(ret, page.Tags...)
+		path = next
+	}
+	return ret, nil
+}
+
+// nextLink get the path of the next page from the Link header.
+func nextLink(link string) string {
+	if link == "" || !strings.Contains(link, `rel="next"`) {
+		return ""
+	}
+	start, end := strings.Index(link, "<"), strings.Index(link, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	u, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return ""
+	}
+	return u.RequestURI()
+}
+
+// manifest get the manifest of the tag or digest.
+func (c *ociClient) manifest(name, reference string) (*ociManifest, error) {
+	resp, err := c.get(fmt.Sprintf("/v2/%s/manifests/%s", name, reference), pullScope(name), _ociManifestMediaType)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	m := &ociManifest{}
+	if err = json.NewDecoder(resp.Body).Decode(m); err != nil {
+		return nil, errors.Wrapf(err, "decode %s:%s manifest", name, reference)
+	}
+	return m, nil
+}
+
+// blob get the blob by digest, the content is verified against the digest.
+func (c *ociClient) blob(name, digest string) ([]byte, error) {
+	resp, err := c.get(fmt.Sprintf("/v2/%s/blobs/%s", name, digest), pullScope(name))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %s@%s", name, digest)
+	}
+	if d := "sha256:" + sha256Hex(b); d != digest {
+		return nil, errors.Errorf("blob %s@%s digest mismatch: %s", name, digest, d)
+	}
+	return b, nil
+}
+
+// ociIndexLoader build the index of the charts in the registry, the chart
+// of a tag is pulled by the digest of its chart layer.
+type ociIndexLoader struct {
+	ref    *ociReference
+	charts []string
+	client *ociClient
+	lock   sync.Mutex
+	cache  map[string]*chart.Metadata // config digest map to the chart metadata.
+	provs  map[string]string          // chart layer digest map to the provenance reference.
+}
+
+func newOCIIndexLoader(u string, charts []string, cred *repository.Credentials) (*ociIndexLoader, error) {
+	ref, err := parseOCIReference(u)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newOCIClient(ref.Host, cred)
+	if err != nil {
+		return nil, err
+	}
+	return &ociIndexLoader{
+		ref:    ref,
+		charts: charts,
+		client: client,
+		cache:  make(map[string]*chart.Metadata),
+		provs:  make(map[string]string),
+	}, nil
+}
+
+func (l *ociIndexLoader) load() (*repo.IndexFile, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	names, err := l.repositories()
+	if err != nil {
+		return nil, err
+	}
+	i := repo.NewIndexFile()
+	for _, name := range names {
+		tags, err := l.client.tags(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list %s tags", name)
+		}
+		for _, tag := range tags {
+			// helm replaces the "+" of the version with "_" in the tag.
+			if _, err = semver.NewVersion(strings.ReplaceAll(tag, "_", "+")); err != nil {
+				continue
+			}
+			cv, err := l.chartVersion(name, tag)
+			if err != nil {
+				log.Warnf("skipping loading oci chart %s:%s: %s", name, tag, err)
+				continue
+			}
+			i.Entries[cv.Name] = append(i.Entries[cv.Name], cv)
+		}
+	}
+	i.SortEntries()
+	return i, nil
+}
+
+// repositories get the chart repositories in the registry.
+func (l *ociIndexLoader) repositories() ([]string, error) {
+	if len(l.charts) != 0 {
+		ret := make([]string, 0, len(l.charts))
+		for _, v := range l.charts {
+			ret = append(ret, strings.TrimPrefix(l.ref.Path+"/"+v, "/"))
+		}
+		return ret, nil
+	}
+	ret, err := l.client.catalog(l.ref.Path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "list %s catalog", l.ref)
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+func (l *ociIndexLoader) chartVersion(name, tag string) (*repo.ChartVersion, error) {
+	m, err := l.client.manifest(name, tag)
+	if err != nil {
+		return nil, err
+	}
+	if m.Config.MediaType != _ociHelmConfigMediaType {
+		return nil, errors.Errorf("not a helm chart: %s", m.Config.MediaType)
+	}
+	layer, err := m.chartLayer()
+	if err != nil {
+		return nil, err
+	}
+	md, ok := l.cache[m.Config.Digest]
+	if !ok {
+		b, err := l.client.blob(name, m.Config.Digest)
+		if err != nil {
+			return nil, errors.Wrap(err, "get chart config")
+		}
+		md = &chart.Metadata{}
+		if err = json.Unmarshal(b, md); err != nil {
+			return nil, errors.Wrap(err, "unmarshal chart config")
+		}
+		l.cache[m.Config.Digest] = md
+	}
+	if md.APIVersion == "" {
+		md.APIVersion = chart.APIVersionV1
+	}
+	cv := &repo.ChartVersion{
+		Metadata: md,
+		URLs: []string{(&ociReference{
+			Host:   l.ref.Host,
+			Path:   name,
+			Digest: layer.Digest,
+		}).String()},
+		Digest: strings.TrimPrefix(layer.Digest, "sha256:"),
+	}
+	if prov := m.provenanceLayer(); prov != nil {
+		l.provs[layer.Digest] = (&ociReference{
+			Host:   l.ref.Host,
+			Path:   name,
+			Digest: prov.Digest,
+		}).String()
+	}
+	if created, err := time.Parse(time.RFC3339, m.Annotations[_ociCreatedAnnotation]); err == nil {
+		cv.Created = created
+	}
+	if err = cv.Validate(); err != nil {
+		return nil, errors.Wrap(err, "validate chart")
+	}
+	return cv, nil
+}
+
+// provenanceURL get the reference of the chart provenance, empty if not signed.
+func (l *ociIndexLoader) provenanceURL(cv *repo.ChartVersion) string {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.provs["sha256:"+cv.Digest]
+}
+
+// pullOCIChart pull the chart archive by the reference with digest.
+func pullOCIChart(u string, cred *repository.Credentials) (*bytes.Buffer, error) {
+	ref, err := parseOCIReference(u)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Digest == "" || ref.Path == "" {
+		return nil, errors.Wrapf(ErrInvalidOCIReference, "%s has no digest", u)
+	}
+	client, err := newOCIClient(ref.Host, cred)
+	if err != nil {
+		return nil, err
+	}
+	b, err := client.blob(ref.Path, ref.Digest)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewBuffer(b), nil
+}
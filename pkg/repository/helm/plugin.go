@@ -1,10 +1,16 @@
 package helm
 
+// the names of the templates injected into the plugin chart.
+const (
+	_pluginConfigTemplate = "templates/plugin_config.yaml"
+	_pluginOAuth2Template = "templates/plugin_oauth2.yaml"
+)
+
 const (
 	PluginConfig = `apiVersion: dapr.io/v1alpha1
 kind: Configuration
 metadata:
-  name: {{ .Values.pluginID }}
+  name: {{ .Values.appID }}
   namespace: {{ .Release.Namespace | quote }}
 spec:
   accessControl:
@@ -26,14 +32,14 @@ spec:
 {{- if (ne .Values.pluginID "keel") }}
   httpPipeline:
     handlers:
-    - name: {{ .Values.pluginID }}-oauth2-client
+    - name: {{ .Values.appID }}-oauth2-client
       type: middleware.http.oauth2clientcredentials
 {{- end -}}`
 	PluginOAuth2 = `{{- if (ne .Values.pluginID "keel") -}}
 apiVersion: dapr.io/v1alpha1
 kind: Component
 metadata:
-  name: {{ .Values.pluginID }}-oauth2-client
+  name: {{ .Values.appID }}-oauth2-client
   namespace: {{ .Release.Namespace | quote }}
 spec:
   type: middleware.http.oauth2clientcredentials
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+	"golang.org/x/crypto/openpgp" // nolint
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/provenance"
+)
+
+const _provenanceSuffix = ".prov"
+
+var ErrInvalidKeyring = errors.New("invalid keyring")
+
+// ParseKeyring parse the armored or binary PGP public keyring, nil if empty.
+func ParseKeyring(keyring string) (openpgp.EntityList, error) {
+	if strings.TrimSpace(keyring) == "" {
+		return nil, nil
+	}
+	list, err := openpgp.ReadArmoredKeyRing(strings.NewReader(keyring))
+	if err != nil {
+		if list, err = openpgp.ReadKeyRing(strings.NewReader(keyring)); err != nil {
+			return nil, errors.Wrapf(ErrInvalidKeyring, "read keyring: %s", err)
+		}
+	}
+	if len(list) == 0 {
+		return nil, errors.Wrap(ErrInvalidKeyring, "no key in keyring")
+	}
+	return list, nil
+}
+
+// verifyProvenance verify the chart file against the provenance file by the keyring,
+// the provenance file is fetched by the getter and cached next to the chart file.
+func verifyProvenance(policy string, keyring openpgp.EntityList, g getter.Getter,
+	chartFile, provURL string,
+) *repository.Provenance {
+	if policy == "" {
+		policy = repository.VerifyNone
+	}
+	ret := &repository.Provenance{Policy: policy}
+	if policy == repository.VerifyNone {
+		ret.Status = repository.ProvenanceSkipped
+		return ret
+	}
+	provFile := chartFile + _provenanceSuffix
+	if _, err := os.Stat(provFile); err != nil {
+		if err = fetchProvenance(g, provFile, provURL); err != nil {
+			log.Debugf("fetch provenance of %s: %s", chartFile, err)
+			// only the chart without provenance is unsigned, the provenance
+			// failed to fetch is not trusted as absent.
+			if !errors.Is(err, ErrNotFound) {
+				ret.Status = repository.ProvenanceFailed
+				ret.Message = fmt.Sprintf("fetch provenance: %s", err)
+				return ret
+			}
+			ret.Message = fmt.Sprintf("provenance not found: %s", err)
+			ret.Status = repository.ProvenanceUnsigned
+			if policy == repository.VerifyRequired {
+				ret.Status = repository.ProvenanceFailed
+			}
+			return ret
+		}
+	}
+	if len(keyring) == 0 {
+		ret.Status = repository.ProvenanceFailed
+		ret.Message = "no keyring set in repository"
+		return ret
+	}
+	sig := &provenance.Signatory{KeyRing: keyring}
+	v, err := sig.Verify(chartFile, provFile)
+	if err != nil {
+		ret.Status = repository.ProvenanceFailed
+		ret.Message = err.Error()
+		return ret
+	}
+	ret.Status = repository.ProvenanceVerified
+	ret.FileHash = v.FileHash
+	if v.SignedBy != nil {
+		names := make([]string, 0, len(v.SignedBy.Identities))
+		for name := range v.SignedBy.Identities {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		ret.SignedBy = strings.Join(names, ",")
+		if v.SignedBy.PrimaryKey != nil {
+			ret.KeyID = v.SignedBy.PrimaryKey.KeyIdString()
+		}
+	}
+	return ret
+}
+
+func fetchProvenance(g getter.Getter, provFile, provURL string) error {
+	if provURL == "" {
+		return errors.Wrap(ErrNotFound, "no provenance url")
+	}
+	b, err := g.Get(provURL)
+	if err != nil {
+		return errors.Wrapf(err, "GET %s", provURL)
+	}
+	if !bytes.Contains(b.Bytes(), []byte("-----BEGIN PGP SIGNED MESSAGE-----")) {
+		return errors.Errorf("%s is not a provenance file", provURL)
+	}
+	if err = os.WriteFile(provFile, b.Bytes(), 0o644); err != nil {
+		return errors.Wrapf(err, "write file %s", provFile)
+	}
+	return nil
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+const (
+	_redactedValue        = "******"
+	_minSensitiveValueLen = 6
+)
+
+// sensitiveValueKeys the values whose key ends with these words are redacted.
+var sensitiveValueKeys = []string{"secret", "password", "token", "passwd", "credential"}
+
+// Release the helm release information of the installed plugin.
+type Release struct {
+	Name         string                 `json:"name"`
+	Revision     int                    `json:"revision"`
+	Status       string                 `json:"status"`
+	Chart        string                 `json:"chart"`
+	ChartVersion string                 `json:"chart_version"`
+	AppVersion   string                 `json:"app_version"`
+	Description  string                 `json:"description"`
+	Updated      time.Time              `json:"updated"`
+	Values       map[string]interface{} `json:"values,omitempty"`   // effective values with secrets redacted.
+	Manifest     string                 `json:"manifest,omitempty"` // rendered manifest.
+	Notes        string                 `json:"notes,omitempty"`    // rendered notes.
+}
+
+// Release get the release of the revision(0 is the current revision) with
+// the effective values, the rendered manifest and notes.
+func (h Installer) Release(revision int) (*Release, error) {
+	get := action.NewGet(h.helmConfig)
+	get.Version = revision
+	rel, err := get.Run(h.releaseName())
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, errors.Wrapf(ErrNotFound, "release %s revision %d", h.releaseName(), revision)
+		}
+		return nil, errors.Wrapf(err, "get release %s revision %d", h.releaseName(), revision)
+	}
+	ret := convertRelease(rel)
+	if rel.Info != nil {
+		ret.Notes = rel.Info.Notes
+	}
+	vals := chartutil.Values(rel.Config)
+	if rel.Chart != nil {
+		if vals, err = chartutil.CoalesceValues(rel.Chart, rel.Config); err != nil {
+			return nil, errors.Wrapf(err, "coalesce release %s values", h.releaseName())
+		}
+	}
+	ret.Values = redactValues(vals.AsMap())
+	// the injected plugin secret and the chart secrets are rendered into the manifest.
+	ret.Manifest = redactManifest(rel.Manifest, vals.AsMap())
+	return ret, nil
+}
+
+// History get the revisions of the release ordered by the revision desc,
+// the values, manifest and notes are not included.
+func (h Installer) History() ([]*Release, error) {
+	rels, err := action.NewHistory(h.helmConfig).Run(h.releaseName())
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, errors.Wrapf(ErrNotFound, "release %s", h.releaseName())
+		}
+		return nil, errors.Wrapf(err, "get release %s history", h.releaseName())
+	}
+	sort.Slice(rels, func(i, j int) bool {
+		return rels[i].Version > rels[j].Version
+	})
+	ret := make([]*Release, 0, len(rels))
+	for _, v := range rels {
+		ret = append(ret, convertRelease(v))
+	}
+	return ret, nil
+}
+
+// UserValues get the values supplied by the user of the current release,
+// the secrets are included.
+func (h Installer) UserValues() (map[string]interface{}, error) {
+	rel, err := action.NewGet(h.helmConfig).Run(h.releaseName())
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, errors.Wrapf(ErrNotFound, "release %s", h.releaseName())
+		}
+		return nil, errors.Wrapf(err, "get release %s", h.releaseName())
+	}
+	return copyValues(rel.Config), nil
+}
+
+// Values get the effective values of the current release, the user values coalesced
+// with the chart values, the secrets are included.
+func (h Installer) Values() (map[string]interface{}, error) {
+	rel, err := action.NewGet(h.helmConfig).Run(h.releaseName())
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return nil, errors.Wrapf(ErrNotFound, "release %s", h.releaseName())
+		}
+		return nil, errors.Wrapf(err, "get release %s", h.releaseName())
+	}
+	if rel.Chart == nil {
+		return copyValues(rel.Config), nil
+	}
+	vals, err := chartutil.CoalesceValues(rel.Chart, rel.Config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "coalesce release %s values", h.releaseName())
+	}
+	return vals.AsMap(), nil
+}
+
+// ListPluginReleases list the releases of the plugin charts in the namespace in all states,
+// the releases of the charts which are not tkeel plugins are excluded.
+func ListPluginReleases(namespace string, driver Driver) ([]*Release, error) {
+	config, err := initActionConfig(namespace, driver)
+	if err != nil {
+		return nil, errors.Wrap(err, "init helm action configuration")
+	}
+	list := action.NewList(config)
+	list.All = true
+	list.SetStateMask()
+	rels, err := list.Run()
+	if err != nil {
+		return nil, errors.Wrap(err, "run helm list action")
+	}
+	ret := make([]*Release, 0, len(rels))
+	for _, v := range rels {
+		if v.Chart == nil || v.Chart.Metadata == nil {
+			continue
+		}
+		if _, ok := v.Chart.Metadata.Annotations[tKeelPluginEnableKey]; !ok {
+			continue
+		}
+		ret = append(ret, convertRelease(v))
+	}
+	return ret, nil
+}
+
+func convertRelease(rel *release.Release) *Release {
+	ret := &Release{Name: rel.Name, Revision: rel.Version}
+	if rel.Info != nil {
+		ret.Status = rel.Info.Status.String()
+		ret.Description = rel.Info.Description
+		ret.Updated = rel.Info.LastDeployed.Time
+	}
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		ret.Chart = rel.Chart.Metadata.Name
+		ret.ChartVersion = rel.Chart.Metadata.Version
+		ret.AppVersion = rel.Chart.Metadata.AppVersion
+	}
+	return ret
+}
+
+// redactValues return a copy of values with the secrets hidden.
+func redactValues(values map[string]interface{}) map[string]interface{} {
+	ret := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		ret[k] = redactValue(k, v)
+	}
+	return ret
+}
+
+func redactValue(key string, v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return redactValues(val)
+	case []interface{}:
+		ret := make([]interface{}, 0, len(val))
+		for _, item := range val {
+			ret = append(ret, redactValue(key, item))
+		}
+		return ret
+	default:
+		if isSensitiveValueKey(key) && v != nil {
+			return _redactedValue
+		}
+		return v
+	}
+}
+
+// sensitiveValues get the string values whose key is sensitive, the values shorter than
+// _minSensitiveValueLen are ignored since they can not be replaced in the manifest safely.
+func sensitiveValues(key string, v interface{}, ret []string) []string {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			ret = sensitiveValues(k, item, ret)
+		}
+	case []interface{}:
+		for _, item := range val {
+			ret = sensitiveValues(key, item, ret)
+		}
+	case string:
+		if len(val) >= _minSensitiveValueLen && isSensitiveValueKey(key) {
+			ret = append(ret, val)
+		}
+	}
+	return ret
+}
+
+var _manifestSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// redactManifest hide the secrets in the rendered manifest: the data of the Secrets, the sensitive
+// fields and name/value pairs(e.g. the env and the dapr component metadata) of the resources,
+// and the sensitive values wherever they are rendered.
+func redactManifest(manifest string, values map[string]interface{}) string {
+	docs := _manifestSeparator.Split(manifest, -1)
+	for i, doc := range docs {
+		docs[i] = redactManifestDoc(doc)
+	}
+	ret := strings.Join(docs, "---")
+	secrets := sensitiveValues("", values, nil)
+	// replace the longer first, a secret may contain another one.
+	sort.Slice(secrets, func(i, j int) bool {
+		return len(secrets[i]) > len(secrets[j])
+	})
+	for _, v := range secrets {
+		ret = strings.ReplaceAll(ret, v, _redactedValue)
+		ret = strings.ReplaceAll(ret, base64.StdEncoding.EncodeToString([]byte(v)), _redactedValue)
+	}
+	return ret
+}
+
+// redactManifestDoc redact the resource of the manifest document, the document which can not be
+// parsed is returned as is.
+func redactManifestDoc(doc string) string {
+	node := &yaml.Node{}
+	if err := yaml.Unmarshal([]byte(doc), node); err != nil || len(node.Content) == 0 {
+		return doc
+	}
+	res := node.Content[0]
+	if res.Kind != yaml.MappingNode {
+		return doc
+	}
+	changed := false
+	if kind := mappingValue(res, "kind"); kind != nil && kind.Value == "Secret" {
+		for _, field := range []string{"data", "stringData"} {
+			if data := mappingValue(res, field); data != nil {
+				changed = redactNode(data, true) || changed
+			}
+		}
+	}
+	if !(redactNode(res, false) || changed) {
+		return doc
+	}
+	var b bytes.Buffer
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return doc
+	}
+	return "\n" + b.String()
+}
+
+// redactNode redact the scalars of the node if sensitive, or the fields with the sensitive
+// keys and the values of the name/value pairs with the sensitive name. Return whether changed.
+func redactNode(n *yaml.Node, sensitive bool) bool {
+	changed := false
+	switch n.Kind {
+	case yaml.ScalarNode:
+		if sensitive && n.Value != "" && n.Value != _redactedValue {
+			n.Value, n.Tag, n.Style = _redactedValue, "!!str", 0
+			return true
+		}
+	case yaml.MappingNode:
+		if name := mappingValue(n, "name"); name != nil && isSensitiveValueKey(name.Value) {
+			if v := mappingValue(n, "value"); v != nil {
+				changed = redactNode(v, true) || changed
+			}
+		}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			changed = redactNode(n.Content[i+1], sensitive || isSensitiveValueKey(n.Content[i].Value)) || changed
+		}
+	case yaml.SequenceNode, yaml.DocumentNode:
+		for _, v := range n.Content {
+			changed = redactNode(v, sensitive) || changed
+		}
+	}
+	return changed
+}
+
+// mappingValue get the value node of the key in the mapping node.
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// StripSensitiveValues return a copy of values without the secrets.
+func StripSensitiveValues(values map[string]interface{}) map[string]interface{} {
+	ret := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if isSensitiveValueKey(k) {
+			continue
+		}
+		if m, ok := v.(map[string]interface{}); ok {
+			v = StripSensitiveValues(m)
+		}
+		ret[k] = v
+	}
+	return ret
+}
+
+func isSensitiveValueKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, v := range sensitiveValueKeys {
+		if strings.HasSuffix(key, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -19,6 +19,8 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -28,11 +30,14 @@ import (
 const (
 	ConfigurationKey       = "configuration"
 	ConfigurationSchemaKey = "configuration_schema"
+	TkeelVersionKey        = "tkeel.io/version"
+	DependencesKey         = "tkeel.io/dependences"
 )
 
 var (
-	ErrInvalidAnnotations = errors.New("invalid annotations")
-	ErrInvalidOptions     = errors.New("invalid options")
+	ErrInvalidAnnotations     = errors.New("invalid annotations")
+	ErrInvalidOptions         = errors.New("invalid options")
+	ErrProvenanceVerification = errors.New("provenance verification failed")
 )
 
 // Annotations is a json object. Any data you want it attach on.
@@ -70,6 +75,7 @@ type InstallerBrief struct {
 	CreateTimestamp int64             `json:"create_timestamp"`
 	Icon            string            `json:"icon"`
 	VersionList     []*pb.VersionList `json:"version_list"`
+	Provenance      *Provenance       `json:"provenance,omitempty"`
 }
 
 func (ib *InstallerBrief) String() string {
@@ -80,10 +86,76 @@ func (ib *InstallerBrief) String() string {
 	return string(b)
 }
 
+// Dependence plugin dependence declared in the installer annotations.
+type Dependence struct {
+	ID      string `json:"id"`                // dependence plugin id(also the installer name).
+	Repo    string `json:"repo,omitempty"`    // repository name, empty means search all repositories.
+	Version string `json:"version,omitempty"` // installer version, empty means the latest.
+}
+
+func (d *Dependence) String() string {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+	return string(b)
+}
+
+// ParseDependences parse the dependences annotation of the installer.
+// The annotation is either a json array of Dependence or
+// a comma separated list of "[repo/]id[@version]".
+func ParseDependences(annotations Annotations) ([]*Dependence, error) {
+	ret := make([]*Dependence, 0)
+	deps, ok := annotations[DependencesKey].(string)
+	if !ok {
+		return ret, nil
+	}
+	if deps = strings.TrimSpace(deps); strings.HasPrefix(deps, "[") {
+		if err := json.Unmarshal([]byte(deps), &ret); err != nil {
+			return nil, errors.Wrapf(ErrInvalidAnnotations, "unmarshal dependences(%s): %s", deps, err)
+		}
+		for _, v := range ret {
+			if v == nil || v.ID == "" {
+				return nil, errors.Wrapf(ErrInvalidAnnotations, "dependences(%s) id is empty", deps)
+			}
+		}
+		return ret, nil
+	}
+	for _, v := range strings.Split(deps, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+		d := &Dependence{}
+		if i := strings.LastIndex(v, "@"); i >= 0 {
+			v, d.Version = v[:i], v[i+1:]
+		}
+		if i := strings.Index(v, "/"); i >= 0 {
+			d.Repo, v = v[:i], v[i+1:]
+		}
+		if d.ID = v; d.ID == "" {
+			return nil, errors.Wrapf(ErrInvalidAnnotations, "dependences(%s) id is empty", deps)
+		}
+		ret = append(ret, d)
+	}
+	return ret, nil
+}
+
+// DryRunResult the result of rendering installer without touching the cluster.
+type DryRunResult struct {
+	Manifest string                 `json:"manifest"`
+	Values   map[string]interface{} `json:"values"`
+	Notes    string                 `json:"notes"`
+}
+
 // Installer plugin installer.
 type Installer interface {
 	// SetPluginID set plugin id after installing to tKeel.
 	SetPluginID(pluginID string)
+	// SetAppID set the release name and the app id of the plugin workload, the plugin id is used if not set.
+	SetAppID(appID string)
+	// SetSecret set the plugin secret injected into the plugin config,
+	// the secret of the installed plugin is kept if not set.
+	SetSecret(secret string)
 	// Annotations get annotations. custom data. Return a copy of the Annotations.
 	Annotations() Annotations
 	// Options get installer options.
@@ -94,8 +166,17 @@ type Installer interface {
 	Install(opts ...*Option) error
 	// Upgrade plugin.
 	Upgrade(opts ...*Option) error
+	// DryRun render the install(or upgrade) manifest without applying it.
+	DryRun(isUpgrade bool, opts ...*Option) (*DryRunResult, error)
 	// Uninstall plugin.
 	Uninstall() error
+	// Revision get the current release revision of the installed plugin.
+	Revision() (int, error)
+	// Reconfigure upgrade the installed plugin with the options merged into the current values,
+	// the installed chart is reused.
+	Reconfigure(opts ...*Option) error
+	// Rollback the installed plugin to the revision(0 is the previous revision), return the rolled back installer brief.
+	Rollback(revision int) (*InstallerBrief, error)
 	// Brief get installer brief information.
 	Brief() *InstallerBrief
 }
@@ -105,6 +186,82 @@ type Info struct {
 	Name        string      `json:"name"`                  // repository name.
 	URL         string      `json:"url"`                   // repository url.
 	Annotations Annotations `json:"annotations,omitempty"` // repository annotations.
+	// Credentials never marshaled with the info, the store keeps them encrypted.
+	Credentials *Credentials `json:"-"`
+	Verify      string       `json:"verify,omitempty"`  // provenance verification policy, none if empty.
+	Keyring     string       `json:"keyring,omitempty"` // armored PGP public keyring to verify the provenance.
+	// RefreshInterval the index refresh interval such as "30m", the hub default if empty.
+	RefreshInterval string `json:"refresh_interval,omitempty"`
+	// LoadError the error of loading the info from the store, e.g. the credentials can not be opened.
+	LoadError string `json:"-"`
+}
+
+// SyncStatus the index sync status of the repository.
+type SyncStatus struct {
+	LastSyncTimestamp  int64  `json:"last_sync_timestamp"`            // last successful sync.
+	LastError          string `json:"last_error,omitempty"`           // error of the last sync, empty if succeeded.
+	LastErrorTimestamp int64  `json:"last_error_timestamp,omitempty"` // last failed sync.
+	ChartCount         int    `json:"chart_count"`                    // number of the plugin charts.
+	IndexGenerated     int64  `json:"index_generated"`                // generation time of the index.
+	RefreshInterval    string `json:"refresh_interval"`               // effective refresh interval.
+}
+
+// The provenance verification policies of the repository.
+const (
+	VerifyNone      = "none"       // never verify the provenance.
+	VerifyIfPresent = "if-present" // verify the provenance if the chart is signed.
+	VerifyRequired  = "required"   // the chart must be signed and verified.
+)
+
+// The provenance verification status of the installer.
+const (
+	ProvenanceSkipped  = "skipped"
+	ProvenanceUnsigned = "unsigned"
+	ProvenanceVerified = "verified"
+	ProvenanceFailed   = "failed"
+)
+
+// ValidVerifyPolicy whether the provenance verification policy is valid, empty means none.
+func ValidVerifyPolicy(policy string) bool {
+	switch policy {
+	case "", VerifyNone, VerifyIfPresent, VerifyRequired:
+		return true
+	}
+	return false
+}
+
+// Provenance the provenance verification result of the installer chart.
+type Provenance struct {
+	Policy   string `json:"policy"`              // verification policy of the repository.
+	Status   string `json:"status"`              // verification status.
+	SignedBy string `json:"signed_by,omitempty"` // identities of the signing key.
+	KeyID    string `json:"key_id,omitempty"`    // id of the signing key.
+	FileHash string `json:"file_hash,omitempty"` // chart file hash in the provenance.
+	Message  string `json:"message,omitempty"`   // reason of the unsigned or failed status.
+}
+
+// Err get the error if the installer is refused by the verification.
+func (p *Provenance) Err() error {
+	if p == nil || p.Status != ProvenanceFailed {
+		return nil
+	}
+	return errors.Wrap(ErrProvenanceVerification, p.Message)
+}
+
+// Credentials the auth and tls settings to access the private repository.
+type Credentials struct {
+	Username              string `json:"username,omitempty"`                 // basic auth username.
+	Password              string `json:"password,omitempty"`                 // basic auth password.
+	Token                 string `json:"token,omitempty"`                    // bearer token.
+	CertData              string `json:"cert_data,omitempty"`                // PEM encoded client certificate.
+	KeyData               string `json:"key_data,omitempty"`                 // PEM encoded client private key.
+	CAData                string `json:"ca_data,omitempty"`                  // PEM encoded CA bundle.
+	InsecureSkipTLSVerify bool   `json:"insecure_skip_tls_verify,omitempty"` // skip the server certificate verification.
+}
+
+// IsZero whether no auth or tls setting is set.
+func (c *Credentials) IsZero() bool {
+	return c == nil || *c == Credentials{}
 }
 
 func NewInfo(name, url string, annotations Annotations) *Info {
@@ -128,6 +285,8 @@ func (i *Info) String() string {
 type InfoOperator interface {
 	// Create plugin repo.
 	Create(context.Context, *Info) error
+	// Update plugin repo info with the same name.
+	Update(context.Context, *Info) error
 	// Get plugin repo info with the repo name.
 	Get(ctx context.Context, repoName string) (*Info, error)
 	// Delete plugin repo with the repo name.
@@ -136,6 +295,10 @@ type InfoOperator interface {
 	List(ctx context.Context) ([]*Info, error)
 	// Watch plugin repo map change. parameter is the changed data.
 	Watch(ctx context.Context, interval string, callback func(news, updates, deletes []*Info) error) error
+	// SaveStatus save the sync status of the plugin repo.
+	SaveStatus(ctx context.Context, repoName string, status *SyncStatus) error
+	// ListStatus get the saved sync status of the plugin repos by the repo names.
+	ListStatus(ctx context.Context, repoNames ...string) (map[string]*SyncStatus, error)
 }
 
 // Repository plugin installer repository.
@@ -152,6 +315,8 @@ type Repository interface {
 	Update() (bool, error)
 	// Len get all installer number.
 	Len() int
+	// Generated get the generation time of the repository index.
+	Generated() time.Time
 	// Close this repository.
 	Close() error
 }
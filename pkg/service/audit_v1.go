@@ -0,0 +1,205 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	s_model "github.com/tkeel-io/security/model"
+	pb "github.com/tkeel-io/tkeel/api/audit/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/audit"
+	"github.com/tkeel-io/tkeel/pkg/repository/helm"
+	"github.com/tkeel-io/tkeel/pkg/util"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+)
+
+const _auditRedacted = "******"
+
+var _auditOp audit.Operator
+
+// InitAuditRecorder set the audit log store used by services to record operations.
+func InitAuditRecorder(op audit.Operator) {
+	_auditOp = op
+}
+
+// recordAudit record the operation of the request user, empty tenantID means the tenant of the user.
+// Failing to record is logged and does not affect the operation.
+func recordAudit(ctx context.Context, action, tenantID, target string, params proto.Message, err error) {
+	if _auditOp == nil {
+		return
+	}
+	actor, actorTenant := "", ""
+	if u, uErr := util.GetUser(ctx); uErr != nil {
+		log.Debugf("audit %s(%s) get user: %s", action, target, uErr)
+	} else {
+		actor, actorTenant = u.User, u.Tenant
+	}
+	if tenantID == "" {
+		tenantID = actorTenant
+	}
+	paramsStr := ""
+	if params != nil {
+		b, mErr := marshalAuditParams(params)
+		if mErr != nil {
+			log.Errorf("error audit %s(%s) marshal params: %s", action, target, mErr)
+		}
+		paramsStr = string(b)
+	}
+	a := model.NewAuditLog(actor, actorTenant, tenantID, action, target, paramsStr, err)
+	if cErr := _auditOp.Create(context.TODO(), a); cErr != nil {
+		log.Errorf("error record audit log(%s): %s", a, cErr)
+	}
+}
+
+// _auditConfigurationKeys the bytes params carrying the plugin configuration,
+// they are decoded so that the sensitive values are removed.
+var _auditConfigurationKeys = map[string]bool{"configuration": true, "values": true}
+
+// marshalAuditParams marshal the params without the sensitive values such as the secrets and passwords.
+func marshalAuditParams(params proto.Message) ([]byte, error) {
+	b, err := protojson.Marshal(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal params")
+	}
+	var v interface{}
+	if err = json.Unmarshal(b, &v); err != nil {
+		return nil, errors.Wrap(err, "unmarshal params")
+	}
+	if b, err = json.Marshal(stripAuditParam("", v)); err != nil {
+		return nil, errors.Wrap(err, "marshal stripped params")
+	}
+	return b, nil
+}
+
+func stripAuditParam(key string, v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		ret := helm.StripSensitiveValues(val)
+		for k, v := range ret {
+			ret[k] = stripAuditParam(k, v)
+		}
+		return ret
+	case []interface{}:
+		ret := make([]interface{}, 0, len(val))
+		for _, v := range val {
+			ret = append(ret, stripAuditParam(key, v))
+		}
+		return ret
+	case string:
+		if !_auditConfigurationKeys[key] {
+			return val
+		}
+		// the configuration(json or yaml) is recorded decoded, or hidden if it can not be decoded.
+		b, err := base64.StdEncoding.DecodeString(val)
+		if err != nil {
+			return _auditRedacted
+		}
+		var conf interface{}
+		if err = yaml.Unmarshal(b, &conf); err != nil {
+			return _auditRedacted
+		}
+		if _, ok := conf.(map[string]interface{}); !ok {
+			return _auditRedacted
+		}
+		return stripAuditParam("", conf)
+	default:
+		return v
+	}
+}
+
+type AuditService struct {
+	pb.UnimplementedAuditServer
+	db      *gorm.DB
+	auditOp audit.Operator
+}
+
+func NewAuditService(db *gorm.DB, auditOp audit.Operator) *AuditService {
+	return &AuditService{
+		db:      db,
+		auditOp: auditOp,
+	}
+}
+
+func (s *AuditService) ListAuditLogs(ctx context.Context, req *pb.ListAuditLogsRequest) (*pb.ListAuditLogsResponse, error) {
+	u, err := util.GetUser(ctx)
+	if err != nil {
+		log.Errorf("error get user: %s", err)
+		return nil, pb.AuditErrInvalidArgument()
+	}
+	if req.PageNum < 0 || req.PageSize < 0 {
+		return nil, pb.AuditErrInvalidArgument()
+	}
+	q := &audit.Query{
+		Tenant:    req.TenantId,
+		Actor:     req.Actor,
+		Action:    req.Action,
+		Target:    req.Target,
+		Result:    req.Result,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		PageNum:   int(req.PageNum),
+		PageSize:  int(req.PageSize),
+	}
+	// tenant admin only query the audit logs of the tenant.
+	if u.Tenant != model.TKeelTenant || u.User != model.TKeelUser {
+		ok, err := s.isTenantAdmin(u)
+		if err != nil {
+			log.Errorf("error check user(%s/%s) role(%s): %s", u.Tenant, u.User, u.Role, err)
+			return nil, pb.AuditErrInternalError()
+		}
+		if !ok || (req.TenantId != "" && req.TenantId != u.Tenant) {
+			log.Errorf("error user(%s/%s) query tenant(%s) audit logs: permission denied", u.Tenant, u.User, req.TenantId)
+			return nil, pb.AuditErrPermissionDenied()
+		}
+		q.Tenant = u.Tenant
+	}
+	logs, total, err := s.auditOp.List(ctx, q)
+	if err != nil {
+		log.Errorf("error list audit logs(%v): %s", q, err)
+		return nil, pb.AuditErrInternalError()
+	}
+	ret := &pb.ListAuditLogsResponse{
+		Total:    total,
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+		Logs:     make([]*pb.AuditLogObject, 0, len(logs)),
+	}
+	for _, v := range logs {
+		ret.Logs = append(ret.Logs, util.ConvertModel2AuditLogObjectPb(v))
+	}
+	return ret, nil
+}
+
+func (s *AuditService) isTenantAdmin(u *model.User) (bool, error) {
+	if u.Role == "" {
+		return false, nil
+	}
+	daoRole := &s_model.Role{}
+	count, roles, err := daoRole.List(s.db,
+		map[string]interface{}{"id": u.Role, "tenant_id": u.Tenant}, nil, "")
+	if err != nil {
+		return false, err
+	}
+	return count == 1 && len(roles) == 1 && roles[0].Name == model.TkeelTenantAdminRole, nil
+}
@@ -50,6 +50,21 @@ func (s *session) String() string {
 type endpoint struct {
 	ID           string
 	TKeelDepened string
+	Maintenance  *model.PluginMaintenance
+	AppID        string             // dapr app id told by the authentication, empty means the id.
+	route        *model.PluginRoute // route of the plugin, nil for the tkeel components.
+}
+
+// appID get the dapr app id serving the requests of the tenant.
+func (e *endpoint) appID(tenantID string) string {
+	switch {
+	case e.route != nil:
+		return e.route.RouteAppID(tenantID)
+	case e.AppID != "":
+		return e.AppID
+	default:
+		return e.ID
+	}
 }
 
 func (e *endpoint) String() string {
@@ -195,6 +210,12 @@ func convertSession2PB(s *session) *pb.AuthenticateResponse {
 	}
 	if s.Dst != nil {
 		ret.Destination = s.Dst.ID
+		ret.AppId = s.Dst.appID(ret.TenantId)
+		if s.Dst.Maintenance != nil {
+			ret.Maintenance = true
+			ret.MaintenanceMessage = s.Dst.Maintenance.Message
+			ret.MaintenanceEndTimestamp = s.Dst.Maintenance.EndTimestamp
+		}
 	}
 	ret.Method = s.RequestMethod
 	return ret
@@ -256,6 +277,8 @@ func (s *AuthenticationService) getPluginDstAndMethod(ctx context.Context, sess
 			return fmt.Errorf("errors get plugin(%s) route: %w", sess.Src.ID, err)
 		}
 		sess.Dst.TKeelDepened = upstreamRoute.TkeelVersion
+		sess.Dst.Maintenance = upstreamRoute.Maintenance
+		sess.Dst.route = upstreamRoute
 	}
 	sess.Dst.ID = pluginID
 	sess.RequestMethod = pluginMethod
@@ -289,6 +312,8 @@ func (s *AuthenticationService) getAddonsDstAndMethod(ctx context.Context, sess
 	sess.Dst.ID = dstID
 	sess.RequestMethod = dstMethod
 	sess.Dst.TKeelDepened = dstRoute.TkeelVersion
+	sess.Dst.Maintenance = dstRoute.Maintenance
+	sess.Dst.route = dstRoute
 	return nil
 }
 
@@ -19,6 +19,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	t_errors "github.com/tkeel-io/kit/errors"
@@ -36,11 +37,16 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const (
 	_securityComponent = "rudder"
 	_authenticate      = "/v1/authenticate"
+
+	_maintenanceCode = "io.tkeel.PLUGIN_MAINTENANCE"
+	// _maintenanceRetryAfter the Retry-After seconds when the maintenance end time is unknown or passed.
+	_maintenanceRetryAfter = 60
 )
 
 var _contextSessionKey = struct{}{}
@@ -118,6 +124,11 @@ func (s *KeelServiceV1) ProxyPlugin(
 		writeResult(resp, http.StatusInternalServerError, "internal error")
 		return errors.New("error invalid dst plugin")
 	}
+	if sess.Dst.Maintenance != nil && !maintenanceBypass(req, sess.User) {
+		metrics.CollectorTKApiRequest.WithLabelValues(sess.User.Tenant, sess.Dst.ID, fmt.Sprintf("%d", http.StatusServiceUnavailable)).Inc()
+		writeMaintenanceResult(resp, sess.Dst.ID, sess.Dst.Maintenance)
+		return nil
+	}
 	bodyByte := make([]byte, 0)
 	if req.ContentLength != 0 {
 		b, err1 := io.ReadAll(req.Body)
@@ -130,7 +141,7 @@ func (s *KeelServiceV1) ProxyPlugin(
 	}
 
 	dstResp, err := s.httpDaprClient.Call(req.Context(), &dapr.AppRequest{
-		ID:         sess.Dst.ID,
+		ID:         sess.Dst.appID(sess.User.Tenant),
 		Method:     sess.RequestMethod,
 		Verb:       req.Method,
 		Header:     req.Header,
@@ -166,7 +177,14 @@ func (s *KeelServiceV1) authenticate(ctx context.Context, req *http.Request) (*s
 		return nil, http.StatusInternalServerError, errors.Wrapf(err, "unmarshal resp(%s)", out)
 	}
 	sess.Dst = &endpoint{
-		ID: resp.Destination,
+		ID:    resp.Destination,
+		AppID: resp.AppId,
+	}
+	if resp.Maintenance {
+		sess.Dst.Maintenance = &model.PluginMaintenance{
+			Message:      resp.MaintenanceMessage,
+			EndTimestamp: resp.MaintenanceEndTimestamp,
+		}
 	}
 	u := &model.User{
 		User:   resp.UserId,
@@ -210,6 +228,56 @@ func (s *KeelServiceV1) callAuthorization(ctx context.Context, req *http.Request
 	return res, http.StatusOK, nil
 }
 
+// maintenanceBypass whether the request is proxied to the plugin in maintenance,
+// only the platform admin can bypass the maintenance for verification.
+func maintenanceBypass(req *http.Request, u *model.User) bool {
+	if req.Header.Get(model.XtKeelMaintenanceBypassHeader) == "" || u == nil {
+		return false
+	}
+	return u.Tenant == model.TKeelTenant && u.User == model.TKeelUser
+}
+
+// writeMaintenanceResult answer the request to the plugin in maintenance with 503,
+// the Retry-After is the seconds to the expected end of the maintenance.
+func writeMaintenanceResult(resp http.ResponseWriter, pluginID string, m *model.PluginMaintenance) {
+	retryAfter := int64(_maintenanceRetryAfter)
+	if d := m.EndTimestamp - time.Now().Unix(); m.EndTimestamp != 0 && d > 0 {
+		retryAfter = d
+	}
+	msg := m.Message
+	if msg == "" {
+		msg = fmt.Sprintf("plugin(%s) is under maintenance", pluginID)
+	}
+	out := &result.Http{
+		Code: _maintenanceCode,
+		Msg:  msg,
+	}
+	data, err := structpb.NewStruct(map[string]interface{}{
+		"plugin_id":     pluginID,
+		"message":       m.Message,
+		"end_timestamp": m.EndTimestamp,
+		"retry_after":   retryAfter,
+	})
+	if err == nil {
+		out.Data, err = anypb.New(data)
+	}
+	if err != nil {
+		log.Errorf("error maintenance result data: %s", err)
+	}
+	outB, err := protojson.MarshalOptions{
+		UseProtoNames:   true,
+		EmitUnpopulated: true,
+	}.Marshal(out)
+	if err != nil {
+		log.Errorf("error protojson marshal: %s", err)
+		outB = []byte{}
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+	resp.WriteHeader(http.StatusServiceUnavailable)
+	resp.Write(outB)
+}
+
 func withSession(ctx context.Context, sess *session) context.Context {
 	return context.WithValue(ctx, _contextSessionKey, sess)
 }
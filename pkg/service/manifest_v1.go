@@ -0,0 +1,505 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	pb "github.com/tkeel-io/tkeel/api/manifest/v1"
+	plugin_v1 "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/config"
+	"github.com/tkeel-io/tkeel/pkg/hub"
+	"github.com/tkeel-io/tkeel/pkg/manifest"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/kv"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+	"github.com/tkeel-io/tkeel/pkg/repository/helm"
+	"github.com/tkeel-io/tkeel/pkg/util"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ManifestChangeSucceeded = "succeeded"
+	ManifestChangeFailed    = "failed"
+	ManifestChangeSkipped   = "skipped"
+)
+
+// appliedManifest the last applied manifest reconciled by the leader.
+type appliedManifest struct {
+	Manifest       *manifest.Manifest `json:"manifest"`
+	Prune          bool               `json:"prune"`
+	ApplyTimestamp int64              `json:"apply_timestamp"`
+}
+
+// ManifestService apply the declarative platform manifest through the plugin, repo and config code paths.
+type ManifestService struct {
+	pb.UnimplementedManifestServer
+	sync.Mutex
+	namespace string
+	interval  time.Duration
+	kvOp      kv.Operator
+	pluginOp  plugin.Operator
+	pluginSrv *PluginServiceV1
+}
+
+func NewManifestService(conf *config.TkeelConf, kvOp kv.Operator, pOp plugin.Operator,
+	pluginSrv *PluginServiceV1,
+) (*ManifestService, error) {
+	var interval time.Duration
+	if conf.ManifestReconcileInterval != "" {
+		d, err := time.ParseDuration(conf.ManifestReconcileInterval)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse manifest reconcile interval(%s)", conf.ManifestReconcileInterval)
+		}
+		interval = d
+	}
+	return &ManifestService{
+		namespace: conf.Namespace,
+		interval:  interval,
+		kvOp:      kvOp,
+		pluginOp:  pOp,
+		pluginSrv: pluginSrv,
+	}, nil
+}
+
+func (s *ManifestService) ApplyManifest(ctx context.Context,
+	req *pb.ApplyManifestRequest,
+) (_ *pb.ApplyManifestResponse, err error) {
+	if err = checkAdminPortal(ctx); err != nil {
+		return nil, err
+	}
+	desired, err := manifest.Parse(req.Manifest)
+	if err != nil {
+		log.Errorf("error parse manifest: %s", err)
+		return nil, pb.ManifestErrInvalidManifest().WithMessage(err.Error())
+	}
+	if !req.DryRun {
+		defer func() {
+			// the manifest is not recorded, the configuration may contain secrets.
+			recordAudit(ctx, model.AuditActionApplyManifest, model.TKeelTenant, manifest.Kind,
+				&pb.ApplyManifestRequest{Prune: req.Prune}, err)
+		}()
+	}
+	s.Lock()
+	defer s.Unlock()
+	current, err := s.currentManifest(ctx, false)
+	if err != nil {
+		log.Errorf("error get current manifest: %s", err)
+		return nil, pb.ManifestErrInternalError()
+	}
+	changes := manifest.Diff(desired, current, req.Prune)
+	if req.DryRun {
+		return &pb.ApplyManifestResponse{Changes: convertManifestChanges(changes, nil)}, nil
+	}
+	if err = s.saveApplied(ctx, desired, req.Prune); err != nil {
+		log.Errorf("error save applied manifest: %s", err)
+		return nil, pb.ManifestErrInternalError()
+	}
+	results := s.applyChanges(ctx, changes)
+	ret := &pb.ApplyManifestResponse{Changes: convertManifestChanges(changes, results)}
+	for _, v := range ret.Changes {
+		if v.Status == ManifestChangeFailed {
+			ret.Failed++
+		}
+	}
+	log.Debugf("apply manifest %d changes, %d failed", len(ret.Changes), ret.Failed)
+	return ret, nil
+}
+
+func (s *ManifestService) DiffManifest(ctx context.Context,
+	req *pb.DiffManifestRequest,
+) (*pb.DiffManifestResponse, error) {
+	if err := checkAdminPortal(ctx); err != nil {
+		return nil, err
+	}
+	desired, err := manifest.Parse(req.Manifest)
+	if err != nil {
+		log.Errorf("error parse manifest: %s", err)
+		return nil, pb.ManifestErrInvalidManifest().WithMessage(err.Error())
+	}
+	current, err := s.currentManifest(ctx, false)
+	if err != nil {
+		log.Errorf("error get current manifest: %s", err)
+		return nil, pb.ManifestErrInternalError()
+	}
+	return &pb.DiffManifestResponse{
+		Changes: convertManifestChanges(manifest.Diff(desired, current, req.Prune), nil),
+	}, nil
+}
+
+func (s *ManifestService) ExportManifest(ctx context.Context,
+	req *emptypb.Empty,
+) (*pb.ExportManifestResponse, error) {
+	if err := checkAdminPortal(ctx); err != nil {
+		return nil, err
+	}
+	current, err := s.currentManifest(ctx, true)
+	if err != nil {
+		log.Errorf("error get current manifest: %s", err)
+		return nil, pb.ManifestErrInternalError()
+	}
+	b, err := current.Marshal()
+	if err != nil {
+		log.Errorf("error marshal current manifest: %s", err)
+		return nil, pb.ManifestErrInternalError()
+	}
+	return &pb.ExportManifestResponse{Manifest: b}, nil
+}
+
+// Run re-apply the last applied manifest at the reconcile interval until the ctx done,
+// the drift from the manifest is reconciled and the pending changes are retried.
+func (s *ManifestService) Run(ctx context.Context) {
+	if s.interval <= 0 {
+		log.Info("platform manifest reconcile disabled")
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("platform manifest reconcile stopped")
+				return
+			case <-ticker.C:
+				s.reconcile(ctx)
+			}
+		}
+	}()
+}
+
+func (s *ManifestService) reconcile(ctx context.Context) {
+	s.Lock()
+	defer s.Unlock()
+	applied, err := s.getApplied(ctx)
+	if err != nil {
+		log.Errorf("error get applied manifest: %s", err)
+		return
+	}
+	if applied == nil {
+		return
+	}
+	current, err := s.currentManifest(ctx, false)
+	if err != nil {
+		log.Errorf("error get current manifest: %s", err)
+		return
+	}
+	changes := manifest.Diff(applied.Manifest, current, applied.Prune)
+	if len(changes) == 0 {
+		return
+	}
+	log.Infof("reconcile platform manifest %d changes", len(changes))
+	results := s.applyChanges(ctx, changes)
+	for i, v := range changes {
+		if results[i] != nil && results[i].status == ManifestChangeFailed {
+			log.Warnf("reconcile manifest change(%s) failed: %s", v, results[i].message)
+		}
+	}
+}
+
+type changeResult struct {
+	status  string
+	message string
+}
+
+// applyChanges apply the changes in order, the tenant changes of the plugin whose install
+// or upgrade is not registered yet are skipped and retried by the next reconciliation.
+func (s *ManifestService) applyChanges(ctx context.Context, changes []*manifest.Change) []*changeResult {
+	ret := make([]*changeResult, len(changes))
+	// plugin id map to the reason its tenant changes are skipped.
+	pending := make(map[string]string)
+	for i, v := range changes {
+		if v.Kind == manifest.KindTenant {
+			if reason, ok := pending[v.Plugin.ID]; ok {
+				ret[i] = &changeResult{status: ManifestChangeSkipped, message: reason}
+				continue
+			}
+		}
+		err := s.applyChange(ctx, v)
+		if err != nil {
+			log.Errorf("error apply manifest change(%s): %s", v, err)
+			ret[i] = &changeResult{status: ManifestChangeFailed, message: err.Error()}
+			if v.Kind == manifest.KindPlugin {
+				pending[v.Plugin.ID] = "plugin " + v.Action + " failed"
+			}
+			continue
+		}
+		ret[i] = &changeResult{status: ManifestChangeSucceeded}
+		if v.Kind == manifest.KindPlugin && (v.Action == manifest.ActionInstall || v.Action == manifest.ActionUpgrade) {
+			pending[v.Plugin.ID] = "waiting for the plugin registered"
+		}
+	}
+	return ret
+}
+
+func (s *ManifestService) applyChange(ctx context.Context, c *manifest.Change) error {
+	switch c.Kind {
+	case manifest.KindRepo:
+		return s.applyRepoChange(c)
+	case manifest.KindPlugin:
+		return s.applyPluginChange(ctx, c)
+	case manifest.KindTenant:
+		return s.applyTenantChange(ctx, c)
+	case manifest.KindExtraConfig:
+		b, err := json.Marshal(c.Value)
+		if err != nil {
+			return errors.Wrapf(err, "marshal extra config(%s)", c.Target)
+		}
+		if err = s.kvOp.Update(ctx, ExtraConfigKey(c.Target), b, ""); err != nil {
+			return errors.Wrapf(err, "update extra config(%s)", c.Target)
+		}
+		return nil
+	}
+	return errors.Errorf("unknown change kind(%s)", c.Kind)
+}
+
+func (s *ManifestService) applyRepoChange(c *manifest.Change) error {
+	switch c.Action {
+	case manifest.ActionCreate:
+		return errors.Wrap(hub.GetInstance().Add(repository.NewInfo(c.Repo.Name, c.Repo.URL, nil)), "hub add repo")
+	case manifest.ActionUpdate:
+		// only the url is managed, the other settings of the repo are kept.
+		repo, err := hub.GetInstance().Get(c.Repo.Name)
+		if err != nil {
+			return errors.Wrap(err, "hub get repo")
+		}
+		info := *repo.Info()
+		info.URL = c.Repo.URL
+		return errors.Wrap(hub.GetInstance().Update(&info), "hub update repo")
+	case manifest.ActionDelete:
+		_, err := hub.GetInstance().Delete(c.Repo.Name)
+		return errors.Wrap(err, "hub delete repo")
+	}
+	return errors.Errorf("unknown repo action(%s)", c.Action)
+}
+
+func (s *ManifestService) applyPluginChange(ctx context.Context, c *manifest.Change) error {
+	p := c.Plugin
+	var configuration []byte
+	if len(p.Configuration) != 0 {
+		b, err := yaml.Marshal(p.Configuration)
+		if err != nil {
+			return errors.Wrapf(err, "marshal plugin(%s) configuration", p.ID)
+		}
+		configuration = b
+	}
+	installer := &plugin_v1.Installer{
+		Repo:          p.Repo,
+		Name:          p.Installer,
+		Version:       p.Version,
+		Configuration: configuration,
+		Type:          plugin_v1.ConfigurationType_YAML,
+	}
+	var err error
+	switch c.Action {
+	case manifest.ActionInstall:
+		_, err = s.pluginSrv.InstallPlugin(ctx, &plugin_v1.InstallPluginRequest{
+			Id:                 p.ID,
+			Installer:          installer,
+			InstallDependences: true,
+		})
+	case manifest.ActionUpgrade:
+		_, err = s.pluginSrv.UpgradePlugin(ctx, &plugin_v1.UpgradePluginRequest{
+			Id:        p.ID,
+			Installer: installer,
+		})
+	case manifest.ActionReconfigure:
+		_, err = s.pluginSrv.ReconfigurePlugin(ctx, &plugin_v1.ReconfigurePluginRequest{
+			Id:            p.ID,
+			Configuration: configuration,
+			Type:          plugin_v1.ConfigurationType_YAML,
+		})
+	case manifest.ActionUninstall:
+		_, err = s.pluginSrv.UninstallPlugin(ctx, &plugin_v1.UninstallPluginRequest{
+			Id:      p.ID,
+			Cascade: true,
+		})
+	default:
+		return errors.Errorf("unknown plugin action(%s)", c.Action)
+	}
+	return err
+}
+
+func (s *ManifestService) applyTenantChange(ctx context.Context, c *manifest.Change) error {
+	var err error
+	switch c.Action {
+	case manifest.ActionEnable:
+		_, err = s.pluginSrv.TMTenantEnable(ctx, &plugin_v1.TMTenantEnableRequest{
+			TenantId: c.Tenant,
+			PluginId: c.Plugin.ID,
+		})
+	case manifest.ActionDisable:
+		_, err = s.pluginSrv.TMTenantDisable(ctx, &plugin_v1.TMTenantDisableRequest{
+			TenantId: c.Tenant,
+			PluginId: c.Plugin.ID,
+		})
+	default:
+		return errors.Errorf("unknown tenant action(%s)", c.Action)
+	}
+	return err
+}
+
+// currentManifest get the current state of the platform, the plugin configuration is the
+// values supplied by the user with the secrets stripped when export is true, otherwise the
+// effective values compared with the desired configuration.
+// The extra config keys are the root key and the keys of the last applied manifest.
+func (s *ManifestService) currentManifest(ctx context.Context, export bool) (*manifest.Manifest, error) {
+	ret := &manifest.Manifest{
+		APIVersion:  manifest.APIVersion,
+		Kind:        manifest.Kind,
+		ExtraConfig: make(map[string]interface{}),
+	}
+	for _, v := range hub.GetInstance().List() {
+		info := v.Info()
+		ret.Repos = append(ret.Repos, &manifest.Repo{Name: info.Name, URL: info.URL})
+	}
+	plugins, err := s.pluginOp.List(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list plugins")
+	}
+	for _, v := range plugins {
+		if pluginIsTkeelComponent(v.ID) || v.Installer == nil {
+			continue
+		}
+		mp := &manifest.Plugin{
+			ID:        v.ID,
+			Repo:      v.Installer.Repo,
+			Installer: v.Installer.Name,
+			Version:   v.Installer.Version,
+			Tenants:   enabledTenants(v),
+		}
+		installer, err := helm.NewReleaseInstaller(v.GetAppID(), s.namespace, helm.Secret)
+		if err != nil {
+			return nil, errors.Wrapf(err, "new plugin(%s) release installer", v.ID)
+		}
+		var values map[string]interface{}
+		if export {
+			values, err = installer.UserValues()
+		} else {
+			values, err = installer.Values()
+		}
+		if err != nil && !errors.Is(err, helm.ErrNotFound) {
+			return nil, errors.Wrapf(err, "get plugin(%s) values", v.ID)
+		}
+		if export {
+			values = helm.StripSensitiveValues(values)
+		}
+		if len(values) != 0 {
+			mp.Configuration = values
+		}
+		ret.Plugins = append(ret.Plugins, mp)
+	}
+	keys := []string{""}
+	applied, err := s.getApplied(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get applied manifest")
+	}
+	if applied != nil {
+		for k := range applied.Manifest.ExtraConfig {
+			if k != "" {
+				keys = append(keys, k)
+			}
+		}
+	}
+	for _, k := range keys {
+		b, _, err := s.kvOp.Get(ctx, ExtraConfigKey(k))
+		if err != nil {
+			return nil, errors.Wrapf(err, "get extra config(%s)", k)
+		}
+		if len(b) == 0 {
+			continue
+		}
+		var value interface{}
+		if err = json.Unmarshal(b, &value); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal extra config(%s)", k)
+		}
+		ret.ExtraConfig[k] = value
+	}
+	ret.Sort()
+	return ret, nil
+}
+
+func (s *ManifestService) getApplied(ctx context.Context) (*appliedManifest, error) {
+	b, _, err := s.kvOp.Get(ctx, model.KeyPlatManifest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get %s", model.KeyPlatManifest)
+	}
+	if len(b) == 0 {
+		return nil, nil
+	}
+	ret := &appliedManifest{}
+	if err = json.Unmarshal(b, ret); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal %s", model.KeyPlatManifest)
+	}
+	if ret.Manifest == nil {
+		return nil, nil
+	}
+	return ret, nil
+}
+
+func (s *ManifestService) saveApplied(ctx context.Context, m *manifest.Manifest, prune bool) error {
+	b, err := json.Marshal(&appliedManifest{
+		Manifest:       m,
+		Prune:          prune,
+		ApplyTimestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "marshal %s", model.KeyPlatManifest)
+	}
+	if err = s.kvOp.Update(ctx, model.KeyPlatManifest, b, ""); err != nil {
+		return errors.Wrapf(err, "update %s", model.KeyPlatManifest)
+	}
+	return nil
+}
+
+// checkAdminPortal check the request user is the platform admin.
+func checkAdminPortal(ctx context.Context) error {
+	u, err := util.GetUser(ctx)
+	if err != nil {
+		log.Errorf("error get user: %s", err)
+		return pb.ManifestErrPermissionDenied()
+	}
+	if u.Tenant != model.TKeelTenant || u.User != model.TKeelUser {
+		log.Errorf("error user(%s/%s) not admin portal", u.Tenant, u.User)
+		return pb.ManifestErrPermissionDenied()
+	}
+	return nil
+}
+
+func convertManifestChanges(changes []*manifest.Change, results []*changeResult) []*pb.ManifestChange {
+	ret := make([]*pb.ManifestChange, 0, len(changes))
+	for i, v := range changes {
+		c := &pb.ManifestChange{
+			Kind:   v.Kind,
+			Action: v.Action,
+			Target: v.Target,
+			Detail: v.Detail,
+		}
+		if i < len(results) && results[i] != nil {
+			c.Status, c.Message = results[i].status, results[i].message
+		}
+		ret = append(ret, c)
+	}
+	return ret
+}
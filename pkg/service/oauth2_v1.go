@@ -79,8 +79,8 @@ func (s *Oauth2ServiceV1) IssuePluginToken(ctx context.Context, req *pb.IssuePlu
 			log.Errorf("error issue(%s) oauth2 token: %s", pluginID, err)
 			return nil, pb.Oauth2ErrInternalStore()
 		}
-		if err = s.checkPluginSecret(plugin.Secret, req.ClientSecret); err != nil {
-			log.Errorf("error issue(%s) oauth2 token(%s -- %s): %s", pluginID, plugin.Secret, req.ClientSecret, err)
+		if err = s.checkPluginSecret(plugin, req.ClientSecret); err != nil {
+			log.Errorf("error issue(%s) oauth2 token: %s", pluginID, err)
 			return nil, pb.Oauth2ErrSecretNotMatch()
 		}
 	}
@@ -140,8 +140,12 @@ func (s *Oauth2ServiceV1) VerifyToken(ctx context.Context, empty *emptypb.Empty)
 	return &emptypb.Empty{}, nil
 }
 
-func (s *Oauth2ServiceV1) UpdateAdminPassword(ctx context.Context, req *pb.UpdateAdminPasswordRequest) (*emptypb.Empty, error) {
-	_, err := util.GetUser(ctx)
+func (s *Oauth2ServiceV1) UpdateAdminPassword(ctx context.Context, req *pb.UpdateAdminPasswordRequest) (_ *emptypb.Empty, err error) {
+	defer func() {
+		// do not record the password.
+		recordAudit(ctx, model.AuditActionUpdateAdminPassword, model.TKeelTenant, model.TKeelUser, nil, err)
+	}()
+	_, err = util.GetUser(ctx)
 	if err != nil {
 		log.Errorf("error get user: %s", err)
 		return nil, pb.Oauth2ErrUnknown()
@@ -163,12 +167,13 @@ func (s *Oauth2ServiceV1) UpdateAdminPassword(ctx context.Context, req *pb.Updat
 	return &emptypb.Empty{}, nil
 }
 
-func (s *Oauth2ServiceV1) checkPluginSecret(ps, os string) error {
-	return nil
-	// if ps == os {.
-	// 	return nil.
-	// }.
-	// return ErrSecretNotMatch.
+// checkPluginSecret check the secret against the plugin secret,
+// the previous secret is accepted in the grace window after rotated.
+func (s *Oauth2ServiceV1) checkPluginSecret(p *model.Plugin, secret string) error {
+	if p.CheckSecret(secret) {
+		return nil
+	}
+	return ErrSecretNotMatch
 }
 
 func (s *Oauth2ServiceV1) genToken(sub string, tokenKV ...string) (token, jti string, err error) {
@@ -0,0 +1,486 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	openapi_v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/client/openapi"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+	"github.com/tkeel-io/tkeel/pkg/model/proute"
+	"github.com/tkeel-io/tkeel/pkg/register"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+	"github.com/tkeel-io/tkeel/pkg/repository/helm"
+	"github.com/tkeel-io/tkeel/pkg/util"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+// _candidateAppSuffix the app of a plugin alternates between the plugin id and
+// the plugin id with the suffix on each promoted blue/green upgrade.
+const _candidateAppSuffix = "-green"
+
+var errCandidateNotFound = errors.New("plugin candidate not found")
+
+// candidateAppID get the app id of the new candidate of the plugin.
+func candidateAppID(p *model.Plugin) string {
+	if p.GetAppID() == p.ID {
+		return p.ID + _candidateAppSuffix
+	}
+	return p.ID
+}
+
+func validCandidateRoute(tenants []string, percent int32) bool {
+	if percent < 0 || percent > 100 {
+		return false
+	}
+	for _, v := range tenants {
+		if v == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// checkNoCandidate return the error if the plugin has a candidate, the release of the
+// plugin can not be changed until the candidate is promoted or aborted.
+func (s *PluginServiceV1) checkNoCandidate(ctx context.Context, pluginID string) error {
+	pr, err := s.pluginRouteOp.Get(ctx, pluginID)
+	if err != nil {
+		if errors.Is(err, proute.ErrPluginRouteNotExsist) {
+			return nil
+		}
+		log.Errorf("error get plugin route(%s): %s", pluginID, err)
+		return pb.PluginErrInternalStore()
+	}
+	if pr.Candidate != nil {
+		log.Errorf("error plugin(%s) has the candidate(%s), promote or abort it first", pluginID, pr.Candidate.AppID)
+		return pb.PluginErrCandidateExists()
+	}
+	return nil
+}
+
+// deployCandidate install the new version as a separate release with its own app id,
+// the candidate is routed once it is registered ready by registerCandidate.
+func (s *PluginServiceV1) deployCandidate(ctx context.Context, p *model.Plugin,
+	upgrader repository.Installer, opts []*repository.Option, req *pb.UpgradePluginRequest,
+) (*pb.UpgradePluginResponse, error) {
+	rbStack := util.NewRollbackStack()
+	defer rbStack.Run()
+	if !validCandidateRoute(req.CandidateTenants, req.CandidatePercent) {
+		log.Errorf("error plugin(%s) invalid candidate route: %v %d", p.ID, req.CandidateTenants, req.CandidatePercent)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	// the candidate uses the secret of the plugin to get the plugin token.
+	primary, err := helm.NewReleaseInstaller(p.GetAppID(), s.tkeelConf.Namespace, helm.Secret)
+	if err != nil {
+		log.Errorf("error new plugin(%s) release installer: %s", p.ID, err)
+		return nil, pb.PluginErrInstallInstaller()
+	}
+	secret, err := primary.ReleaseSecret()
+	if err != nil {
+		log.Errorf("error get plugin(%s) release secret: %s", p.ID, err)
+		return nil, pb.PluginErrInstallInstaller()
+	}
+	appID := candidateAppID(p)
+	upgrader.SetAppID(appID)
+	upgrader.SetSecret(secret)
+	tracker := operationFromContext(ctx)
+	tracker.start(ctx, model.PhaseHelmAction)
+	err = upgrader.Install(opts...)
+	tracker.end(ctx, model.PhaseHelmAction, err)
+	if err != nil {
+		log.Errorf("error install plugin(%s) candidate(%s): %s", p.ID, upgrader.Brief(), err)
+		if errors.Is(err, repository.ErrInvalidOptions) {
+			return nil, pb.PluginErrInvalidArgument()
+		}
+		if errors.Is(err, repository.ErrProvenanceVerification) {
+			return nil, pb.PluginErrProvenanceVerification()
+		}
+		return nil, pb.PluginErrInstallInstaller()
+	}
+	rbStack = append(rbStack, func() error {
+		log.Debugf("candidate installer roll back.")
+		return upgrader.Uninstall()
+	})
+	operatorID := ""
+	if u, err1 := util.GetUser(ctx); err1 == nil {
+		operatorID = u.User
+	}
+	candidate := &model.PluginCandidate{
+		AppID: appID,
+		Installer: &model.Installer{
+			Repo:       upgrader.Brief().Repo,
+			Name:       upgrader.Brief().Name,
+			Version:    upgrader.Brief().Version,
+			Icon:       upgrader.Brief().Icon,
+			Desc:       upgrader.Brief().Desc,
+			Maintainer: upgrader.Brief().Maintainers,
+		},
+		Tenants:        req.CandidateTenants,
+		Percent:        req.CandidatePercent,
+		Status:         model.CandidateStatusDeploying,
+		OperatorID:     operatorID,
+		StartTimestamp: time.Now().Unix(),
+	}
+	pr, err := s.updatePluginRouteLocked(ctx, p.ID, func(pr *model.PluginRoute) (bool, error) {
+		if pr.Candidate != nil {
+			return false, pb.PluginErrCandidateExists()
+		}
+		pr.Candidate = candidate
+		return true, nil
+	})
+	if err != nil {
+		log.Errorf("error save plugin(%s) candidate: %s", p.ID, err)
+		if errors.Is(err, pb.PluginErrCandidateExists()) {
+			return nil, pb.PluginErrCandidateExists()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	rbStack = append(rbStack, func() error {
+		_, err := s.updatePluginRouteLocked(ctx, p.ID, func(pr *model.PluginRoute) (bool, error) {
+			pr.Candidate = nil
+			return true, nil
+		})
+		return err
+	})
+	tracker.start(ctx, model.PhaseWaitReady)
+	reg := model.NewRegistration(model.RegistrationActionCandidate, p.ID, tracker.id())
+	reg.AppID = appID
+	if err = s.registerPending(ctx, reg); err != nil {
+		tracker.end(ctx, model.PhaseWaitReady, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	log.Debugf("deploy plugin(%s) candidate(%s) succ.", p.ID, appID)
+	rbStack = util.NewRollbackStack()
+	return &pb.UpgradePluginResponse{
+		Plugin: util.ConvertModel2PluginObjectPb(p, pr, model.TKeelTenant),
+	}, nil
+}
+
+// registerCandidate mark the candidate ready when it is running and its identify is valid,
+// the candidate is marked failed when the final attempt failed.
+func (s *PluginServiceV1) registerCandidate(ctx context.Context, reg *model.Registration, final bool) error {
+	pr, err := s.pluginRouteOp.Get(ctx, reg.PluginID)
+	if err != nil {
+		return errors.Wrapf(err, "get plugin route(%s)", reg.PluginID)
+	}
+	tracker := operationFromContext(ctx)
+	if pr.Candidate == nil || pr.Candidate.AppID != reg.AppID {
+		log.Debugf("plugin(%s) candidate(%s) aborted, skip register", reg.PluginID, reg.AppID)
+		tracker.finish(ctx, errCandidateNotFound)
+		return nil
+	}
+	// the candidate app is addressed directly, its id may be the plugin id.
+	resp, err := s.queryStatus(openapi.WithAppID(ctx), reg.AppID)
+	if err == nil && resp.Status != openapi_v1.PluginStatus_RUNNING {
+		err = errors.Errorf("plugin status %s", resp.Status)
+	}
+	if err != nil {
+		log.Warnf("register query plugin(%s) candidate(%s) status: %s", reg.PluginID, reg.AppID, err)
+		if !final {
+			return register.ErrNotReady
+		}
+		// the candidate not ready in time is marked failed.
+		err = errors.Wrapf(register.ErrReadyTimeout, "%s", err)
+		tracker.end(ctx, model.PhaseWaitReady, err)
+	} else {
+		tracker.end(ctx, model.PhaseWaitReady, nil)
+		tracker.start(ctx, model.PhaseIdentify)
+		ver := ""
+		if pr.Candidate.Installer != nil {
+			ver = pr.Candidate.Installer.Version
+		}
+		err = s.checkCandidateIdentify(ctx, reg.PluginID, reg.AppID, ver)
+		tracker.end(ctx, model.PhaseIdentify, err)
+	}
+	status, msg := model.CandidateStatusReady, ""
+	if err != nil {
+		log.Errorf("error register plugin(%s) candidate(%s): %s", reg.PluginID, reg.AppID, err)
+		if !final {
+			return err
+		}
+		status, msg = model.CandidateStatusFailed, err.Error()
+	}
+	if _, uErr := s.updatePluginRouteLocked(ctx, reg.PluginID, func(pr *model.PluginRoute) (bool, error) {
+		if pr.Candidate == nil || pr.Candidate.AppID != reg.AppID {
+			return false, nil
+		}
+		pr.Candidate.Status, pr.Candidate.Message = status, msg
+		return true, nil
+	}); uErr != nil {
+		log.Errorf("error update plugin(%s) candidate status(%s): %s", reg.PluginID, status, uErr)
+		return uErr
+	}
+	log.Debugf("plugin(%s) candidate(%s) %s", reg.PluginID, reg.AppID, status)
+	tracker.finish(ctx, err)
+	return err
+}
+
+func (s *PluginServiceV1) checkCandidateIdentify(ctx context.Context, pluginID, appID, ver string) error {
+	resp, err := s.openapiClient.Identify(openapi.WithAppID(ctx), appID)
+	if err != nil {
+		return errors.Wrapf(err, "identify(%s)", appID)
+	}
+	if resp.Res == nil || resp.Res.Ret != openapi_v1.Retcode_OK {
+		return errors.Errorf("identify(%s): %s", appID, resp.Res)
+	}
+	if resp.PluginId != pluginID {
+		return errors.Errorf("plugin id not match: %s -- %s", pluginID, resp.PluginId)
+	}
+	if err = s.checkIdentify(ctx, resp, ver); err != nil {
+		return errors.Wrapf(err, "check identify: %s", resp)
+	}
+	return nil
+}
+
+func (s *PluginServiceV1) SetPluginCandidateRoute(ctx context.Context,
+	req *pb.SetPluginCandidateRouteRequest,
+) (_ *pb.SetPluginCandidateRouteResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionSetCandidateRoute, model.TKeelTenant, req.Id, req, err)
+	}()
+	if !validCandidateRoute(req.Tenants, req.Percent) {
+		log.Errorf("error plugin(%s) invalid candidate route: %v %d", req.Id, req.Tenants, req.Percent)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	p, err := s.pluginOp.Get(ctx, req.Id)
+	if err != nil {
+		log.Errorf("error get plugin(%s): %s", req.Id, err)
+		if errors.Is(err, plugin.ErrPluginNotExsist) {
+			return nil, pb.PluginErrPluginNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	pr, err := s.updatePluginRouteLocked(ctx, req.Id, func(pr *model.PluginRoute) (bool, error) {
+		if pr.Candidate == nil {
+			return false, errCandidateNotFound
+		}
+		pr.Candidate.Tenants, pr.Candidate.Percent = req.Tenants, req.Percent
+		return true, nil
+	})
+	if err != nil {
+		log.Errorf("error set plugin(%s) candidate route: %s", req.Id, err)
+		return nil, candidateErr(err)
+	}
+	log.Debugf("set plugin(%s) candidate route(%v %d) succ.", req.Id, req.Tenants, req.Percent)
+	return &pb.SetPluginCandidateRouteResponse{
+		Plugin: util.ConvertModel2PluginObjectPb(p, pr, model.TKeelTenant),
+	}, nil
+}
+
+// PromotePluginCandidate switch the plugin to the ready candidate, the new version
+// is registered and the release of the previous version is removed.
+func (s *PluginServiceV1) PromotePluginCandidate(ctx context.Context,
+	req *pb.PromotePluginCandidateRequest,
+) (_ *pb.PromotePluginCandidateResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionPromoteCandidate, model.TKeelTenant, req.Id, req, err)
+	}()
+	ctx, tracker, err := s.newOperation(ctx, model.OperationTypePromote, req.Id)
+	if err != nil {
+		log.Errorf("error promote plugin(%s) candidate new operation: %s", req.Id, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	resp, err := s.promoteCandidate(ctx, req)
+	tracker.finish(ctx, err)
+	return resp, err
+}
+
+func (s *PluginServiceV1) promoteCandidate(ctx context.Context,
+	req *pb.PromotePluginCandidateRequest,
+) (*pb.PromotePluginCandidateResponse, error) {
+	rbStack := util.NewRollbackStack()
+	defer rbStack.Run()
+	p, err := s.pluginOp.Get(ctx, req.Id)
+	if err != nil {
+		log.Errorf("error get plugin(%s): %s", req.Id, err)
+		if errors.Is(err, plugin.ErrPluginNotExsist) {
+			return nil, pb.PluginErrPluginNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	pr, err := s.pluginRouteOp.Get(ctx, req.Id)
+	if err != nil {
+		log.Errorf("error get plugin route(%s): %s", req.Id, err)
+		if errors.Is(err, proute.ErrPluginRouteNotExsist) {
+			return nil, pb.PluginErrPluginRouteNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	c := pr.Candidate
+	if c == nil {
+		log.Errorf("error promote plugin(%s): %s", req.Id, errCandidateNotFound)
+		return nil, pb.PluginErrCandidateNotFound()
+	}
+	if c.Status != model.CandidateStatusReady {
+		log.Errorf("error promote plugin(%s) candidate(%s) %s", req.Id, c.AppID, c.Status)
+		return nil, pb.PluginErrCandidateNotReady()
+	}
+	oldP, oldAppID := p.Clone(), p.GetAppID()
+	p.Upgrade(c.Installer)
+	p.AppID = c.AppID
+	if p.AppID == p.ID {
+		p.AppID = ""
+	}
+	rb, err := s.updatePlugin(ctx, oldP, p)
+	if err != nil {
+		log.Errorf("error update plugin(%s): %s", p, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	rbStack = append(rbStack, rb)
+	// all tenants are routed to the candidate app.
+	if _, err = s.updatePluginRouteLocked(ctx, p.ID, func(pr *model.PluginRoute) (bool, error) {
+		pr.AppID, pr.Candidate = p.AppID, nil
+		return true, nil
+	}); err != nil {
+		log.Errorf("error switch plugin(%s) route to candidate(%s): %s", p.ID, c.AppID, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	rbStack = append(rbStack, func() error {
+		_, err := s.updatePluginRouteLocked(ctx, p.ID, func(pr *model.PluginRoute) (bool, error) {
+			pr.AppID, pr.Candidate = oldP.AppID, c
+			return true, nil
+		})
+		return err
+	})
+	// register the identify of the new version.
+	if err = s.registerPluginProcess(ctx, p.ID, true); err != nil {
+		log.Errorf("error register plugin(%s) candidate(%s): %s", p.ID, c.AppID, err)
+		return nil, pb.PluginErrPromoteCandidate()
+	}
+	rbStack = util.NewRollbackStack()
+	tracker := operationFromContext(ctx)
+	tracker.start(ctx, model.PhaseCleanup)
+	err = s.uninstallRelease(oldAppID)
+	tracker.end(ctx, model.PhaseCleanup, err)
+	if err != nil {
+		// the plugin is served by the candidate already.
+		log.Errorf("error remove plugin(%s) previous release(%s): %s", p.ID, oldAppID, err)
+	}
+	if p, err = s.pluginOp.Get(ctx, req.Id); err != nil {
+		log.Errorf("error get plugin(%s): %s", req.Id, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	if pr, err = s.pluginRouteOp.Get(ctx, req.Id); err != nil {
+		log.Errorf("error get plugin route(%s): %s", req.Id, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	log.Debugf("promote plugin(%s) candidate(%s) succ.", p.ID, c.AppID)
+	return &pb.PromotePluginCandidateResponse{
+		Plugin: util.ConvertModel2PluginObjectPb(p, pr, model.TKeelTenant),
+	}, nil
+}
+
+// AbortPluginCandidate stop routing to the candidate and remove its release.
+func (s *PluginServiceV1) AbortPluginCandidate(ctx context.Context,
+	req *pb.AbortPluginCandidateRequest,
+) (_ *pb.AbortPluginCandidateResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionAbortCandidate, model.TKeelTenant, req.Id, req, err)
+	}()
+	p, err := s.pluginOp.Get(ctx, req.Id)
+	if err != nil {
+		log.Errorf("error get plugin(%s): %s", req.Id, err)
+		if errors.Is(err, plugin.ErrPluginNotExsist) {
+			return nil, pb.PluginErrPluginNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	pr, err := s.pluginRouteOp.Get(ctx, req.Id)
+	if err != nil {
+		log.Errorf("error get plugin(%s) route: %s", req.Id, err)
+		return nil, candidateErr(err)
+	}
+	if pr.Candidate == nil {
+		log.Errorf("error abort plugin(%s) candidate: %s", req.Id, errCandidateNotFound)
+		return nil, candidateErr(errCandidateNotFound)
+	}
+	// the candidate is kept until its release removed, so that the abort can be retried.
+	c := pr.Candidate
+	if err = s.uninstallRelease(c.AppID); err != nil {
+		log.Errorf("error remove plugin(%s) candidate release(%s): %s", req.Id, c.AppID, err)
+		return nil, pb.PluginErrUninstallPlugin()
+	}
+	pr, err = s.updatePluginRouteLocked(ctx, req.Id, func(pr *model.PluginRoute) (bool, error) {
+		if pr.Candidate == nil || pr.Candidate.AppID != c.AppID {
+			return false, nil
+		}
+		pr.Candidate = nil
+		return true, nil
+	})
+	if err != nil {
+		log.Errorf("error abort plugin(%s) candidate: %s", req.Id, err)
+		return nil, candidateErr(err)
+	}
+	log.Debugf("abort plugin(%s) candidate(%s) succ.", req.Id, c.AppID)
+	return &pb.AbortPluginCandidateResponse{
+		Plugin: util.ConvertModel2PluginObjectPb(p, pr, model.TKeelTenant),
+	}, nil
+}
+
+// uninstallRelease uninstall the release of the app, the release not found is ignored.
+func (s *PluginServiceV1) uninstallRelease(appID string) error {
+	installer, err := helm.NewReleaseInstaller(appID, s.tkeelConf.Namespace, helm.Secret)
+	if err != nil {
+		return errors.Wrapf(err, "new release(%s) installer", appID)
+	}
+	if err = installer.Uninstall(); err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return errors.Wrapf(err, "uninstall release(%s)", appID)
+	}
+	return nil
+}
+
+// updatePluginRouteLocked apply the change to the latest plugin route with the route locked,
+// the route is not updated when change returns false or error.
+func (s *PluginServiceV1) updatePluginRouteLocked(ctx context.Context, pluginID string,
+	change func(pr *model.PluginRoute) (bool, error),
+) (*model.PluginRoute, error) {
+	v, _ := s.routeLocks.LoadOrStore(pluginID, &sync.Mutex{})
+	mu, _ := v.(*sync.Mutex)
+	mu.Lock()
+	defer mu.Unlock()
+	pr, err := s.pluginRouteOp.Get(ctx, pluginID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get plugin route(%s)", pluginID)
+	}
+	ok, err := change(pr)
+	if err != nil || !ok {
+		return pr, err
+	}
+	if err = s.pluginRouteOp.Update(ctx, pr); err != nil {
+		return nil, errors.Wrapf(err, "update plugin route(%s)", pr)
+	}
+	return pr, nil
+}
+
+func candidateErr(err error) error {
+	switch {
+	case errors.Is(err, errCandidateNotFound):
+		return pb.PluginErrCandidateNotFound()
+	case errors.Is(err, proute.ErrPluginRouteNotExsist):
+		return pb.PluginErrPluginRouteNotFound()
+	default:
+		return pb.PluginErrInternalStore()
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/proute"
+	"github.com/tkeel-io/tkeel/pkg/util"
+)
+
+// uninstallPreview get the tenants and plugins affected by the cascade uninstall of the plugin.
+func (s *PluginServiceV1) uninstallPreview(ctx context.Context, p *model.Plugin,
+	pr *model.PluginRoute,
+) (*pb.UninstallPreview, error) {
+	ret := &pb.UninstallPreview{
+		Tenants:   enabledTenants(p),
+		Plugins:   make([]*pb.UninstallAffectedPlugin, 0),
+		Conflicts: make([]string, 0),
+		Policies:  int32(len(s.pluginPolicies(p))),
+	}
+	addons := registeredAddons(pr)
+	for _, id := range sortedKeys(addons) {
+		ret.Plugins = append(ret.Plugins, &pb.UninstallAffectedPlugin{
+			Id:           id,
+			AddonsPoints: addons[id],
+		})
+	}
+	conflicts, err := s.checkDependentConstraints(ctx, p.ID, "", true)
+	if err != nil {
+		return nil, errors.Wrapf(err, "check plugin(%s) dependent version constraints", p.ID)
+	}
+	for _, v := range conflicts {
+		ret.Conflicts = append(ret.Conflicts, v.String())
+	}
+	return ret, nil
+}
+
+// cascadeUninstall disable the plugin for all the enabled tenants and remove the plugin from
+// the routes of the plugins which registered addons on it, the actions are recorded in the operation report.
+// The plugin is disabled in memory only, it is deleted by the caller.
+// The returned rollback stack is valid even if an error is returned.
+func (s *PluginServiceV1) cascadeUninstall(ctx context.Context, p *model.Plugin,
+	pr *model.PluginRoute,
+) (util.RollBackStack, error) {
+	rbStack := util.NewRollbackStack()
+	tracker := operationFromContext(ctx)
+	for _, tenantID := range enabledTenants(p) {
+		rbs, err := s.cascadeTenantDisable(ctx, p.ID, tenantID)
+		rbStack = append(rbStack, rbs...)
+		tracker.report(ctx, model.ReportActionTenantDisable, tenantID, "", err)
+		if err != nil {
+			return rbStack, errors.Wrapf(err, "tenant(%s) disable plugin(%s)", tenantID, p.ID)
+		}
+		p.TenantDisable(tenantID)
+	}
+	addons := registeredAddons(pr)
+	for _, id := range sortedKeys(addons) {
+		rb, err := s.removeImplementedPlugin(ctx, id, p.ID)
+		if rb != nil {
+			rbStack = append(rbStack, rb)
+		}
+		tracker.report(ctx, model.ReportActionAddonsRemove, id, strings.Join(addons[id], ","), err)
+		if err != nil {
+			return rbStack, errors.Wrapf(err, "remove plugin(%s) addons(%v)", id, addons[id])
+		}
+	}
+	if pr != nil {
+		pr.RegisterAddons = nil
+	}
+	return rbStack, nil
+}
+
+func (s *PluginServiceV1) cascadeTenantDisable(ctx context.Context, pluginID, tenantID string) (util.RollBackStack, error) {
+	rbStack := util.NewRollbackStack()
+	rb, err := s.requestTenantDisable(ctx, pluginID, tenantID, nil)
+	if err != nil {
+		return rbStack, errors.Wrap(err, "request tenant disable")
+	}
+	rbStack = append(rbStack, rb)
+	if _, err = s.tenantPluginOp.DeleteTenantPlugin(tenantID, pluginID); err != nil {
+		return rbStack, errors.Wrap(err, "delete tenant plugin rbac")
+	}
+	rbStack = append(rbStack, func() error {
+		log.Debugf("cascade tenant(%s) disable plugin(%s) roll back run.", tenantID, pluginID)
+		if _, err := s.tenantPluginOp.AddTenantPlugin(tenantID, pluginID); err != nil {
+			return errors.Wrapf(err, "add tenant(%s) plugin(%s)", tenantID, pluginID)
+		}
+		return nil
+	})
+	return rbStack, nil
+}
+
+// removeImplementedPlugin remove the implemented plugin from the plugin route.
+func (s *PluginServiceV1) removeImplementedPlugin(ctx context.Context, pluginID, implementedID string) (util.RollbackFunc, error) {
+	route, err := s.pluginRouteOp.Get(ctx, pluginID)
+	if err != nil {
+		if errors.Is(err, proute.ErrPluginRouteNotExsist) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "get plugin route(%s)", pluginID)
+	}
+	tmpRoute := route.Clone()
+	implemented := make([]string, 0, len(route.ImplementedPlugin))
+	for _, v := range route.ImplementedPlugin {
+		if v != implementedID {
+			implemented = append(implemented, v)
+		}
+	}
+	route.ImplementedPlugin = implemented
+	if err = s.pluginRouteOp.Update(ctx, route); err != nil {
+		return nil, errors.Wrapf(err, "update plugin route(%s)", route)
+	}
+	return func() error {
+		log.Debugf("cascade remove implemented plugin(%s) route(%s) roll back run.", implementedID, pluginID)
+		if _, err := s.pluginRouteOp.Delete(ctx, tmpRoute.ID); err != nil {
+			return errors.Wrapf(err, "delete tmpRoute(%s)", tmpRoute)
+		}
+		tmpRoute.Version = "1"
+		if err := s.pluginRouteOp.Create(ctx, tmpRoute); err != nil {
+			return errors.Wrapf(err, "create tmpRoute(%s)", tmpRoute)
+		}
+		return nil
+	}, nil
+}
+
+// enabledTenants get the tenants enabled the plugin except the tKeel tenant.
+func enabledTenants(p *model.Plugin) []string {
+	ret := make([]string, 0, len(p.EnableTenantes))
+	for _, v := range p.EnableTenantes {
+		if v.TenantID != model.TKeelTenant {
+			ret = append(ret, v.TenantID)
+		}
+	}
+	return ret
+}
+
+// registeredAddons get the addons points registered on the plugin route grouped by the registered plugin.
+func registeredAddons(pr *model.PluginRoute) map[string][]string {
+	ret := make(map[string][]string)
+	if pr == nil {
+		return ret
+	}
+	for point, endpoint := range pr.RegisterAddons {
+		pluginID, _ := util.DecodePluginRoute(endpoint)
+		if pluginID == "" {
+			log.Warnf("invalid plugin(%s) addons(%s) endpoint: %s", pr.ID, point, endpoint)
+			continue
+		}
+		ret[pluginID] = append(ret[pluginID], point)
+	}
+	for _, v := range ret {
+		sort.Strings(v)
+	}
+	return ret
+}
+
+func sortedKeys(m map[string][]string) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func policiesDetail(n int) string {
+	return fmt.Sprintf("%d policies", n)
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	g_version "github.com/hashicorp/go-version"
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/hub"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+	"github.com/tkeel-io/tkeel/pkg/util"
+)
+
+var (
+	ErrDependenceCycle    = errors.New("dependence cycle")
+	ErrDependenceNotFound = errors.New("dependence installer not found")
+)
+
+// installPlanItem a plugin in the install plan.
+type installPlanItem struct {
+	id          string
+	installer   repository.Installer
+	installed   bool
+	dependences []string
+}
+
+// resolveInstallPlan resolve the dependence graph of the installer from the installer annotations,
+// return the install plan in topological order(dependences first, the installer itself last).
+// The dependences which have been installed are kept in the plan and marked installed.
+// The version constraint is checked on every visit of the dependence, so that the
+// dependence shared by several plugins satisfies all of their constraints.
+func (s *PluginServiceV1) resolveInstallPlan(ctx context.Context, pluginID string,
+	installer repository.Installer,
+) ([]*installPlanItem, error) {
+	plan := make([]*installPlanItem, 0)
+	// visited plugin id -> the installed or planned version.
+	visited := make(map[string]string)
+	visiting := make([]string, 0)
+	var visit func(id string, installer repository.Installer) error
+	visit = func(id string, installer repository.Installer) error {
+		for i, v := range visiting {
+			if v == id {
+				return errors.Wrapf(ErrDependenceCycle, "%s -> %s",
+					strings.Join(visiting[i:], " -> "), id)
+			}
+		}
+		if _, ok := visited[id]; ok {
+			return nil
+		}
+		visiting = append(visiting, id)
+		deps, err := repository.ParseDependences(installer.Annotations())
+		if err != nil {
+			return errors.Wrapf(err, "parse plugin(%s) dependences", id)
+		}
+		item := &installPlanItem{
+			id:          id,
+			installer:   installer,
+			dependences: make([]string, 0, len(deps)),
+		}
+		for _, dep := range deps {
+			if pluginIsTkeelComponent(dep.ID) {
+				continue
+			}
+			item.dependences = append(item.dependences, dep.ID)
+			if version, ok := visited[dep.ID]; ok {
+				if err = checkDependenceVersion(id, dep, version); err != nil {
+					return err
+				}
+				continue
+			}
+			if p, err := s.pluginOp.Get(ctx, dep.ID); err == nil {
+				if err = checkDependenceVersion(id, dep, pluginVersion(p)); err != nil {
+					return err
+				}
+				visited[dep.ID] = pluginVersion(p)
+				plan = append(plan, &installPlanItem{id: dep.ID, installed: true})
+				continue
+			} else if !errors.Is(err, plugin.ErrPluginNotExsist) {
+				return errors.Wrapf(err, "get plugin(%s)", dep.ID)
+			}
+			depInstaller, err := findDependenceInstaller(dep)
+			if err != nil {
+				return errors.Wrapf(err, "plugin(%s) dependence(%s)", id, dep)
+			}
+			depInstaller.SetPluginID(dep.ID)
+			if err = visit(dep.ID, depInstaller); err != nil {
+				return err
+			}
+		}
+		visiting = visiting[:len(visiting)-1]
+		visited[id] = installer.Brief().Version
+		plan = append(plan, item)
+		return nil
+	}
+	if err := visit(pluginID, installer); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// checkDependenceVersion check the installed or planned version of the dependence satisfies its constraint.
+func checkDependenceVersion(pluginID string, dep *repository.Dependence, version string) error {
+	conflicts, err := checkVersion(pluginID, dep.ID, version, []string{dep.Version})
+	if err != nil {
+		return err
+	}
+	return conflicts.err()
+}
+
+// findDependenceInstaller find the dependence installer in the dependence repository,
+// or in all repositories of the hub ordered by the repository name.
+func findDependenceInstaller(dep *repository.Dependence) (repository.Installer, error) {
+	if dep.Repo != "" {
+		repo, err := hub.GetInstance().Get(dep.Repo)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get repo(%s)", dep.Repo)
+		}
+		installer, err := getDependenceInstaller(repo, dep)
+		if err != nil {
+			return nil, errors.Wrapf(ErrDependenceNotFound, "repo(%s): %s", dep.Repo, err)
+		}
+		return installer, nil
+	}
+	repos := hub.GetInstance().List()
+	sort.Slice(repos, func(i, j int) bool {
+		return repos[i].Info().Name < repos[j].Info().Name
+	})
+	for _, repo := range repos {
+		installer, err := getDependenceInstaller(repo, dep)
+		if err != nil {
+			log.Debugf("repo(%s) get dependence(%s): %s", repo.Info().Name, dep, err)
+			continue
+		}
+		return installer, nil
+	}
+	return nil, ErrDependenceNotFound
+}
+
+// getDependenceInstaller get the highest version installer satisfying the dependence version constraint.
+func getDependenceInstaller(repo repository.Repository, dep *repository.Dependence) (repository.Installer, error) {
+	candidate, err := repo.Get(dep.ID, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "get installer(%s)", dep.ID)
+	}
+	cs, err := util.ParseVersionConstraints(dep.Version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse dependence(%s) version", dep)
+	}
+	var matched *g_version.Version
+	for _, v := range candidate.Brief().VersionList {
+		ver, err := g_version.NewVersion(v.Version)
+		if err != nil {
+			log.Debugf("installer(%s) invalid version(%s): %s", dep.ID, v.Version, err)
+			continue
+		}
+		if cs.Check(ver) && (matched == nil || ver.GreaterThan(matched)) {
+			matched = ver
+		}
+	}
+	if matched == nil {
+		return nil, errors.Errorf("no installer(%s) version satisfies %q", dep.ID, dep.Version)
+	}
+	if matched.Original() == candidate.Brief().Version {
+		return candidate, nil
+	}
+	installer, err := repo.Get(dep.ID, matched.Original())
+	if err != nil {
+		return nil, errors.Wrapf(err, "get installer(%s/%s)", dep.ID, matched.Original())
+	}
+	return installer, nil
+}
+
+func convertInstallPlan2Pb(plan []*installPlanItem) []*pb.InstallPlanItem {
+	ret := make([]*pb.InstallPlanItem, 0, len(plan))
+	for _, v := range plan {
+		item := &pb.InstallPlanItem{
+			Id:          v.id,
+			Installed:   v.installed,
+			Dependences: v.dependences,
+		}
+		if v.installer != nil {
+			item.Repo = v.installer.Brief().Repo
+			item.Name = v.installer.Brief().Name
+			item.Version = v.installer.Brief().Version
+		}
+		ret = append(ret, item)
+	}
+	return ret
+}
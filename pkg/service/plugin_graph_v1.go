@@ -0,0 +1,298 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/proute"
+	"github.com/tkeel-io/tkeel/pkg/util"
+)
+
+const (
+	_graphFormatJSON = "json"
+	_graphFormatDOT  = "dot"
+
+	// edge from the plugin to the plugin it depends on.
+	_graphEdgeDependence = "dependence"
+	// edge from the plugin to the plugin whose addons points it implements.
+	_graphEdgeImplementation = "implementation"
+	// edge from the plugin to the plugin registered on its addons points.
+	_graphEdgeAddons = "addons"
+
+	// status of the plugin depended on but not installed.
+	_graphStatusNotInstalled = "NOT_INSTALLED"
+)
+
+// pluginGraph the plugins and the relations between them, an edge from a to b
+// means that a is affected when b is removed.
+type pluginGraph struct {
+	nodes []*pb.PluginGraphNode
+	edges []*pb.PluginGraphEdge
+}
+
+// GetPluginGraph get the dependence, implementation and addons relations of the plugins,
+// the tenant users only get the plugins enabled by their tenant.
+func (s *PluginServiceV1) GetPluginGraph(ctx context.Context,
+	req *pb.GetPluginGraphRequest,
+) (*pb.GetPluginGraphResponse, error) {
+	u, err := util.GetUser(ctx)
+	if err != nil {
+		log.Errorf("error get user: %s", err)
+		return nil, pb.PluginErrUnknown()
+	}
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = _graphFormatJSON
+	}
+	if format != _graphFormatJSON && format != _graphFormatDOT {
+		log.Errorf("error invalid plugin graph format: %s", req.Format)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	tenantID := req.TenantId
+	if u.Tenant != model.TKeelTenant {
+		tenantID = u.Tenant
+	}
+	ps, err := s.pluginOp.List(ctx)
+	if err != nil {
+		log.Errorf("error plugin list: %s", err)
+		return nil, pb.PluginErrListPlugin()
+	}
+	routes := make(map[string]*model.PluginRoute, len(ps))
+	for _, p := range ps {
+		pr, err := s.pluginRouteOp.Get(ctx, p.ID)
+		if err != nil {
+			if errors.Is(err, proute.ErrPluginRouteNotExsist) {
+				continue
+			}
+			log.Errorf("error get plugin route(%s): %s", p.ID, err)
+			return nil, pb.PluginErrInternalStore()
+		}
+		routes[p.ID] = pr
+	}
+	g := buildPluginGraph(ps, routes)
+	if req.PluginId != "" {
+		if g.node(req.PluginId) == nil {
+			log.Errorf("error get plugin(%s) graph: plugin not found", req.PluginId)
+			return nil, pb.PluginErrPluginNotFound()
+		}
+		g = g.impact(req.PluginId)
+	}
+	if tenantID != "" {
+		g = g.tenant(tenantID)
+	}
+	ret := &pb.GetPluginGraphResponse{
+		Nodes: g.nodes,
+		Edges: g.edges,
+	}
+	if format == _graphFormatDOT {
+		ret.Dot = g.dot()
+	}
+	return ret, nil
+}
+
+// buildPluginGraph build the graph from the identify of the plugins and the registered addons of the routes.
+func buildPluginGraph(ps []*model.Plugin, routes map[string]*model.PluginRoute) *pluginGraph {
+	g := &pluginGraph{
+		nodes: make([]*pb.PluginGraphNode, 0, len(ps)),
+		edges: make([]*pb.PluginGraphEdge, 0),
+	}
+	for _, p := range ps {
+		tenants := make([]string, 0, len(p.EnableTenantes))
+		for _, v := range p.EnableTenantes {
+			tenants = append(tenants, v.TenantID)
+		}
+		sort.Strings(tenants)
+		g.nodes = append(g.nodes, &pb.PluginGraphNode{
+			Id:      p.ID,
+			Version: p.PluginVersion,
+			Status:  p.Status.String(),
+			Tenants: tenants,
+		})
+		for _, v := range p.PluginDependences {
+			g.addEdge(p.ID, v.Id, _graphEdgeDependence, v.Version)
+		}
+		for _, v := range p.ImplementedPlugin {
+			if v.Plugin == nil {
+				continue
+			}
+			points := make([]string, 0, len(v.Addons))
+			for _, a := range v.Addons {
+				points = append(points, a.AddonsPoint)
+			}
+			sort.Strings(points)
+			g.addEdge(p.ID, v.Plugin.Id, _graphEdgeImplementation, strings.Join(points, ","))
+		}
+		addons := registeredAddons(routes[p.ID])
+		for _, id := range sortedKeys(addons) {
+			g.addEdge(p.ID, id, _graphEdgeAddons, strings.Join(addons[id], ","))
+		}
+	}
+	// the plugins depended on but not installed.
+	for _, e := range g.edges {
+		if g.node(e.To) == nil {
+			g.nodes = append(g.nodes, &pb.PluginGraphNode{
+				Id:      e.To,
+				Status:  _graphStatusNotInstalled,
+				Tenants: []string{},
+			})
+		}
+	}
+	g.sort()
+	return g
+}
+
+func (g *pluginGraph) addEdge(from, to, typ, detail string) {
+	g.edges = append(g.edges, &pb.PluginGraphEdge{
+		From:   from,
+		To:     to,
+		Type:   typ,
+		Detail: detail,
+	})
+}
+
+func (g *pluginGraph) node(id string) *pb.PluginGraphNode {
+	for _, v := range g.nodes {
+		if v.Id == id {
+			return v
+		}
+	}
+	return nil
+}
+
+func (g *pluginGraph) sort() {
+	sort.Slice(g.nodes, func(i, j int) bool {
+		return g.nodes[i].Id < g.nodes[j].Id
+	})
+	sort.SliceStable(g.edges, func(i, j int) bool {
+		a, b := g.edges[i], g.edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Type < b.Type
+	})
+}
+
+// subgraph get the graph of the kept nodes and the edges between them.
+func (g *pluginGraph) subgraph(keep func(n *pb.PluginGraphNode) bool) *pluginGraph {
+	ret := &pluginGraph{
+		nodes: make([]*pb.PluginGraphNode, 0, len(g.nodes)),
+		edges: make([]*pb.PluginGraphEdge, 0, len(g.edges)),
+	}
+	kept := make(map[string]bool, len(g.nodes))
+	for _, v := range g.nodes {
+		if keep(v) {
+			kept[v.Id] = true
+			ret.nodes = append(ret.nodes, v)
+		}
+	}
+	for _, v := range g.edges {
+		if kept[v.From] && kept[v.To] {
+			ret.edges = append(ret.edges, v)
+		}
+	}
+	return ret
+}
+
+// impact get the graph of the plugin, the plugins it relies on directly and
+// the plugins affected by its removal transitively.
+func (g *pluginGraph) impact(pluginID string) *pluginGraph {
+	kept := map[string]bool{pluginID: true}
+	for _, v := range g.edges {
+		if v.From == pluginID {
+			kept[v.To] = true
+		}
+	}
+	queue := []string{pluginID}
+	affected := map[string]bool{pluginID: true}
+	for len(queue) != 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, v := range g.edges {
+			if v.To == id && !affected[v.From] {
+				affected[v.From] = true
+				kept[v.From] = true
+				queue = append(queue, v.From)
+			}
+		}
+	}
+	return g.subgraph(func(n *pb.PluginGraphNode) bool {
+		return kept[n.Id]
+	})
+}
+
+// tenant get the graph of the plugins enabled by the tenant.
+func (g *pluginGraph) tenant(tenantID string) *pluginGraph {
+	return g.subgraph(func(n *pb.PluginGraphNode) bool {
+		for _, v := range n.Tenants {
+			if v == tenantID {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// dot render the graph in the Graphviz DOT language.
+func (g *pluginGraph) dot() string {
+	var b strings.Builder
+	b.WriteString("digraph plugins {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, v := range g.nodes {
+		label := dotEscape(v.Id)
+		if v.Version != "" {
+			label += `\n` + dotEscape(v.Version)
+		}
+		attrs := fmt.Sprintf("label=\"%s\"", label)
+		if v.Status == _graphStatusNotInstalled {
+			attrs += ", style=dashed"
+		}
+		fmt.Fprintf(&b, "  \"%s\" [%s];\n", dotEscape(v.Id), attrs)
+	}
+	for _, v := range g.edges {
+		style := "solid"
+		switch v.Type {
+		case _graphEdgeImplementation:
+			style = "dashed"
+		case _graphEdgeAddons:
+			style = "dotted"
+		}
+		label := v.Type
+		if v.Detail != "" {
+			label += ": " + v.Detail
+		}
+		fmt.Fprintf(&b, "  \"%s\" -> \"%s\" [label=\"%s\", style=%s];\n",
+			dotEscape(v.From), dotEscape(v.To), dotEscape(label), style)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+	"github.com/tkeel-io/tkeel/pkg/model/proute"
+	"github.com/tkeel-io/tkeel/pkg/util"
+)
+
+// SetPluginMaintenance enable or disable the maintenance mode of the plugin, the keel
+// answers the requests to the plugin in maintenance with 503 except the platform admin
+// requests with the maintenance bypass header.
+func (s *PluginServiceV1) SetPluginMaintenance(ctx context.Context,
+	req *pb.SetPluginMaintenanceRequest,
+) (_ *pb.SetPluginMaintenanceResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionSetMaintenance, model.TKeelTenant, req.Id, req, err)
+	}()
+	if pluginIsTkeelComponent(req.Id) {
+		log.Errorf("error set tkeel component plugin(%s) maintenance", req.Id)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	now := time.Now().Unix()
+	if req.Enabled && req.EndTimestamp != 0 && req.EndTimestamp <= now {
+		log.Errorf("error set plugin(%s) maintenance: end timestamp(%d) passed", req.Id, req.EndTimestamp)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	u, err := util.GetUser(ctx)
+	if err != nil {
+		log.Errorf("error get user: %s", err)
+		return nil, pb.PluginErrUnknown()
+	}
+	p, err := s.pluginOp.Get(ctx, req.Id)
+	if err != nil {
+		log.Errorf("error plugin(%s) get: %s", req.Id, err)
+		if errors.Is(err, plugin.ErrPluginNotExsist) {
+			return nil, pb.PluginErrPluginNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	pr, err := s.updatePluginRouteLocked(ctx, req.Id, func(pr *model.PluginRoute) (bool, error) {
+		if !req.Enabled {
+			pr.Maintenance = nil
+			return true, nil
+		}
+		m := &model.PluginMaintenance{
+			Message:        req.Message,
+			StartTimestamp: now,
+			EndTimestamp:   req.EndTimestamp,
+			OperatorID:     u.User,
+		}
+		// keep the start of the maintenance when only the message or end time changed.
+		if pr.Maintenance != nil && pr.Maintenance.StartTimestamp != 0 {
+			m.StartTimestamp = pr.Maintenance.StartTimestamp
+		}
+		pr.Maintenance = m
+		return true, nil
+	})
+	if err != nil {
+		log.Errorf("error set plugin route(%s) maintenance: %s", req.Id, err)
+		if errors.Is(err, proute.ErrPluginRouteNotExsist) {
+			return nil, pb.PluginErrPluginRouteNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	log.Debugf("set plugin(%s) maintenance(%t) succ.", req.Id, req.Enabled)
+	return &pb.SetPluginMaintenanceResponse{
+		Plugin: util.ConvertModel2PluginObjectPb(p, pr, model.TKeelTenant),
+	}, nil
+}
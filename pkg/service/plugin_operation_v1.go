@@ -0,0 +1,328 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/operation"
+	"github.com/tkeel-io/tkeel/pkg/util"
+)
+
+const (
+	// the finished operations are pruned after the retention, or when there are more than the max count.
+	_operationRetention     = 7 * 24 * time.Hour
+	_operationMaxCount      = 1000
+	_operationPruneInterval = time.Hour
+	// the reports are saved in batches of the count or after the interval since the last save.
+	_reportSaveBatch    = 20
+	_reportSaveInterval = time.Second
+)
+
+type operationContextKey struct{}
+
+// operationTracker record the phases of a plugin operation into the state store.
+// all methods are safe to call on a nil tracker.
+type operationTracker struct {
+	sync.Mutex
+	op       *model.Operation
+	opOp     operation.Operator
+	unsaved  int       // the reports not saved yet.
+	lastSave time.Time // the time of the last save.
+}
+
+func withOperation(ctx context.Context, t *operationTracker) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, t)
+}
+
+func operationFromContext(ctx context.Context) *operationTracker {
+	t, ok := ctx.Value(operationContextKey{}).(*operationTracker)
+	if !ok {
+		return nil
+	}
+	return t
+}
+
+func (t *operationTracker) id() string {
+	if t == nil {
+		return ""
+	}
+	return t.op.ID
+}
+
+func (t *operationTracker) start(ctx context.Context, phase string) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	t.op.StartPhase(phase)
+	t.save(ctx)
+}
+
+func (t *operationTracker) end(ctx context.Context, phase string, err error) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	t.op.EndPhase(phase, err)
+	t.save(ctx)
+}
+
+// finish the operation, the operation finished already is not changed.
+func (t *operationTracker) finish(ctx context.Context, err error) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	if t.op.IsFinished() {
+		return
+	}
+	t.op.Finish(err)
+	t.save(ctx)
+}
+
+// report record the result of the target, the reports are saved in batches
+// and the rest are saved by the next phase change or finish.
+func (t *operationTracker) report(ctx context.Context, action, target, detail string, err error) {
+	if t == nil {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	t.op.AddReport(action, target, detail, err)
+	t.unsaved++
+	if t.unsaved >= _reportSaveBatch || time.Since(t.lastSave) >= _reportSaveInterval {
+		t.save(ctx)
+	}
+}
+
+// reports get a copy of the operation report.
+func (t *operationTracker) reports() []*model.OperationReportItem {
+	if t == nil {
+		return nil
+	}
+	t.Lock()
+	defer t.Unlock()
+	return append([]*model.OperationReportItem{}, t.op.Report...)
+}
+
+func (t *operationTracker) save(ctx context.Context) {
+	t.unsaved, t.lastSave = 0, time.Now()
+	if err := t.opOp.Update(ctx, t.op); err != nil {
+		log.Errorf("error update operation(%s): %s", t.op.ID, err)
+	}
+}
+
+// newOperation create the plugin operation and attach it to the context,
+// the operation already in the context is reused.
+func (s *PluginServiceV1) newOperation(ctx context.Context, opType, pluginID string) (context.Context, *operationTracker, error) {
+	if t := operationFromContext(ctx); t != nil {
+		return ctx, t, nil
+	}
+	op := model.NewOperation(opType, pluginID)
+	if err := s.operationOp.Create(ctx, op); err != nil {
+		return ctx, nil, errors.Wrapf(err, "create operation(%s)", op)
+	}
+	t := &operationTracker{op: op, opOp: s.operationOp, lastSave: time.Now()}
+	return withOperation(ctx, t), t, nil
+}
+
+// asyncOperation create the plugin operation and run fn in the background,
+// the operation is failed when fn returns error or panics.
+func (s *PluginServiceV1) asyncOperation(ctx context.Context, opType, pluginID string,
+	fn func(ctx context.Context) error,
+) (string, error) {
+	_, t, err := s.newOperation(ctx, opType, pluginID)
+	if err != nil {
+		return "", err
+	}
+	go func() {
+		opCtx := withOperation(context.Background(), t)
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("panic %s plugin(%s) operation(%s): %v\n%s", opType, pluginID, t.id(), r, debug.Stack())
+				t.finish(opCtx, errors.Errorf("panic: %v", r))
+			}
+		}()
+		if err := fn(opCtx); err != nil {
+			log.Errorf("error %s plugin(%s) operation(%s): %s", opType, pluginID, t.id(), err)
+			t.finish(opCtx, err)
+		}
+	}()
+	return t.id(), nil
+}
+
+// RunOperationPruner prune the finished operations periodically until the ctx done.
+func (s *PluginServiceV1) RunOperationPruner(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(_operationPruneInterval)
+		defer ticker.Stop()
+		for {
+			if err := s.pruneOperations(ctx, time.Now()); err != nil {
+				log.Errorf("error prune operations: %s", err)
+			}
+			select {
+			case <-ctx.Done():
+				log.Info("operation pruner stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *PluginServiceV1) pruneOperations(ctx context.Context, now time.Time) error {
+	ops, err := s.operationOp.List(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list operation")
+	}
+	expired := expiredOperations(ops, now, _operationRetention, _operationMaxCount)
+	if len(expired) == 0 {
+		return nil
+	}
+	if err = s.operationOp.Delete(ctx, expired...); err != nil {
+		return errors.Wrapf(err, "delete %d operations", len(expired))
+	}
+	log.Debugf("pruned %d operations", len(expired))
+	return nil
+}
+
+// expiredOperations get the finished operations not updated within the retention, and the oldest
+// finished operations beyond the max count. The operations not finished are never expired.
+func expiredOperations(ops []*model.Operation, now time.Time, retention time.Duration, maxCount int) []string {
+	finished := make([]*model.Operation, 0, len(ops))
+	for _, v := range ops {
+		if v.IsFinished() {
+			finished = append(finished, v)
+		}
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].UpdateTimestamp < finished[j].UpdateTimestamp
+	})
+	before := now.Add(-retention).Unix()
+	exceeded := len(ops) - maxCount
+	ret := make([]string, 0)
+	for i, v := range finished {
+		if v.UpdateTimestamp >= before && i >= exceeded {
+			break
+		}
+		ret = append(ret, v.ID)
+	}
+	return ret
+}
+
+func (s *PluginServiceV1) GetOperation(ctx context.Context,
+	req *pb.GetOperationRequest,
+) (*pb.GetOperationResponse, error) {
+	op, err := s.operationOp.Get(ctx, req.Id)
+	if err != nil {
+		log.Errorf("error get operation(%s): %s", req.Id, err)
+		if errors.Is(err, operation.ErrOperationNotExsist) {
+			return nil, pb.PluginErrOperationNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	return &pb.GetOperationResponse{
+		Operation: util.ConvertModel2OperationObjectPb(op),
+	}, nil
+}
+
+func (s *PluginServiceV1) ListOperations(ctx context.Context,
+	req *pb.ListOperationsRequest,
+) (*pb.ListOperationsResponse, error) {
+	ops, err := s.operationOp.List(ctx)
+	if err != nil {
+		log.Errorf("error list operation: %s", err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	filtered := make([]*model.Operation, 0, len(ops))
+	for _, v := range ops {
+		if req.PluginId != "" && v.PluginID != req.PluginId {
+			continue
+		}
+		if req.Type != "" && v.Type != req.Type {
+			continue
+		}
+		if req.Status != "" && v.Status != req.Status {
+			continue
+		}
+		filtered = append(filtered, v)
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].CreateTimestamp > filtered[j].CreateTimestamp
+	})
+	ret := &pb.ListOperationsResponse{
+		Total:      int32(len(filtered)),
+		PageNum:    req.PageNum,
+		PageSize:   req.PageSize,
+		Operations: make([]*pb.OperationObject, 0),
+	}
+	start, end := getQueryItemsStartAndEnd(int(req.PageNum), int(req.PageSize), len(filtered))
+	for _, v := range filtered[start:end] {
+		ret.Operations = append(ret.Operations, util.ConvertModel2OperationObjectPb(v))
+	}
+	return ret, nil
+}
+
+func (s *PluginServiceV1) WatchOperation(req *pb.WatchOperationRequest,
+	stream pb.Plugin_WatchOperationServer,
+) error {
+	ctx := stream.Context()
+	interval, err := time.ParseDuration(s.tkeelConf.WatchInterval)
+	if err != nil {
+		log.Errorf("error parse watch interval(%s): %s", s.tkeelConf.WatchInterval, err)
+		interval = time.Second
+	}
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	version := ""
+	for {
+		op, err := s.operationOp.Get(ctx, req.Id)
+		if err != nil {
+			log.Errorf("error get operation(%s): %s", req.Id, err)
+			if errors.Is(err, operation.ErrOperationNotExsist) {
+				return pb.PluginErrOperationNotFound()
+			}
+			return pb.PluginErrInternalStore()
+		}
+		if op.Version != version {
+			version = op.Version
+			if err = stream.Send(util.ConvertModel2OperationObjectPb(op)); err != nil {
+				return errors.Wrapf(err, "send operation(%s)", req.Id)
+			}
+		}
+		if op.IsFinished() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tick.C:
+		}
+	}
+}
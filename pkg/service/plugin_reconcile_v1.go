@@ -0,0 +1,366 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	openapi_v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/repository/helm"
+	"github.com/tkeel-io/tkeel/pkg/util"
+)
+
+// _reconcileGracePeriod the release deployed in the period may not have its plugin created yet.
+const _reconcileGracePeriod = 10 * time.Minute
+
+// ReconcilePlugins report the drifts between the helm releases, the plugins, the plugin
+// routes and the permission set, the drifts of the requested kinds are repaired only when
+// they were found by the last reconciliation too, so the repair is requested twice to confirm it.
+func (s *PluginServiceV1) ReconcilePlugins(ctx context.Context,
+	req *pb.ReconcilePluginsRequest,
+) (_ *pb.ReconcilePluginsResponse, err error) {
+	kinds := req.Kinds
+	if len(kinds) == 0 {
+		kinds = model.DriftKinds
+	}
+	repair, err := driftKindSet(kinds)
+	if err != nil {
+		log.Errorf("error reconcile plugins: %s", err)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	if req.Repair {
+		defer func() {
+			recordAudit(ctx, model.AuditActionReconcilePlugins, model.TKeelTenant, "", req, err)
+		}()
+	}
+	drifts, err := s.reconcile(ctx, func(d *model.Drift) bool {
+		return req.Repair && repair[d.Kind]
+	})
+	if err != nil {
+		log.Errorf("error reconcile plugins: %s", err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	ret := &pb.ReconcilePluginsResponse{
+		Drifts:    make([]*pb.PluginDrift, 0, len(drifts)),
+		Timestamp: time.Now().Unix(),
+	}
+	for _, v := range drifts {
+		ret.Drifts = append(ret.Drifts, &pb.PluginDrift{
+			Kind:     v.Kind,
+			Target:   v.Target,
+			Detail:   v.Detail,
+			Repaired: v.Repaired,
+			Error:    v.Error,
+		})
+	}
+	return ret, nil
+}
+
+// RunReconciler reconcile the plugin state at the reconcile interval until the ctx done,
+// a drift of the configured kinds is repaired when it is found by two reconciliations in a row.
+func (s *PluginServiceV1) RunReconciler(ctx context.Context) {
+	interval, err := parseReconcileInterval(s.tkeelConf.ReconcileInterval)
+	if err != nil {
+		log.Errorf("error plugin reconciler: %s", err)
+		return
+	}
+	if interval <= 0 {
+		log.Info("plugin reconciler disabled")
+		return
+	}
+	repair, err := driftKindSet(splitDriftKinds(s.tkeelConf.ReconcileRepair))
+	if err != nil {
+		log.Errorf("error plugin reconciler: %s", err)
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("plugin reconciler stopped")
+				return
+			case <-ticker.C:
+				if _, err := s.reconcile(ctx, func(d *model.Drift) bool {
+					return repair[d.Kind]
+				}); err != nil {
+					log.Errorf("error reconcile plugins: %s", err)
+				}
+			}
+		}
+	}()
+}
+
+// reconcile detect the drifts and repair those selected by repair,
+// the plugins being installed or uninstalled are not touched as their drifts are transient.
+func (s *PluginServiceV1) reconcile(ctx context.Context, repair func(d *model.Drift) bool) ([]*model.Drift, error) {
+	s.reconcileLock.Lock()
+	defer s.reconcileLock.Unlock()
+	releases, err := helm.ListPluginReleases(s.tkeelConf.Namespace, helm.Secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "list plugin releases")
+	}
+	ps, err := s.pluginOp.List(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list plugin")
+	}
+	routes, err := s.pluginRouteOp.List(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list plugin route")
+	}
+	permissionIDs, err := s.permissionSetPluginIDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get permission set")
+	}
+	drifts := detectDrifts(time.Now(), releases, ps, routes, permissionIDs)
+	s.repairDrifts(ctx, drifts, repair)
+	return drifts, nil
+}
+
+// repairDrifts repair the drifts selected by repair which were found by the last reconciliation too,
+// the drifts not repaired are remembered for the next reconciliation.
+func (s *PluginServiceV1) repairDrifts(ctx context.Context, drifts []*model.Drift, repair func(d *model.Drift) bool) {
+	seen := make(map[string]bool, len(drifts))
+	for _, v := range drifts {
+		if !repair(v) {
+			log.Warnf("plugin state drift: %s %s: %s", v.Kind, v.Target, v.Detail)
+			seen[v.Key()] = true
+			continue
+		}
+		if !s.reconcileSeen[v.Key()] {
+			log.Warnf("plugin state drift: %s %s: %s, repaired when found again", v.Kind, v.Target, v.Detail)
+			seen[v.Key()] = true
+			continue
+		}
+		if err := s.repairDrift(ctx, v); err != nil {
+			log.Errorf("error repair plugin state drift(%s %s): %s", v.Kind, v.Target, err)
+			v.Error = err.Error()
+			seen[v.Key()] = true
+			continue
+		}
+		log.Infof("repaired plugin state drift: %s %s: %s", v.Kind, v.Target, v.Detail)
+		v.Repaired = true
+	}
+	s.reconcileSeen = seen
+}
+
+// permissionSetPluginIDs get the plugin ids of the persisted permission set,
+// the permission set in memory is refreshed from it by the watcher.
+func (s *PluginServiceV1) permissionSetPluginIDs(ctx context.Context) ([]string, error) {
+	b, _, err := s.kvOp.Get(ctx, model.KeyPermissionSet)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get %s", model.KeyPermissionSet)
+	}
+	set := make(map[string]json.RawMessage)
+	if len(b) != 0 {
+		if err = json.Unmarshal(b, &set); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal %s", model.KeyPermissionSet)
+		}
+	}
+	ret := make([]string, 0, len(set))
+	for k := range set {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+func (s *PluginServiceV1) repairDrift(ctx context.Context, d *model.Drift) error {
+	switch d.Kind {
+	case model.DriftOrphanRelease:
+		return s.uninstallRelease(d.Target)
+	case model.DriftMissingRelease:
+		// remove the plugin as uninstalled by kubectl, the plugin depended by others is kept.
+		if _, err := s.uninstallPlugin(ctx, &pb.UninstallPluginRequest{Id: d.Target}); err != nil {
+			return errors.Wrapf(err, "uninstall plugin(%s)", d.Target)
+		}
+	case model.DriftOrphanRoute:
+		if _, err := s.pluginRouteOp.Delete(ctx, d.Target); err != nil {
+			return errors.Wrapf(err, "delete plugin route(%s)", d.Target)
+		}
+	case model.DriftMissingRoute:
+		// the plugin can be registered again by TMRegisterPlugin.
+		return s.markRegisterFailed(ctx, d.Target)
+	case model.DriftOrphanPermission:
+		if _, err := util.DeletePluginPermissionOnSet(ctx, s.kvOp, d.Target); err != nil {
+			return errors.Wrapf(err, "delete plugin(%s) permission set", d.Target)
+		}
+	case model.DriftMissingPermission:
+		p, err := s.pluginOp.Get(ctx, d.Target)
+		if err != nil {
+			return errors.Wrapf(err, "get plugin(%s)", d.Target)
+		}
+		if _, err = util.AddPluginPermissionOnSet(ctx, s.kvOp, p.ID, p.Permissions); err != nil {
+			return errors.Wrapf(err, "add plugin(%s) permission set", p.ID)
+		}
+	default:
+		return errors.Errorf("unknown drift kind: %s", d.Kind)
+	}
+	return nil
+}
+
+// detectDrifts compare the helm releases of the plugin charts, the plugins, the plugin routes and
+// the plugin ids of the permission set, the tkeel components are not checked.
+func detectDrifts(now time.Time, releases []*helm.Release, ps []*model.Plugin,
+	routes model.PluginProxyRouteMap, permissionIDs []string,
+) []*model.Drift {
+	ret := make([]*model.Drift, 0)
+	plugins := make(map[string]*model.Plugin, len(ps))
+	// release name map to the plugin id.
+	apps := make(map[string]string, len(ps))
+	for _, v := range ps {
+		plugins[v.ID] = v
+		apps[v.GetAppID()] = v.ID
+	}
+	for _, v := range routes {
+		if v.Candidate != nil {
+			apps[v.Candidate.AppID] = v.ID
+		}
+	}
+	released := make(map[string]bool, len(releases))
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Name < releases[j].Name
+	})
+	for _, v := range releases {
+		released[v.Name] = true
+		if _, ok := apps[v.Name]; ok || pluginIsTkeelComponent(v.Name) {
+			continue
+		}
+		if now.Sub(v.Updated) < _reconcileGracePeriod {
+			continue
+		}
+		ret = append(ret, &model.Drift{
+			Kind:   model.DriftOrphanRelease,
+			Target: v.Name,
+			Detail: fmt.Sprintf("release of chart %s@%s(%s) has no plugin", v.Chart, v.ChartVersion, v.Status),
+		})
+	}
+	sort.Slice(ps, func(i, j int) bool {
+		return ps[i].ID < ps[j].ID
+	})
+	for _, v := range ps {
+		if pluginIsTkeelComponent(v.ID) || v.Installer == nil {
+			continue
+		}
+		if !released[v.GetAppID()] {
+			ret = append(ret, &model.Drift{
+				Kind:   model.DriftMissingRelease,
+				Target: v.ID,
+				Detail: fmt.Sprintf("release %s not found", v.GetAppID()),
+			})
+		}
+	}
+	routeIDs := make([]string, 0, len(routes))
+	for k := range routes {
+		routeIDs = append(routeIDs, k)
+	}
+	sort.Strings(routeIDs)
+	for _, id := range routeIDs {
+		if _, ok := plugins[id]; !ok && !pluginIsTkeelComponent(id) {
+			ret = append(ret, &model.Drift{
+				Kind:   model.DriftOrphanRoute,
+				Target: id,
+				Detail: "plugin route has no plugin",
+			})
+		}
+	}
+	for _, v := range ps {
+		if pluginIsTkeelComponent(v.ID) || v.Status != openapi_v1.PluginStatus_RUNNING {
+			continue
+		}
+		if _, ok := routes[v.ID]; !ok {
+			ret = append(ret, &model.Drift{
+				Kind:   model.DriftMissingRoute,
+				Target: v.ID,
+				Detail: "running plugin has no plugin route",
+			})
+		}
+	}
+	permitted := make(map[string]bool, len(permissionIDs))
+	for _, id := range permissionIDs {
+		permitted[id] = true
+		if _, ok := plugins[id]; !ok && !pluginIsTkeelComponent(id) {
+			ret = append(ret, &model.Drift{
+				Kind:   model.DriftOrphanPermission,
+				Target: id,
+				Detail: "permissions in the permission set have no plugin",
+			})
+		}
+	}
+	for _, v := range ps {
+		if pluginIsTkeelComponent(v.ID) || v.Status != openapi_v1.PluginStatus_RUNNING {
+			continue
+		}
+		if !permitted[v.ID] {
+			ret = append(ret, &model.Drift{
+				Kind:   model.DriftMissingPermission,
+				Target: v.ID,
+				Detail: "running plugin has no permissions in the permission set",
+			})
+		}
+	}
+	return ret
+}
+
+func parseReconcileInterval(interval string) (time.Duration, error) {
+	if interval == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse reconcile interval(%s)", interval)
+	}
+	return d, nil
+}
+
+func splitDriftKinds(kinds string) []string {
+	ret := make([]string, 0)
+	for _, v := range strings.Split(kinds, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func driftKindSet(kinds []string) (map[string]bool, error) {
+	ret := make(map[string]bool, len(kinds))
+	for _, v := range kinds {
+		valid := false
+		for _, k := range model.DriftKinds {
+			if v == k {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, errors.Errorf("invalid drift kind: %s", v)
+		}
+		ret[v] = true
+	}
+	return ret, nil
+}
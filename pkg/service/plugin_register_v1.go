@@ -0,0 +1,200 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	openapi_v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/register"
+	"github.com/tkeel-io/tkeel/pkg/repository/helm"
+)
+
+// registerPending persist the pending registration, the plugin is registered
+// by HandleRegistration when its workload is ready.
+func (s *PluginServiceV1) registerPending(ctx context.Context, reg *model.Registration) error {
+	if err := register.Instance().Register(ctx, reg); err != nil {
+		log.Errorf("error register pending plugin(%s): %s", reg.PluginID, err)
+		return errors.Wrapf(err, "register pending plugin(%s)", reg.PluginID)
+	}
+	return nil
+}
+
+// HandleRegistration register the plugin of the pending registration, it returns
+// register.ErrNotReady when the plugin is not running yet.
+// When the final attempt failed, the installed plugin is marked register failed
+// and the upgraded plugin is rolled back to the previous revision.
+func (s *PluginServiceV1) HandleRegistration(ctx context.Context, reg *model.Registration, final bool) error {
+	ctx, cancel := context.WithTimeout(withOperation(ctx, s.loadOperation(ctx, reg.OperationID)), 5*time.Minute)
+	defer cancel()
+	if reg.Action == model.RegistrationActionCandidate {
+		return s.registerCandidate(ctx, reg, final)
+	}
+	resp, err := s.queryStatus(ctx, reg.PluginID)
+	if err != nil {
+		log.Warnf("register query plugin(%s) status: %s", reg.PluginID, err)
+		return s.registrationNotReady(ctx, reg, final, err)
+	}
+	if resp.Status != openapi_v1.PluginStatus_RUNNING {
+		return s.registrationNotReady(ctx, reg, final, errors.Errorf("plugin status %s", resp.Status))
+	}
+	if err = s.checkDependencesRegistered(ctx, reg); err != nil {
+		if errors.Is(err, register.ErrNotReady) {
+			return s.registrationNotReady(ctx, reg, final, err)
+		}
+		log.Errorf("error register plugin(%s): %s", reg.PluginID, err)
+		if final {
+			s.registrationFailed(ctx, reg, err)
+		}
+		return err
+	}
+	tracker := operationFromContext(ctx)
+	tracker.end(ctx, model.PhaseWaitReady, nil)
+	if reg.Action == model.RegistrationActionReconfigure && reg.OldPlugin != nil {
+		identify, err := s.queryIdentify(ctx, reg.PluginID)
+		if err == nil && reg.OldPlugin.IdentifyEqual(identify) {
+			log.Debugf("reconfigure plugin(%s) identify unchanged, skip register", reg.PluginID)
+			tracker.finish(ctx, nil)
+			return nil
+		}
+	}
+	log.Debugf("register plugin(%s) %s attempt(%d)", reg.PluginID, reg.Action, reg.Attempts+1)
+	if err = s.registerPluginProcess(ctx, reg.PluginID, reg.IsUpgrade()); err != nil {
+		log.Errorf("error register plugin(%s): %s", reg.PluginID, err)
+		if final {
+			s.registrationFailed(ctx, reg, err)
+		}
+		return err
+	}
+	log.Debugf("register plugin(%s) ok", reg.PluginID)
+	tracker.finish(ctx, nil)
+	return nil
+}
+
+// registrationNotReady return register.ErrNotReady so that the registration waits for the plugin,
+// the registration is given up if it is the final attempt.
+func (s *PluginServiceV1) registrationNotReady(ctx context.Context, reg *model.Registration, final bool, reason error) error {
+	if !final {
+		return register.ErrNotReady
+	}
+	err := errors.Wrapf(register.ErrReadyTimeout, "%s", reason)
+	log.Errorf("error register plugin(%s): %s", reg.PluginID, err)
+	s.registrationFailed(ctx, reg, err)
+	return err
+}
+
+// checkDependencesRegistered check the dependences installed along with the plugin have been registered,
+// register.ErrNotReady is returned when some of them are still waiting for the registration.
+func (s *PluginServiceV1) checkDependencesRegistered(ctx context.Context, reg *model.Registration) error {
+	for _, id := range reg.Dependences {
+		p, err := s.pluginOp.Get(ctx, id)
+		if err != nil {
+			return errors.Wrapf(err, "get dependence(%s)", id)
+		}
+		switch p.Status {
+		case openapi_v1.PluginStatus_WAIT_RUNNING:
+			return register.ErrNotReady
+		case openapi_v1.PluginStatus_ERR_REGISTER:
+			return errors.Errorf("dependence(%s) register failed", id)
+		}
+	}
+	return nil
+}
+
+// registrationFailed finish the operation of the registration given up.
+func (s *PluginServiceV1) registrationFailed(ctx context.Context, reg *model.Registration, err error) {
+	tracker := operationFromContext(ctx)
+	if len(reg.Dependences) != 0 {
+		if rbErr := s.rollbackInstallPlan(ctx, reg); rbErr != nil {
+			log.Errorf("error roll back install plugin(%s): %s", reg.PluginID, rbErr)
+			tracker.finish(ctx, errors.Wrapf(err, "roll back: %s", rbErr))
+			return
+		}
+		tracker.finish(ctx, errors.Wrapf(err, "rolled back install dependences(%v)", reg.Dependences))
+		return
+	}
+	if !reg.IsUpgrade() || reg.OldPlugin == nil {
+		if uErr := s.markRegisterFailed(ctx, reg.PluginID); uErr != nil {
+			log.Errorf("error mark plugin(%s) register failed: %s", reg.PluginID, uErr)
+		}
+		tracker.finish(ctx, err)
+		return
+	}
+	rbErr := func() error {
+		installer, err := helm.NewReleaseInstaller(reg.GetAppID(), s.tkeelConf.Namespace, helm.Secret)
+		if err != nil {
+			return errors.Wrapf(err, "new plugin(%s) release installer", reg.PluginID)
+		}
+		return s.rollbackUpgrade(ctx, reg.OldPlugin, installer, reg.Revision)
+	}()
+	if rbErr != nil {
+		log.Errorf("error roll back %s plugin(%s): %s", reg.Action, reg.PluginID, rbErr)
+		tracker.finish(ctx, errors.Wrapf(err, "roll back: %s", rbErr))
+		return
+	}
+	// the previous revision is running with the old registration.
+	log.Debugf("%s plugin(%s) rolled back to revision(%d)", reg.Action, reg.PluginID, reg.Revision)
+	tracker.finish(ctx, errors.Wrapf(err, "rolled back to revision(%d)", reg.Revision))
+}
+
+// rollbackInstallPlan uninstall the plugin and then the dependences installed along with it in reverse order.
+// A dependence which can not be uninstalled(e.g. depended by other plugins since) is kept.
+func (s *PluginServiceV1) rollbackInstallPlan(ctx context.Context, reg *model.Registration) error {
+	// the operation of the registration is finished by the caller.
+	ctx = withOperation(ctx, nil)
+	if _, err := s.uninstallPlugin(ctx, &pb.UninstallPluginRequest{Id: reg.PluginID}); err != nil {
+		return errors.Wrapf(err, "uninstall plugin(%s)", reg.PluginID)
+	}
+	for i := len(reg.Dependences) - 1; i >= 0; i-- {
+		id := reg.Dependences[i]
+		if _, err := s.uninstallPlugin(ctx, &pb.UninstallPluginRequest{Id: id}); err != nil {
+			log.Errorf("error roll back plugin(%s) dependence(%s): %s", reg.PluginID, id, err)
+		}
+	}
+	return nil
+}
+
+// markRegisterFailed mark the plugin register failed, it can be registered again by TMRegisterPlugin.
+func (s *PluginServiceV1) markRegisterFailed(ctx context.Context, pluginID string) error {
+	p, err := s.pluginOp.Get(ctx, pluginID)
+	if err != nil {
+		return errors.Wrapf(err, "get plugin(%s)", pluginID)
+	}
+	p.Status = openapi_v1.PluginStatus_ERR_REGISTER
+	if err = s.pluginOp.Update(ctx, p); err != nil {
+		return errors.Wrapf(err, "update plugin(%s)", pluginID)
+	}
+	return nil
+}
+
+// loadOperation load the tracker of the operation, nil is returned when the operation is not found.
+func (s *PluginServiceV1) loadOperation(ctx context.Context, operationID string) *operationTracker {
+	if operationID == "" {
+		return nil
+	}
+	op, err := s.operationOp.Get(ctx, operationID)
+	if err != nil {
+		log.Errorf("error get operation(%s): %s", operationID, err)
+		return nil
+	}
+	return &operationTracker{op: op, opOp: s.operationOp}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+	"github.com/tkeel-io/tkeel/pkg/repository/helm"
+	"gopkg.in/yaml.v3"
+)
+
+func (s *PluginServiceV1) GetPluginRelease(ctx context.Context,
+	req *pb.GetPluginReleaseRequest,
+) (*pb.GetPluginReleaseResponse, error) {
+	if req.Revision < 0 {
+		log.Errorf("error get plugin(%s) release invalid revision: %d", req.Id, req.Revision)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	installer, err := s.releaseInstaller(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := installer.Release(int(req.Revision))
+	if err != nil {
+		log.Errorf("error get plugin(%s) release revision(%d): %s", req.Id, req.Revision, err)
+		if errors.Is(err, helm.ErrNotFound) {
+			return nil, pb.PluginErrInvalidArgument()
+		}
+		return nil, pb.PluginErrGetRelease()
+	}
+	ret, err := convertRelease2Pb(rel)
+	if err != nil {
+		log.Errorf("error convert plugin(%s) release: %s", req.Id, err)
+		return nil, pb.PluginErrGetRelease()
+	}
+	return &pb.GetPluginReleaseResponse{Release: ret}, nil
+}
+
+func (s *PluginServiceV1) ListPluginReleases(ctx context.Context,
+	req *pb.ListPluginReleasesRequest,
+) (*pb.ListPluginReleasesResponse, error) {
+	installer, err := s.releaseInstaller(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	rels, err := installer.History()
+	if err != nil {
+		log.Errorf("error get plugin(%s) release history: %s", req.Id, err)
+		if errors.Is(err, helm.ErrNotFound) {
+			return nil, pb.PluginErrPluginNotFound()
+		}
+		return nil, pb.PluginErrGetRelease()
+	}
+	ret := make([]*pb.PluginRelease, 0, len(rels))
+	for _, v := range rels {
+		r, err := convertRelease2Pb(v)
+		if err != nil {
+			log.Errorf("error convert plugin(%s) release: %s", req.Id, err)
+			return nil, pb.PluginErrGetRelease()
+		}
+		ret = append(ret, r)
+	}
+	return &pb.ListPluginReleasesResponse{Releases: ret}, nil
+}
+
+// releaseInstaller get the installer of the installed plugin release.
+func (s *PluginServiceV1) releaseInstaller(ctx context.Context, pluginID string) (*helm.Installer, error) {
+	p, err := s.pluginOp.Get(ctx, pluginID)
+	if err != nil {
+		log.Errorf("error get plugin(%s): %s", pluginID, err)
+		if errors.Is(err, plugin.ErrPluginNotExsist) {
+			return nil, pb.PluginErrPluginNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	installer, err := helm.NewReleaseInstaller(p.GetAppID(), s.tkeelConf.Namespace, helm.Secret)
+	if err != nil {
+		log.Errorf("error new plugin(%s) release installer: %s", pluginID, err)
+		return nil, pb.PluginErrGetRelease()
+	}
+	return installer, nil
+}
+
+func convertRelease2Pb(rel *helm.Release) (*pb.PluginRelease, error) {
+	ret := &pb.PluginRelease{
+		Revision:     int32(rel.Revision),
+		Status:       rel.Status,
+		Chart:        rel.Chart,
+		ChartVersion: rel.ChartVersion,
+		AppVersion:   rel.AppVersion,
+		Description:  rel.Description,
+		Manifest:     rel.Manifest,
+		Notes:        rel.Notes,
+	}
+	if !rel.Updated.IsZero() {
+		ret.UpdatedTimestamp = rel.Updated.Unix()
+	}
+	if rel.Values != nil {
+		b, err := yaml.Marshal(rel.Values)
+		if err != nil {
+			return nil, errors.Wrap(err, "marshal values")
+		}
+		ret.Values = b
+	}
+	return ret, nil
+}
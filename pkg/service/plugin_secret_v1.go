@@ -0,0 +1,133 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+	"github.com/tkeel-io/tkeel/pkg/repository/helm"
+	"github.com/tkeel-io/tkeel/pkg/util"
+)
+
+// defaultSecretGracePeriod the previous secret is accepted in the grace period after rotated,
+// the plugin workload is restarted so that the sidecar picks up the new secret.
+const defaultSecretGracePeriod = 24 * time.Hour
+
+// WorkloadRestarter restart the plugin workload so that the sidecar loads the changed components.
+type WorkloadRestarter interface {
+	RestartWorkload(ctx context.Context, name string) error
+}
+
+func (s *PluginServiceV1) RotatePluginSecret(ctx context.Context,
+	req *pb.RotatePluginSecretRequest,
+) (_ *pb.RotatePluginSecretResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionRotatePluginSecret, model.TKeelTenant, req.Id, req, err)
+	}()
+	rbStack := util.NewRollbackStack()
+	defer rbStack.Run()
+	if req.GracePeriod < 0 {
+		log.Errorf("error rotate plugin(%s) secret invalid grace period: %d", req.Id, req.GracePeriod)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	grace := defaultSecretGracePeriod
+	if req.GracePeriod > 0 {
+		grace = time.Duration(req.GracePeriod) * time.Second
+	}
+	p, err := s.pluginOp.Get(ctx, req.Id)
+	if err != nil {
+		log.Errorf("error get plugin(%s): %s", req.Id, err)
+		if errors.Is(err, plugin.ErrPluginNotExsist) {
+			return nil, pb.PluginErrPluginNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	if pluginIsTkeelComponent(p.ID) {
+		log.Errorf("error rotate tkeel component plugin(%s) secret", p.ID)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	if err = s.checkNoCandidate(ctx, p.ID); err != nil {
+		return nil, err
+	}
+	secret, err := util.GenerateSecret()
+	if err != nil {
+		log.Errorf("error generate plugin(%s) secret: %s", req.Id, err)
+		return nil, pb.PluginErrRotateSecret()
+	}
+	installer, err := helm.NewReleaseInstaller(p.GetAppID(), s.tkeelConf.Namespace, helm.Secret)
+	if err != nil {
+		log.Errorf("error new plugin(%s) release installer: %s", req.Id, err)
+		return nil, pb.PluginErrRotateSecret()
+	}
+	revision, err := installer.Revision()
+	if err != nil {
+		log.Errorf("error get plugin(%s) release revision: %s", req.Id, err)
+		return nil, pb.PluginErrRotateSecret()
+	}
+	// inject the new secret into the plugin config.
+	if err = installer.Reconfigure(&repository.Option{Key: "secret", Value: secret}); err != nil {
+		log.Errorf("error reconfigure plugin(%s) secret: %s", req.Id, err)
+		return nil, pb.PluginErrRotateSecret()
+	}
+	rbStack = append(rbStack, func() error {
+		log.Debugf("rotate plugin(%s) secret roll back run.", req.Id)
+		if _, err := installer.Rollback(revision); err != nil {
+			return errors.Wrapf(err, "rollback plugin(%s) to revision(%d)", req.Id, revision)
+		}
+		return nil
+	})
+	var oldP *model.Plugin
+	if err = s.updatePluginLocked(ctx, p.ID, func(latest *model.Plugin) bool {
+		oldP, p = latest.Clone(), latest
+		p.RotateSecret(secret, grace)
+		return true
+	}); err != nil {
+		log.Errorf("error update plugin(%s): %s", req.Id, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	rbStack = append(rbStack, func() error {
+		log.Debugf("rotate plugin(%s) secret restore run.", req.Id)
+		return s.updatePluginLocked(ctx, oldP.ID, func(latest *model.Plugin) bool {
+			latest.Secret, latest.PreviousSecret = oldP.Secret, oldP.PreviousSecret
+			latest.PreviousSecretExpire = oldP.PreviousSecretExpire
+			return true
+		})
+	})
+	// the sidecar loads the oauth2 component with the new secret when restarted,
+	// the pods not restarted yet keep using the previous secret in the grace period.
+	if s.workload == nil {
+		log.Errorf("error restart plugin(%s) workload: no workload restarter", req.Id)
+		return nil, pb.PluginErrRotateSecret()
+	}
+	if err = s.workload.RestartWorkload(ctx, p.GetAppID()); err != nil {
+		log.Errorf("error restart plugin(%s) workload: %s", req.Id, err)
+		return nil, pb.PluginErrRotateSecret()
+	}
+	rbStack = util.NewRollbackStack()
+	log.Debugf("rotate plugin(%s) secret succ.", req.Id)
+	return &pb.RotatePluginSecretResponse{
+		Plugin:               util.ConvertModel2PluginObjectPb(p, nil, model.TKeelTenant),
+		PreviousSecretExpire: p.PreviousSecretExpire,
+	}, nil
+}
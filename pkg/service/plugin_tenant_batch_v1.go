@@ -0,0 +1,273 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	s_model "github.com/tkeel-io/security/model"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/operation"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+	"github.com/tkeel-io/tkeel/pkg/util"
+)
+
+const (
+	defaultBatchConcurrency = 8
+	maxBatchConcurrency     = 32
+)
+
+// batchTenantAction the action of a batch tenant operation.
+type batchTenantAction struct {
+	opType       string
+	reportAction string
+	// skip return whether the tenant is skipped, e.g. the tenant enabled the plugin already.
+	skip func(p *model.Plugin, tenantID string) bool
+	// run the action for the tenant.
+	run func(ctx context.Context, pluginID, tenantID string, extra []byte) error
+}
+
+func (s *PluginServiceV1) BatchTenantEnable(ctx context.Context,
+	req *pb.BatchTenantRequest,
+) (_ *pb.BatchTenantResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionBatchTenantEnable, "", req.Id, req, err)
+	}()
+	return s.batchTenant(ctx, req, &batchTenantAction{
+		opType:       model.OperationTypeBatchTenantEnable,
+		reportAction: model.ReportActionTenantEnable,
+		skip: func(p *model.Plugin, tenantID string) bool {
+			return p.CheckTenantEnable(tenantID)
+		},
+		run: func(ctx context.Context, pluginID, tenantID string, extra []byte) error {
+			_, _, err := s.tenantEnablePlugin(ctx, false, tenantID, model.TKeelUser, pluginID, extra)
+			return err
+		},
+	})
+}
+
+func (s *PluginServiceV1) BatchTenantDisable(ctx context.Context,
+	req *pb.BatchTenantRequest,
+) (_ *pb.BatchTenantResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionBatchTenantDisable, "", req.Id, req, err)
+	}()
+	return s.batchTenant(ctx, req, &batchTenantAction{
+		opType:       model.OperationTypeBatchTenantDisable,
+		reportAction: model.ReportActionTenantDisable,
+		skip: func(p *model.Plugin, tenantID string) bool {
+			return !p.CheckTenantEnable(tenantID)
+		},
+		run: s.tenantDisablePlugin,
+	})
+}
+
+func (s *PluginServiceV1) batchTenant(ctx context.Context, req *pb.BatchTenantRequest,
+	action *batchTenantAction,
+) (*pb.BatchTenantResponse, error) {
+	if req.Id == "" || req.Concurrency < 0 {
+		log.Error("error invalid batch tenant request")
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	p, err := s.pluginOp.Get(ctx, req.Id)
+	if err != nil {
+		log.Errorf("error get plugin(%s): %s", req.Id, err)
+		if errors.Is(err, plugin.ErrPluginNotExsist) {
+			return nil, pb.PluginErrInvalidArgument()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	tenants, err := s.batchTenants(ctx, req, action.opType)
+	if err != nil {
+		log.Errorf("error plugin(%s) %s resolve tenants: %s", req.Id, action.opType, err)
+		if errors.Is(err, operation.ErrOperationNotExsist) {
+			return nil, pb.PluginErrOperationNotFound()
+		}
+		return nil, pb.PluginErrInvalidArgument().WithMessage(err.Error())
+	}
+	run := func(ctx context.Context) error {
+		return s.runBatchTenant(ctx, p, tenants, req, action)
+	}
+	if req.Async {
+		opID, err := s.asyncOperation(ctx, action.opType, req.Id, run)
+		if err != nil {
+			log.Errorf("error plugin(%s) %s async: %s", req.Id, action.opType, err)
+			return nil, pb.PluginErrInternalStore()
+		}
+		return &pb.BatchTenantResponse{OperationId: opID, Total: int32(len(tenants))}, nil
+	}
+	ctx, tracker, err := s.newOperation(ctx, action.opType, req.Id)
+	if err != nil {
+		log.Errorf("error plugin(%s) %s new operation: %s", req.Id, action.opType, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	// the failed tenants are in the report, they can be retried with the operation id.
+	if err = run(ctx); err != nil {
+		log.Errorf("error plugin(%s) %s: %s", req.Id, action.opType, err)
+	}
+	return convertBatchTenantReport(tracker.id(), tenants, tracker.reports()), nil
+}
+
+// batchTenants get the tenants of the batch request, the failed tenants of the operation
+// are retried when the retry operation id is set.
+func (s *PluginServiceV1) batchTenants(ctx context.Context, req *pb.BatchTenantRequest, opType string) ([]string, error) {
+	set := make(map[string]struct{})
+	if req.RetryOperationId != "" {
+		op, err := s.operationOp.Get(ctx, req.RetryOperationId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get operation(%s)", req.RetryOperationId)
+		}
+		if op.PluginID != req.Id || op.Type != opType {
+			return nil, errors.Errorf("operation(%s) is not %s of plugin(%s)", op.ID, opType, req.Id)
+		}
+		if !op.IsFinished() {
+			return nil, errors.Errorf("operation(%s) is %s", op.ID, op.Status)
+		}
+		for _, v := range op.Report {
+			if v.Status == model.OperationStatusFailed {
+				set[v.Target] = struct{}{}
+			}
+		}
+	} else {
+		for _, v := range req.Tenants {
+			set[v] = struct{}{}
+		}
+		if req.Selector != nil && (req.Selector.All || req.Selector.KeyWords != "") {
+			daoTenant := &s_model.Tenant{}
+			_, ts, err := daoTenant.List(s.db, nil, nil, req.Selector.KeyWords)
+			if err != nil {
+				return nil, errors.Wrap(err, "list tenant")
+			}
+			for _, v := range ts {
+				set[v.ID] = struct{}{}
+			}
+		}
+	}
+	delete(set, "")
+	delete(set, model.TKeelTenant)
+	if len(set) == 0 {
+		return nil, errors.New("no tenant selected")
+	}
+	ret := make([]string, 0, len(set))
+	for k := range set {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+// runBatchTenant run the action for the tenants with bounded concurrency,
+// the result of every tenant is recorded in the operation report.
+func (s *PluginServiceV1) runBatchTenant(ctx context.Context, p *model.Plugin, tenants []string,
+	req *pb.BatchTenantRequest, action *batchTenantAction,
+) error {
+	concurrency := int(req.Concurrency)
+	if concurrency == 0 {
+		concurrency = defaultBatchConcurrency
+	}
+	if concurrency > maxBatchConcurrency {
+		concurrency = maxBatchConcurrency
+	}
+	tracker := operationFromContext(ctx)
+	tracker.start(ctx, model.PhaseTenants)
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed int
+	)
+	sem := make(chan struct{}, concurrency)
+	for _, tenantID := range tenants {
+		if action.skip(p, tenantID) {
+			tracker.report(ctx, action.reportAction, tenantID, "skipped", nil)
+			continue
+		}
+		tenantID := tenantID
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			err := action.run(ctx, p.ID, tenantID, req.Extra)
+			if err != nil {
+				log.Errorf("error plugin(%s) %s tenant(%s): %s", p.ID, action.reportAction, tenantID, err)
+				mu.Lock()
+				failed++
+				mu.Unlock()
+			}
+			tracker.report(ctx, action.reportAction, tenantID, "", err)
+		}()
+	}
+	wg.Wait()
+	var err error
+	if failed > 0 {
+		err = errors.Errorf("%d of %d tenants failed", failed, len(tenants))
+	}
+	tracker.end(ctx, model.PhaseTenants, err)
+	tracker.finish(ctx, err)
+	return err
+}
+
+// tenantDisablePlugin disable the plugin for the tenant, the changes are rolled back when failed.
+func (s *PluginServiceV1) tenantDisablePlugin(ctx context.Context, pluginID, tenantID string, extra []byte) error {
+	rbStack := util.NewRollbackStack()
+	defer rbStack.Run()
+	rb, err := s.requestTenantDisable(ctx, pluginID, tenantID, extra)
+	if err != nil {
+		return errors.Wrapf(err, "request(%s) tenant(%s) disable", pluginID, tenantID)
+	}
+	rbStack = append(rbStack, rb)
+	if _, err = s.tenantPluginOp.DeleteTenantPlugin(tenantID, pluginID); err != nil {
+		return errors.Wrapf(err, "delete tenant(%s) plugin(%s) rbac", tenantID, pluginID)
+	}
+	rbStack = append(rbStack, func() error {
+		log.Debugf("tenant(%s) disable plugin(%s) rbac roll back run.", tenantID, pluginID)
+		if _, err := s.tenantPluginOp.AddTenantPlugin(tenantID, pluginID); err != nil {
+			return errors.Wrapf(err, "add tenant(%s) plugin(%s)", tenantID, pluginID)
+		}
+		return nil
+	})
+	if err = s.updatePluginLocked(ctx, pluginID, func(p *model.Plugin) bool {
+		return p.TenantDisable(tenantID)
+	}); err != nil {
+		return errors.Wrapf(err, "tenant(%s) disable(%s) update plugin", tenantID, pluginID)
+	}
+	rbStack = util.NewRollbackStack()
+	return nil
+}
+
+func convertBatchTenantReport(opID string, tenants []string, report []*model.OperationReportItem) *pb.BatchTenantResponse {
+	ret := &pb.BatchTenantResponse{
+		OperationId: opID,
+		Total:       int32(len(tenants)),
+		Report:      util.ConvertModel2OperationReportPb(report),
+	}
+	for _, v := range report {
+		if v.Status == model.OperationStatusFailed {
+			ret.Failed++
+		} else {
+			ret.Succeeded++
+		}
+	}
+	return ret
+}
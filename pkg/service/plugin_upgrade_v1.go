@@ -0,0 +1,452 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	g_version "github.com/hashicorp/go-version"
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/kit/log"
+	openapi_v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/hub"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/operation"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+	"github.com/tkeel-io/tkeel/pkg/repository/helm"
+	"github.com/tkeel-io/tkeel/pkg/util"
+)
+
+const _upgradeScheduleInterval = time.Minute
+
+// upgradeSet the available upgrades of the installed plugins found in the last refresh.
+type upgradeSet struct {
+	sync.RWMutex
+	items     map[string]*model.AvailableUpgrade
+	timestamp int64
+}
+
+func (us *upgradeSet) list() ([]*model.AvailableUpgrade, int64) {
+	us.RLock()
+	defer us.RUnlock()
+	ret := make([]*model.AvailableUpgrade, 0, len(us.items))
+	for _, v := range us.items {
+		c := *v
+		ret = append(ret, &c)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].PluginID < ret[j].PluginID
+	})
+	return ret, us.timestamp
+}
+
+func (s *PluginServiceV1) SetPluginUpgradePolicy(ctx context.Context,
+	req *pb.SetPluginUpgradePolicyRequest,
+) (_ *pb.SetPluginUpgradePolicyResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionSetUpgradePolicy, model.TKeelTenant, req.Id, req, err)
+	}()
+	if !model.ValidUpgradePolicy(req.Policy) {
+		log.Errorf("error set plugin(%s) invalid upgrade policy: %s", req.Id, req.Policy)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	if pluginIsTkeelComponent(req.Id) {
+		log.Errorf("error set tkeel component plugin(%s) upgrade policy", req.Id)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	if err = s.updatePluginLocked(ctx, req.Id, func(p *model.Plugin) bool {
+		if p.UpgradePolicy == req.Policy {
+			return false
+		}
+		p.UpgradePolicy = req.Policy
+		return true
+	}); err != nil {
+		log.Errorf("error set plugin(%s) upgrade policy(%s): %s", req.Id, req.Policy, err)
+		if errors.Is(err, plugin.ErrPluginNotExsist) {
+			return nil, pb.PluginErrPluginNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	p, err := s.pluginOp.Get(ctx, req.Id)
+	if err != nil {
+		log.Errorf("error get plugin(%s): %s", req.Id, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	if err = s.RefreshAvailableUpgrades(ctx); err != nil {
+		log.Warnf("refresh available upgrades: %s", err)
+	}
+	log.Debugf("set plugin(%s) upgrade policy(%s) succ.", req.Id, req.Policy)
+	return &pb.SetPluginUpgradePolicyResponse{
+		Plugin: util.ConvertModel2PluginObjectPb(p, nil, model.TKeelTenant),
+	}, nil
+}
+
+func (s *PluginServiceV1) ListAvailableUpgrades(ctx context.Context,
+	req *pb.ListAvailableUpgradesRequest,
+) (*pb.ListAvailableUpgradesResponse, error) {
+	_, ts := s.upgrades.list()
+	if req.Refresh || ts == 0 {
+		if err := s.RefreshAvailableUpgrades(ctx); err != nil {
+			log.Errorf("error refresh available upgrades: %s", err)
+			return nil, pb.PluginErrInternalStore()
+		}
+	}
+	items, ts := s.upgrades.list()
+	ret := &pb.ListAvailableUpgradesResponse{
+		Upgrades:          make([]*pb.AvailableUpgrade, 0, len(items)),
+		RefreshTimestamp:  ts,
+		MaintenanceWindow: s.tkeelConf.UpgradeMaintenanceWindow,
+	}
+	for _, v := range items {
+		ret.Upgrades = append(ret.Upgrades, &pb.AvailableUpgrade{
+			Id:              v.PluginID,
+			Repo:            v.Repo,
+			Installer:       v.Installer,
+			CurrentVersion:  v.CurrentVersion,
+			LatestVersion:   v.LatestVersion,
+			Policy:          v.Policy,
+			AutoVersion:     v.AutoVersion,
+			DetectTimestamp: v.DetectTimestamp,
+		})
+	}
+	return ret, nil
+}
+
+// RefreshAvailableUpgrades check the repository indexes for the newer versions of the installed plugins,
+// it is called after the hub refreshed the repository indexes.
+func (s *PluginServiceV1) RefreshAvailableUpgrades(ctx context.Context) error {
+	ps, err := s.pluginOp.List(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list plugin")
+	}
+	old, _ := s.upgrades.list()
+	detected := make(map[string]*model.AvailableUpgrade, len(old))
+	for _, v := range old {
+		detected[v.PluginID] = v
+	}
+	now := time.Now().Unix()
+	items := make(map[string]*model.AvailableUpgrade)
+	for _, p := range ps {
+		if pluginIsTkeelComponent(p.ID) || p.Installer == nil {
+			continue
+		}
+		versions, err := installerVersions(p.Installer.Repo, p.Installer.Name)
+		if err != nil {
+			log.Warnf("check plugin(%s) upgrades: %s", p.ID, err)
+			continue
+		}
+		policy := p.UpgradePolicy
+		if policy == "" {
+			policy = model.UpgradePolicyManual
+		}
+		latest, auto := selectUpgradeVersions(policy, p.Installer.Version, versions)
+		if latest == "" {
+			continue
+		}
+		if !versionNewer(auto, p.FailedAutoVersion) {
+			auto = ""
+		}
+		u := &model.AvailableUpgrade{
+			PluginID:        p.ID,
+			Repo:            p.Installer.Repo,
+			Installer:       p.Installer.Name,
+			CurrentVersion:  p.Installer.Version,
+			LatestVersion:   latest,
+			Policy:          policy,
+			AutoVersion:     auto,
+			DetectTimestamp: now,
+		}
+		if d, ok := detected[p.ID]; ok && d.LatestVersion == latest {
+			u.DetectTimestamp = d.DetectTimestamp
+		}
+		items[p.ID] = u
+	}
+	s.upgrades.Lock()
+	s.upgrades.items, s.upgrades.timestamp = items, now
+	s.upgrades.Unlock()
+	log.Debugf("refresh available upgrades: %d plugins", len(items))
+	return nil
+}
+
+// autoUpgradeOperation the automatic upgrade waiting for its operation finished.
+type autoUpgradeOperation struct {
+	operationID string
+	version     string
+}
+
+// RunUpgradeScheduler report the new versions of the notify plugins and upgrade the plugins whose policy
+// allows it inside the maintenance window until the ctx done, the failed automatic upgrade version is
+// recorded on the plugin and not retried until a newer version found.
+func (s *PluginServiceV1) RunUpgradeScheduler(ctx context.Context) {
+	window, err := parseMaintenanceWindow(s.tkeelConf.UpgradeMaintenanceWindow)
+	if err != nil {
+		log.Errorf("error plugin upgrade scheduler: %s", err)
+		return
+	}
+	if window == nil {
+		log.Info("plugin automatic upgrades disabled, no maintenance window")
+	}
+	go func() {
+		notified := make(map[string]string)
+		pending := make(map[string]*autoUpgradeOperation)
+		ticker := time.NewTicker(_upgradeScheduleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("plugin upgrade scheduler stopped")
+				return
+			case <-ticker.C:
+				s.scheduleUpgrades(ctx, window, notified, pending)
+			}
+		}
+	}()
+}
+
+func (s *PluginServiceV1) scheduleUpgrades(ctx context.Context, window *maintenanceWindow,
+	notified map[string]string, pending map[string]*autoUpgradeOperation,
+) {
+	s.checkAutoUpgrades(ctx, pending)
+	items, _ := s.upgrades.list()
+	inWindow := window != nil && window.contains(time.Now().UTC())
+	for _, v := range items {
+		if v.Policy == model.UpgradePolicyNotify && notified[v.PluginID] != v.LatestVersion {
+			log.Warnf("plugin %s upgrade available: %s -> %s", v.PluginID, v.CurrentVersion, v.LatestVersion)
+			notified[v.PluginID] = v.LatestVersion
+		}
+		if !inWindow || v.AutoVersion == "" || pending[v.PluginID] != nil {
+			continue
+		}
+		opID, err := s.autoUpgrade(ctx, v)
+		if err != nil {
+			log.Errorf("error plugin(%s) automatic upgrade to %s: %s", v.PluginID, v.AutoVersion, err)
+			s.autoUpgradeFailed(ctx, v.PluginID, v.AutoVersion)
+			continue
+		}
+		if opID != "" {
+			pending[v.PluginID] = &autoUpgradeOperation{operationID: opID, version: v.AutoVersion}
+		}
+	}
+}
+
+// checkAutoUpgrades check the operations of the pending automatic upgrades, the upgrade failed
+// after the registration(and rolled back) is recorded when its operation finished.
+func (s *PluginServiceV1) checkAutoUpgrades(ctx context.Context, pending map[string]*autoUpgradeOperation) {
+	for pluginID, v := range pending {
+		op, err := s.operationOp.Get(ctx, v.operationID)
+		if err != nil {
+			if !errors.Is(err, operation.ErrOperationNotExsist) {
+				log.Warnf("get plugin(%s) automatic upgrade operation(%s): %s", pluginID, v.operationID, err)
+				continue
+			}
+			delete(pending, pluginID)
+			continue
+		}
+		if !op.IsFinished() {
+			continue
+		}
+		delete(pending, pluginID)
+		if op.Status == model.OperationStatusFailed {
+			log.Errorf("error plugin(%s) automatic upgrade to %s failed: %s", pluginID, v.version, op.Message)
+			s.autoUpgradeFailed(ctx, pluginID, v.version)
+		}
+	}
+}
+
+// autoUpgradeFailed record the failed automatic upgrade version on the plugin.
+func (s *PluginServiceV1) autoUpgradeFailed(ctx context.Context, pluginID, version string) {
+	if err := s.updatePluginLocked(ctx, pluginID, func(p *model.Plugin) bool {
+		if p.FailedAutoVersion == version {
+			return false
+		}
+		p.FailedAutoVersion = version
+		return true
+	}); err != nil {
+		log.Errorf("error record plugin(%s) automatic upgrade %s failed: %s", pluginID, version, err)
+	}
+}
+
+// autoUpgrade upgrade the running plugin through the upgrade flow, the values supplied by the user are kept,
+// it returns the operation of the upgrade, empty if skipped.
+func (s *PluginServiceV1) autoUpgrade(ctx context.Context, u *model.AvailableUpgrade) (string, error) {
+	p, err := s.pluginOp.Get(ctx, u.PluginID)
+	if err != nil {
+		return "", errors.Wrapf(err, "get plugin(%s)", u.PluginID)
+	}
+	if p.Installer == nil || p.Installer.Version != u.CurrentVersion || p.Status != openapi_v1.PluginStatus_RUNNING {
+		log.Debugf("skip plugin(%s) automatic upgrade, plugin changed or not running", u.PluginID)
+		return "", nil
+	}
+	if !versionNewer(u.AutoVersion, p.FailedAutoVersion) {
+		log.Debugf("skip plugin(%s) automatic upgrade, %s failed before", u.PluginID, p.FailedAutoVersion)
+		return "", nil
+	}
+	installer, err := helm.NewReleaseInstaller(p.GetAppID(), s.tkeelConf.Namespace, helm.Secret)
+	if err != nil {
+		return "", errors.Wrapf(err, "new plugin(%s) release installer", u.PluginID)
+	}
+	values, err := installer.UserValues()
+	if err != nil {
+		return "", errors.Wrapf(err, "get plugin(%s) values", u.PluginID)
+	}
+	configuration, err := json.Marshal(values)
+	if err != nil {
+		return "", errors.Wrapf(err, "marshal plugin(%s) values", u.PluginID)
+	}
+	log.Infof("plugin %s automatic upgrade(%s): %s -> %s", u.PluginID, u.Policy, u.CurrentVersion, u.AutoVersion)
+	resp, err := s.UpgradePlugin(ctx, &pb.UpgradePluginRequest{
+		Id: u.PluginID,
+		Installer: &pb.Installer{
+			Repo:          u.Repo,
+			Name:          u.Installer,
+			Version:       u.AutoVersion,
+			Configuration: configuration,
+			Type:          pb.ConfigurationType_JSON,
+		},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "upgrade plugin")
+	}
+	return resp.OperationId, nil
+}
+
+// versionNewer return whether the version is newer than the old one, any valid version is newer than empty.
+func versionNewer(ver, old string) bool {
+	if old == "" {
+		return true
+	}
+	o, err := g_version.NewVersion(old)
+	if err != nil {
+		return true
+	}
+	v, err := g_version.NewVersion(ver)
+	if err != nil {
+		return false
+	}
+	return v.GreaterThan(o)
+}
+
+// installerVersions get the versions of the installer in the repository.
+func installerVersions(repoName, name string) ([]string, error) {
+	repo, err := hub.GetInstance().Get(repoName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get repo(%s)", repoName)
+	}
+	briefs, err := repo.Search(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "search repo(%s) installer(%s)", repoName, name)
+	}
+	ret := make([]string, 0, len(briefs))
+	for _, v := range briefs {
+		if v.Name == name {
+			ret = append(ret, v.Version)
+		}
+	}
+	return ret, nil
+}
+
+// selectUpgradeVersions get the latest version newer than the current version and the version
+// the policy upgrades to automatically, the pre-releases and invalid versions are ignored.
+func selectUpgradeVersions(policy, current string, versions []string) (latest, auto string) {
+	cur, err := g_version.NewVersion(current)
+	if err != nil {
+		return "", ""
+	}
+	var latestV, autoV *g_version.Version
+	for _, v := range versions {
+		ver, err := g_version.NewVersion(v)
+		if err != nil || ver.Prerelease() != "" || !ver.GreaterThan(cur) {
+			continue
+		}
+		if latestV == nil || ver.GreaterThan(latestV) {
+			latestV = ver
+		}
+		if !autoUpgradeAllowed(policy, cur, ver) {
+			continue
+		}
+		if autoV == nil || ver.GreaterThan(autoV) {
+			autoV = ver
+		}
+	}
+	if latestV != nil {
+		latest = latestV.Original()
+	}
+	if autoV != nil {
+		auto = autoV.Original()
+	}
+	return latest, auto
+}
+
+func autoUpgradeAllowed(policy string, cur, ver *g_version.Version) bool {
+	cs, vs := cur.Segments(), ver.Segments()
+	switch policy {
+	case model.UpgradePolicyPatchAuto:
+		return cs[0] == vs[0] && cs[1] == vs[1]
+	case model.UpgradePolicyMinorAuto:
+		return cs[0] == vs[0]
+	}
+	return false
+}
+
+// maintenanceWindow the daily time range in minutes of the day, the end is excluded.
+type maintenanceWindow struct {
+	start int
+	end   int
+}
+
+// parseMaintenanceWindow parse the window such as "02:00-04:00", the window may cross midnight.
+// Empty means no window.
+func parseMaintenanceWindow(s string) (*maintenanceWindow, error) {
+	if s = strings.TrimSpace(s); s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return nil, errors.Errorf("invalid maintenance window(%s)", s)
+	}
+	ret := &maintenanceWindow{}
+	for i, v := range parts {
+		t, err := time.Parse("15:04", strings.TrimSpace(v))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid maintenance window(%s)", s)
+		}
+		m := t.Hour()*60 + t.Minute()
+		if i == 0 {
+			ret.start = m
+		} else {
+			ret.end = m
+		}
+	}
+	if ret.start == ret.end {
+		return nil, errors.Errorf("invalid maintenance window(%s): empty range", s)
+	}
+	return ret, nil
+}
+
+func (w *maintenanceWindow) contains(t time.Time) bool {
+	m := t.Hour()*60 + t.Minute()
+	if w.start < w.end {
+		return m >= w.start && m < w.end
+	}
+	return m >= w.start || m < w.end
+}
@@ -19,8 +19,10 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"regexp"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/casbin/casbin/v2"
@@ -36,9 +38,9 @@ import (
 	"github.com/tkeel-io/tkeel/pkg/hub"
 	"github.com/tkeel-io/tkeel/pkg/model"
 	"github.com/tkeel-io/tkeel/pkg/model/kv"
+	"github.com/tkeel-io/tkeel/pkg/model/operation"
 	"github.com/tkeel-io/tkeel/pkg/model/plugin"
 	"github.com/tkeel-io/tkeel/pkg/model/proute"
-	"github.com/tkeel-io/tkeel/pkg/register"
 	"github.com/tkeel-io/tkeel/pkg/repository"
 	"github.com/tkeel-io/tkeel/pkg/repository/helm"
 	"github.com/tkeel-io/tkeel/pkg/util"
@@ -46,6 +48,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
+	"helm.sh/helm/v3/pkg/storage/driver"
 )
 
 var (
@@ -64,10 +67,18 @@ type PluginServiceV1 struct {
 	openapiClient  openapi.Client
 	db             *gorm.DB
 	rbacOp         *casbin.SyncedEnforcer
+	operationOp    operation.Operator
+	workload       WorkloadRestarter
+	pluginLocks    sync.Map // plugin id map to the mutex of updating the plugin.
+	routeLocks     sync.Map // plugin id map to the mutex of updating the plugin route.
+	reconcileLock  sync.Mutex
+	reconcileSeen  map[string]bool // the drifts found by the last reconciliation and not repaired.
+	upgrades       *upgradeSet
 }
 
 func NewPluginServiceV1(rbacOp *casbin.SyncedEnforcer, db *gorm.DB, conf *config.TkeelConf, kvOp kv.Operator, pOp plugin.Operator,
-	prOp proute.Operator, tpOp rbac.TenantPluginMgr, openapi openapi.Client,
+	prOp proute.Operator, tpOp rbac.TenantPluginMgr, openapi openapi.Client, opOp operation.Operator,
+	workload WorkloadRestarter,
 ) *PluginServiceV1 {
 	ok, err := tpOp.OnCreateTenant(model.TKeelTenant)
 	if err != nil {
@@ -90,11 +101,48 @@ func NewPluginServiceV1(rbacOp *casbin.SyncedEnforcer, db *gorm.DB, conf *config
 		openapiClient:  openapi,
 		db:             db,
 		rbacOp:         rbacOp,
+		operationOp:    opOp,
+		workload:       workload,
+		upgrades:       &upgradeSet{items: make(map[string]*model.AvailableUpgrade)},
 	}
 }
 
 func (s *PluginServiceV1) InstallPlugin(ctx context.Context,
 	req *pb.InstallPluginRequest,
+) (_ *pb.InstallPluginResponse, err error) {
+	if req.DryRun {
+		return s.installPlugin(ctx, req)
+	}
+	defer func() {
+		recordAudit(ctx, model.AuditActionInstallPlugin, model.TKeelTenant, req.Id, req, err)
+	}()
+	if req.Async {
+		opID, err := s.asyncOperation(ctx, model.OperationTypeInstall, req.Id, func(opCtx context.Context) error {
+			_, err := s.installPlugin(opCtx, req)
+			return err
+		})
+		if err != nil {
+			log.Errorf("error install plugin(%s) async: %s", req.Id, err)
+			return nil, pb.PluginErrInternalStore()
+		}
+		return &pb.InstallPluginResponse{OperationId: opID}, nil
+	}
+	ctx, tracker, err := s.newOperation(ctx, model.OperationTypeInstall, req.Id)
+	if err != nil {
+		log.Errorf("error install plugin(%s) new operation: %s", req.Id, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	resp, err := s.installPlugin(ctx, req)
+	if err != nil {
+		tracker.finish(ctx, err)
+		return nil, err
+	}
+	resp.OperationId = tracker.id()
+	return resp, nil
+}
+
+func (s *PluginServiceV1) installPlugin(ctx context.Context,
+	req *pb.InstallPluginRequest,
 ) (*pb.InstallPluginResponse, error) {
 	rbStack := util.NewRollbackStack()
 	defer rbStack.Run()
@@ -114,6 +162,7 @@ func (s *PluginServiceV1) InstallPlugin(ctx context.Context,
 		if errors.Is(err, hub.ErrRepoNotFound) {
 			return nil, pb.PluginErrInstallerNotFound()
 		}
+		return nil, pb.PluginErrInternalStore()
 	}
 	installer, err := repo.Get(req.Installer.Name, req.Installer.Version)
 	if err != nil {
@@ -121,22 +170,112 @@ func (s *PluginServiceV1) InstallPlugin(ctx context.Context,
 		return nil, pb.PluginErrInstallerNotFound()
 	}
 	installer.SetPluginID(req.Id)
-	if err = installer.Install(convertConfiguration2Option(installerConfiguration)...); err != nil {
+	plan := []*installPlanItem{{id: req.Id, installer: installer}}
+	if req.InstallDependences {
+		plan, err = s.resolveInstallPlan(ctx, req.Id, installer)
+		if err != nil && !req.DryRun {
+			log.Errorf("error resolve plugin(%s) install plan: %s", req.Id, err)
+			return nil, pb.PluginErrResolveDependences()
+		}
+	}
+	if req.DryRun {
+		planned := make(map[string]bool, len(plan))
+		for _, v := range plan {
+			planned[v.id] = true
+		}
+		ret := s.dryRunInstaller(ctx, req.Id, installer, false, convertConfiguration2Option(installerConfiguration), planned)
+		if err != nil {
+			ret.Problems = append(ret.Problems, fmt.Sprintf("resolve dependences: %s", err))
+		}
+		if _, err = s.pluginOp.Get(ctx, req.Id); err == nil {
+			ret.Problems = append(ret.Problems, fmt.Sprintf("plugin(%s) already exists", req.Id))
+		}
+		log.Debugf("dry run install plugin(%s): %v", req.Id, ret.Problems)
+		return &pb.InstallPluginResponse{DryRun: ret, InstallPlan: convertInstallPlan2Pb(plan)}, nil
+	}
+	tracker := operationFromContext(ctx)
+	tracker.start(ctx, model.PhaseHelmAction)
+	newPlugins := make([]*model.Plugin, 0, len(plan))
+	for _, v := range plan {
+		if v.installed {
+			continue
+		}
+		var opts []*repository.Option
+		if v.id == req.Id {
+			opts = convertConfiguration2Option(installerConfiguration)
+		} else {
+			log.Debugf("install plugin(%s) dependence(%s)", req.Id, v.installer.Brief())
+		}
+		newP, rbs, err := s.installInstaller(ctx, v.id, v.installer, opts)
+		rbStack = append(rbStack, rbs...)
+		if err != nil {
+			tracker.end(ctx, model.PhaseHelmAction, err)
+			return nil, err
+		}
+		newPlugins = append(newPlugins, newP)
+	}
+	tracker.end(ctx, model.PhaseHelmAction, nil)
+	tracker.start(ctx, model.PhaseWaitReady)
+	var newP *model.Plugin
+	dependences := make([]string, 0, len(newPlugins))
+	for _, v := range newPlugins {
+		if v.ID != req.Id {
+			dependences = append(dependences, v.ID)
+		}
+	}
+	for _, v := range newPlugins {
+		reg := model.NewRegistration(model.RegistrationActionInstall, v.ID, "")
+		if v.ID == req.Id {
+			// the installed dependences are rolled back when the plugin registration failed.
+			reg.OperationID = tracker.id()
+			reg.Dependences = dependences
+			newP = v
+		}
+		if err = s.registerPending(ctx, reg); err != nil {
+			tracker.end(ctx, model.PhaseWaitReady, err)
+			return nil, pb.PluginErrInternalStore()
+		}
+	}
+	rbStack = util.NewRollbackStack()
+	log.Debugf("install plugin(%s) succ.", newP)
+	return &pb.InstallPluginResponse{
+		Plugin:      util.ConvertModel2PluginObjectPb(newP, nil, model.TKeelTenant),
+		InstallPlan: convertInstallPlan2Pb(plan),
+	}, nil
+}
+
+// installInstaller install the installer and create the plugin enabled by the tKeel tenant.
+// The returned rollback stack is valid even if an error is returned.
+func (s *PluginServiceV1) installInstaller(ctx context.Context, pluginID string,
+	installer repository.Installer, opts []*repository.Option,
+) (*model.Plugin, util.RollBackStack, error) {
+	rbStack := util.NewRollbackStack()
+	secret, err := util.GenerateSecret()
+	if err != nil {
+		log.Errorf("error generate plugin(%s) secret: %s", pluginID, err)
+		return nil, rbStack, pb.PluginErrInstallInstaller()
+	}
+	installer.SetSecret(secret)
+	err = installer.Install(opts...)
+	if err != nil {
 		log.Errorf("error install installer(%s) err: %s", installer.Brief(), err)
 		if errors.Is(err, repository.ErrInvalidOptions) {
-			return nil, pb.PluginErrInvalidArgument()
+			return nil, rbStack, pb.PluginErrInvalidArgument()
 		}
-		return nil, pb.PluginErrInstallInstaller()
+		if errors.Is(err, repository.ErrProvenanceVerification) {
+			return nil, rbStack, pb.PluginErrProvenanceVerification()
+		}
+		return nil, rbStack, pb.PluginErrInstallInstaller()
 	}
 	rbStack = append(rbStack, func() error {
 		log.Debugf("installer roll back.")
-		if err = hub.GetInstance().Uninstall(req.Id, installer.Brief()); err != nil {
+		if err = hub.GetInstance().Uninstall(pluginID, installer.Brief()); err != nil {
 			return errors.Wrapf(err, "uninstall installer(%s)", installer.Brief())
 		}
 		return nil
 	})
 	// create new plugin.
-	newP := model.NewPlugin(req.Id, &model.Installer{
+	newP := model.NewPlugin(pluginID, &model.Installer{
 		Repo:       installer.Brief().Repo,
 		Name:       installer.Brief().Name,
 		Version:    installer.Brief().Version,
@@ -144,39 +283,65 @@ func (s *PluginServiceV1) InstallPlugin(ctx context.Context,
 		Desc:       installer.Brief().Desc,
 		Maintainer: installer.Brief().Maintainers,
 	})
+	newP.SetSecret(secret)
 	if err = s.pluginOp.Create(ctx, newP); err != nil {
 		log.Errorf("error create plugin(%s): %s", newP, err)
 		if errors.Is(err, plugin.ErrPluginExsist) {
-			return nil, pb.PluginErrPluginAlreadyExists()
+			return nil, rbStack, pb.PluginErrPluginAlreadyExists()
 		}
-		return nil, pb.PluginErrInternalStore()
+		return nil, rbStack, pb.PluginErrInternalStore()
 	}
 	rbStack = append(rbStack, func() error {
 		log.Debugf("installer roll back.")
-		if _, err = s.pluginOp.Delete(ctx, req.Id); err != nil {
-			return errors.Wrapf(err, "delete plugin(%s)", req.Id)
+		if _, err = s.pluginOp.Delete(ctx, pluginID); err != nil {
+			return errors.Wrapf(err, "delete plugin(%s)", pluginID)
 		}
 		return nil
 	})
 	// tkeel tenant enalbe plugin.
-	if _, err = s.tenantPluginOp.AddTenantPlugin(model.TKeelTenant, req.Id); err != nil {
-		log.Errorf("error add tenant(%s) plugin(%s): %s", model.TKeelTenant, req.Id, err)
-		return nil, pb.PluginErrUnknown()
+	if _, err = s.tenantPluginOp.AddTenantPlugin(model.TKeelTenant, pluginID); err != nil {
+		log.Errorf("error add tenant(%s) plugin(%s): %s", model.TKeelTenant, pluginID, err)
+		return nil, rbStack, pb.PluginErrUnknown()
 	}
-	rbStack = util.NewRollbackStack()
-	register.Instance().Register(newP.ID, false, func() bool {
-		actionCtx, cancel := context.WithTimeout(context.TODO(), 5*time.Minute)
-		defer cancel()
-		return s.RegisterPluginAction(actionCtx, newP.ID, false)
-	})
-	log.Debugf("install plugin(%s) succ.", newP)
-	return &pb.InstallPluginResponse{
-		Plugin: util.ConvertModel2PluginObjectPb(newP, nil, model.TKeelTenant),
-	}, nil
+	return newP, rbStack, nil
 }
 
 func (s *PluginServiceV1) UpgradePlugin(ctx context.Context,
 	req *pb.UpgradePluginRequest,
+) (_ *pb.UpgradePluginResponse, err error) {
+	if req.DryRun {
+		return s.upgradePlugin(ctx, req)
+	}
+	defer func() {
+		recordAudit(ctx, model.AuditActionUpgradePlugin, model.TKeelTenant, req.Id, req, err)
+	}()
+	if req.Async {
+		opID, err := s.asyncOperation(ctx, model.OperationTypeUpgrade, req.Id, func(opCtx context.Context) error {
+			_, err := s.upgradePlugin(opCtx, req)
+			return err
+		})
+		if err != nil {
+			log.Errorf("error upgrade plugin(%s) async: %s", req.Id, err)
+			return nil, pb.PluginErrInternalStore()
+		}
+		return &pb.UpgradePluginResponse{OperationId: opID}, nil
+	}
+	ctx, tracker, err := s.newOperation(ctx, model.OperationTypeUpgrade, req.Id)
+	if err != nil {
+		log.Errorf("error upgrade plugin(%s) new operation: %s", req.Id, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	resp, err := s.upgradePlugin(ctx, req)
+	if err != nil {
+		tracker.finish(ctx, err)
+		return nil, err
+	}
+	resp.OperationId = tracker.id()
+	return resp, nil
+}
+
+func (s *PluginServiceV1) upgradePlugin(ctx context.Context,
+	req *pb.UpgradePluginRequest,
 ) (*pb.UpgradePluginResponse, error) {
 	rbStack := util.NewRollbackStack()
 	defer rbStack.Run()
@@ -204,6 +369,7 @@ func (s *PluginServiceV1) UpgradePlugin(ctx context.Context,
 		if errors.Is(err, hub.ErrRepoNotFound) {
 			return nil, pb.PluginErrInstallerNotFound()
 		}
+		return nil, pb.PluginErrInternalStore()
 	}
 	upgrader, err := repo.Get(req.Installer.Name, req.Installer.Version)
 	if err != nil {
@@ -211,17 +377,53 @@ func (s *PluginServiceV1) UpgradePlugin(ctx context.Context,
 		return nil, pb.PluginErrInstallerNotFound()
 	}
 	upgrader.SetPluginID(req.Id)
-	if err = upgrader.Upgrade(convertConfiguration2Option(installerConfiguration)...); err != nil {
+	upgrader.SetAppID(p.GetAppID())
+	if req.DryRun {
+		ret := s.dryRunInstaller(ctx, req.Id, upgrader, true, convertConfiguration2Option(installerConfiguration), nil)
+		log.Debugf("dry run upgrade plugin(%s): %v", req.Id, ret.Problems)
+		return &pb.UpgradePluginResponse{
+			Plugin: util.ConvertModel2PluginObjectPb(p, nil, model.TKeelTenant),
+			DryRun: ret,
+		}, nil
+	}
+	conflicts, err := s.checkInstallerConstraints(ctx, req.Id, upgrader)
+	if err != nil {
+		log.Errorf("error check plugin(%s) version constraints: %s", req.Id, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	if len(conflicts) != 0 {
+		log.Errorf("error upgrade plugin(%s): %s", req.Id, conflicts)
+		return nil, conflicts.pb()
+	}
+	if req.BlueGreen {
+		return s.deployCandidate(ctx, p, upgrader, convertConfiguration2Option(installerConfiguration), req)
+	}
+	if err = s.checkNoCandidate(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	revision, err := upgrader.Revision()
+	if err != nil {
+		log.Errorf("error get plugin(%s) release revision: %s", req.Id, err)
+		return nil, pb.PluginErrInstallInstaller()
+	}
+	tracker := operationFromContext(ctx)
+	tracker.start(ctx, model.PhaseHelmAction)
+	err = upgrader.Upgrade(convertConfiguration2Option(installerConfiguration)...)
+	tracker.end(ctx, model.PhaseHelmAction, err)
+	if err != nil {
 		log.Errorf("error upgrade installer(%s) err: %s", upgrader.Brief(), err)
 		if errors.Is(err, repository.ErrInvalidOptions) {
 			return nil, pb.PluginErrInvalidArgument()
 		}
+		if errors.Is(err, repository.ErrProvenanceVerification) {
+			return nil, pb.PluginErrProvenanceVerification()
+		}
 		return nil, pb.PluginErrInstallInstaller()
 	}
 	rbStack = append(rbStack, func() error {
 		log.Debugf("installer roll back.")
-		if err = hub.GetInstance().Uninstall(req.Id, upgrader.Brief()); err != nil {
-			return errors.Wrapf(err, "uninstall installer(%s)", upgrader.Brief())
+		if _, err = upgrader.Rollback(revision); err != nil {
+			return errors.Wrapf(err, "rollback installer(%s) to revision(%d)", upgrader.Brief(), revision)
 		}
 		return nil
 	})
@@ -240,11 +442,14 @@ func (s *PluginServiceV1) UpgradePlugin(ctx context.Context,
 		return nil, pb.PluginErrInternalStore()
 	}
 	rbStack = append(rbStack, rb)
-	register.Instance().Register(p.ID, true, func() bool {
-		actionCtx, cancel := context.WithTimeout(context.TODO(), 5*time.Minute)
-		defer cancel()
-		return s.RegisterPluginAction(actionCtx, p.ID, true)
-	})
+	tracker.start(ctx, model.PhaseWaitReady)
+	reg := model.NewRegistration(model.RegistrationActionUpgrade, p.ID, tracker.id())
+	reg.AppID = p.AppID
+	reg.OldPlugin, reg.Revision = tmp, revision
+	if err = s.registerPending(ctx, reg); err != nil {
+		tracker.end(ctx, model.PhaseWaitReady, err)
+		return nil, pb.PluginErrInternalStore()
+	}
 	log.Debugf("upgrade plugin(%s) succ.", p)
 	rbStack = util.NewRollbackStack()
 	return &pb.UpgradePluginResponse{
@@ -252,8 +457,246 @@ func (s *PluginServiceV1) UpgradePlugin(ctx context.Context,
 	}, nil
 }
 
+func (s *PluginServiceV1) RollbackPlugin(ctx context.Context,
+	req *pb.RollbackPluginRequest,
+) (_ *pb.RollbackPluginResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionRollbackPlugin, model.TKeelTenant, req.Id, req, err)
+	}()
+	ctx, tracker, err := s.newOperation(ctx, model.OperationTypeRollback, req.Id)
+	if err != nil {
+		log.Errorf("error rollback plugin(%s) new operation: %s", req.Id, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	resp, err := s.rollbackPlugin(ctx, req)
+	if err != nil {
+		tracker.finish(ctx, err)
+		return nil, err
+	}
+	resp.OperationId = tracker.id()
+	return resp, nil
+}
+
+func (s *PluginServiceV1) rollbackPlugin(ctx context.Context,
+	req *pb.RollbackPluginRequest,
+) (*pb.RollbackPluginResponse, error) {
+	rbStack := util.NewRollbackStack()
+	defer rbStack.Run()
+	if req.Revision < 0 {
+		log.Errorf("error rollback plugin(%s) invalid revision: %d", req.GetId(), req.Revision)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	p, err := s.pluginOp.Get(ctx, req.GetId())
+	if err != nil {
+		log.Errorf("error get plugin(%s): %s", req.GetId(), err)
+		if errors.Is(err, plugin.ErrPluginNotExsist) {
+			return nil, pb.PluginErrPluginNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	if p.Installer == nil {
+		log.Errorf("error plugin(%s) installer is nil", p)
+		return nil, pb.PluginErrInternalStore()
+	}
+	if err = s.checkNoCandidate(ctx, p.ID); err != nil {
+		return nil, err
+	}
+	installer, err := helm.NewReleaseInstaller(p.GetAppID(), s.tkeelConf.Namespace, helm.Secret)
+	if err != nil {
+		log.Errorf("error new plugin(%s) release installer: %s", req.Id, err)
+		return nil, pb.PluginErrRollbackPlugin()
+	}
+	revision, err := installer.Revision()
+	if err != nil {
+		log.Errorf("error get plugin(%s) release revision: %s", req.Id, err)
+		return nil, pb.PluginErrRollbackPlugin()
+	}
+	tracker := operationFromContext(ctx)
+	tracker.start(ctx, model.PhaseHelmAction)
+	brief, err := installer.Rollback(int(req.Revision))
+	tracker.end(ctx, model.PhaseHelmAction, err)
+	if err != nil {
+		log.Errorf("error rollback plugin(%s) to revision(%d): %s", req.Id, req.Revision, err)
+		return nil, pb.PluginErrRollbackPlugin()
+	}
+	rbStack = append(rbStack, func() error {
+		log.Debugf("installer roll back.")
+		if _, err = installer.Rollback(revision); err != nil {
+			return errors.Wrapf(err, "rollback plugin(%s) to revision(%d)", req.Id, revision)
+		}
+		return nil
+	})
+	tmp := p.Clone()
+	// the plugin secret follows the release rolled back to.
+	secret, err := installer.ReleaseSecret()
+	if err != nil {
+		log.Errorf("error get plugin(%s) release secret: %s", req.Id, err)
+		return nil, pb.PluginErrRollbackPlugin()
+	}
+	if p.Secret != model.HashPluginSecret(secret) {
+		p.SetSecret(secret)
+	}
+	p.Upgrade(&model.Installer{
+		Repo:       p.Installer.Repo,
+		Name:       brief.Name,
+		Version:    brief.Version,
+		Icon:       brief.Icon,
+		Desc:       brief.Desc,
+		Maintainer: p.Installer.Maintainer,
+	})
+	rb, err := s.updatePlugin(ctx, tmp, p)
+	if err != nil {
+		log.Errorf("error update plugin(%s) err: %s", p, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	rbStack = append(rbStack, rb)
+	tracker.start(ctx, model.PhaseWaitReady)
+	reg := model.NewRegistration(model.RegistrationActionRollback, p.ID, tracker.id())
+	reg.AppID = p.AppID
+	reg.OldPlugin, reg.Revision = tmp, revision
+	if err = s.registerPending(ctx, reg); err != nil {
+		tracker.end(ctx, model.PhaseWaitReady, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	log.Debugf("rollback plugin(%s) succ.", p)
+	rbStack = util.NewRollbackStack()
+	return &pb.RollbackPluginResponse{
+		Plugin: util.ConvertModel2PluginObjectPb(p, nil, model.TKeelTenant),
+	}, nil
+}
+
+func (s *PluginServiceV1) ReconfigurePlugin(ctx context.Context,
+	req *pb.ReconfigurePluginRequest,
+) (_ *pb.ReconfigurePluginResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionReconfigurePlugin, model.TKeelTenant, req.Id, req, err)
+	}()
+	if req.Async {
+		opID, err := s.asyncOperation(ctx, model.OperationTypeReconfigure, req.Id, func(opCtx context.Context) error {
+			_, err := s.reconfigurePlugin(opCtx, req)
+			return err
+		})
+		if err != nil {
+			log.Errorf("error reconfigure plugin(%s) async: %s", req.Id, err)
+			return nil, pb.PluginErrInternalStore()
+		}
+		return &pb.ReconfigurePluginResponse{OperationId: opID}, nil
+	}
+	ctx, tracker, err := s.newOperation(ctx, model.OperationTypeReconfigure, req.Id)
+	if err != nil {
+		log.Errorf("error reconfigure plugin(%s) new operation: %s", req.Id, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	resp, err := s.reconfigurePlugin(ctx, req)
+	if err != nil {
+		tracker.finish(ctx, err)
+		return nil, err
+	}
+	resp.OperationId = tracker.id()
+	return resp, nil
+}
+
+func (s *PluginServiceV1) reconfigurePlugin(ctx context.Context,
+	req *pb.ReconfigurePluginRequest,
+) (*pb.ReconfigurePluginResponse, error) {
+	p, err := s.pluginOp.Get(ctx, req.GetId())
+	if err != nil {
+		log.Errorf("error get plugin(%s): %s", req.GetId(), err)
+		if errors.Is(err, plugin.ErrPluginNotExsist) {
+			return nil, pb.PluginErrPluginNotFound()
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	if pluginIsTkeelComponent(p.ID) {
+		log.Errorf("error reconfigure tkeel component plugin(%s)", p.ID)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	if err = s.checkNoCandidate(ctx, p.ID); err != nil {
+		return nil, err
+	}
+	installerConfiguration, err := getInstallerConfiguration(&pb.Installer{
+		Configuration: req.Configuration,
+		Type:          req.Type,
+	})
+	if err != nil {
+		log.Errorf("error get installer configuration: %s", err)
+		return nil, pb.PluginErrInvalidArgument()
+	}
+	log.Debugf("configuration: %v", installerConfiguration)
+	installer, err := helm.NewReleaseInstaller(p.GetAppID(), s.tkeelConf.Namespace, helm.Secret)
+	if err != nil {
+		log.Errorf("error new plugin(%s) release installer: %s", req.Id, err)
+		return nil, pb.PluginErrReconfigurePlugin()
+	}
+	revision, err := installer.Revision()
+	if err != nil {
+		log.Errorf("error get plugin(%s) release revision: %s", req.Id, err)
+		return nil, pb.PluginErrReconfigurePlugin()
+	}
+	tracker := operationFromContext(ctx)
+	tracker.start(ctx, model.PhaseHelmAction)
+	err = installer.Reconfigure(convertConfiguration2Option(installerConfiguration)...)
+	tracker.end(ctx, model.PhaseHelmAction, err)
+	if err != nil {
+		log.Errorf("error reconfigure plugin(%s): %s", req.Id, err)
+		if errors.Is(err, repository.ErrInvalidOptions) {
+			return nil, pb.PluginErrInvalidArgument().WithMessage(fmt.Sprintf("插件配置校验失败: %s", err))
+		}
+		return nil, pb.PluginErrReconfigurePlugin()
+	}
+	tracker.start(ctx, model.PhaseWaitReady)
+	reg := model.NewRegistration(model.RegistrationActionReconfigure, p.ID, tracker.id())
+	reg.AppID = p.AppID
+	reg.OldPlugin, reg.Revision = p.Clone(), revision
+	if err = s.registerPending(ctx, reg); err != nil {
+		tracker.end(ctx, model.PhaseWaitReady, err)
+		if _, rbErr := installer.Rollback(revision); rbErr != nil {
+			log.Errorf("error rollback plugin(%s) to revision(%d): %s", req.Id, revision, rbErr)
+		}
+		return nil, pb.PluginErrInternalStore()
+	}
+	log.Debugf("reconfigure plugin(%s) succ.", p)
+	return &pb.ReconfigurePluginResponse{
+		Plugin: util.ConvertModel2PluginObjectPb(p, nil, model.TKeelTenant),
+	}, nil
+}
+
 func (s *PluginServiceV1) UninstallPlugin(ctx context.Context,
 	req *pb.UninstallPluginRequest,
+) (_ *pb.UninstallPluginResponse, err error) {
+	if req.Preview {
+		return s.uninstallPlugin(ctx, req)
+	}
+	defer func() {
+		recordAudit(ctx, model.AuditActionUninstallPlugin, model.TKeelTenant, req.Id, req, err)
+	}()
+	if req.Async {
+		opID, err := s.asyncOperation(ctx, model.OperationTypeUninstall, req.Id, func(opCtx context.Context) error {
+			_, err := s.uninstallPlugin(opCtx, req)
+			return err
+		})
+		if err != nil {
+			log.Errorf("error uninstall plugin(%s) async: %s", req.Id, err)
+			return nil, pb.PluginErrInternalStore()
+		}
+		return &pb.UninstallPluginResponse{OperationId: opID}, nil
+	}
+	ctx, tracker, err := s.newOperation(ctx, model.OperationTypeUninstall, req.Id)
+	if err != nil {
+		log.Errorf("error uninstall plugin(%s) new operation: %s", req.Id, err)
+		return nil, pb.PluginErrInternalStore()
+	}
+	resp, err := s.uninstallPlugin(ctx, req)
+	if err != nil {
+		tracker.finish(ctx, err)
+		return nil, err
+	}
+	resp.OperationId = tracker.id()
+	return resp, nil
+}
+
+func (s *PluginServiceV1) uninstallPlugin(ctx context.Context,
+	req *pb.UninstallPluginRequest,
 ) (*pb.UninstallPluginResponse, error) {
 	rbStack := util.NewRollbackStack()
 	defer rbStack.Run()
@@ -276,16 +719,52 @@ func (s *PluginServiceV1) UninstallPlugin(ctx context.Context,
 		log.Errorf("error plugin(%s) installer is nil", p)
 		return nil, pb.PluginErrInternalStore()
 	}
-	// check whether the extension point is implemented.
-	if pr != nil && len(pr.RegisterAddons) != 0 {
-		log.Errorf("error uninstall plugin(%s): other plugins have implemented the extension points of this plugin.", req.GetId())
-		return nil, pb.PluginErrUninstallPluginHasBeenDepended()
+	if req.Preview {
+		preview, err := s.uninstallPreview(ctx, p, pr)
+		if err != nil {
+			log.Errorf("error preview uninstall plugin(%s): %s", p.ID, err)
+			return nil, pb.PluginErrInternalStore()
+		}
+		return &pb.UninstallPluginResponse{
+			Plugin:  util.ConvertModel2PluginObjectPb(p, pr, model.TKeelTenant),
+			Preview: preview,
+		}, nil
+	}
+	// the tenants and the registered addons are removed by the cascade uninstall.
+	if !req.Cascade {
+		// check whether the extension point is implemented.
+		if pr != nil && len(pr.RegisterAddons) != 0 {
+			log.Errorf("error uninstall plugin(%s): other plugins have implemented the extension points of this plugin.", req.GetId())
+			return nil, pb.PluginErrUninstallPluginHasBeenDepended()
+		}
+		// Check if plugin is disabled by tenant.
+		if len(p.EnableTenantes) > 1 {
+			log.Errorf("error unregister plugin(%s): tenant(%v) enableed", p.ID, p.EnableTenantes)
+			return nil, pb.PluginErrPluginHasTenantEnabled()
+		}
+		// check whether other plugins declare constraints on this plugin.
+		conflicts, err := s.checkDependentConstraints(ctx, p.ID, "", true)
+		if err != nil {
+			log.Errorf("error check plugin(%s) dependent version constraints: %s", p.ID, err)
+			return nil, pb.PluginErrInternalStore()
+		}
+		if len(conflicts) != 0 {
+			log.Errorf("error uninstall plugin(%s): %s", p.ID, conflicts)
+			return nil, conflicts.pb()
+		}
 	}
-	// Check if plugin is disabled by tenant.
-	if len(p.EnableTenantes) > 1 {
-		log.Errorf("error unregister plugin(%s): tenant(%v) enableed", p.ID, p.EnableTenantes)
-		return nil, pb.PluginErrPluginHasTenantEnabled()
+	tracker := operationFromContext(ctx)
+	if req.Cascade {
+		tracker.start(ctx, model.PhaseCascade)
+		rbs, err := s.cascadeUninstall(ctx, p, pr)
+		rbStack = append(rbStack, rbs...)
+		tracker.end(ctx, model.PhaseCascade, err)
+		if err != nil {
+			log.Errorf("error cascade uninstall plugin(%s): %s", p.ID, err)
+			return nil, pb.PluginErrUninstallPlugin()
+		}
 	}
+	tracker.start(ctx, model.PhaseCleanup)
 	// reset implemented plugin route.
 	subRbStack, err := s.resetImplementedPluginRoute(ctx, p)
 	if err != nil {
@@ -314,26 +793,44 @@ func (s *PluginServiceV1) UninstallPlugin(ctx context.Context,
 	}
 	rbStack = append(rbStack, rb)
 	// rbac remove plugin permission.
+	policies := len(s.pluginPolicies(p))
 	rbList, err := s.deletePermission(ctx, p)
+	tracker.report(ctx, model.ReportActionPolicyRemove, p.ID, policiesDetail(policies), err)
 	if err != nil {
 		log.Errorf("error delete permission: %s", req.GetId(), err)
 		return nil, pb.PluginErrUninstallPlugin()
 	}
 	rbStack = append(rbStack, rbList...)
+	tracker.end(ctx, model.PhaseCleanup, nil)
 	// uninstall plugin.
-	if err = hub.GetInstance().Uninstall(req.GetId(), &repository.InstallerBrief{
+	tracker.start(ctx, model.PhaseHelmAction)
+	err = hub.GetInstance().Uninstall(p.GetAppID(), &repository.InstallerBrief{
 		Name:    p.Installer.Name,
 		Repo:    p.Installer.Repo,
 		Version: p.Installer.Version,
 		State:   repository.StateInstalled,
-	}); err != nil {
+	})
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		// the release was removed outside of tkeel.
+		log.Warnf("uninstall plugin(%s) release(%s) not found", p.ID, p.GetAppID())
+		err = nil
+	}
+	tracker.end(ctx, model.PhaseHelmAction, err)
+	if err != nil {
 		log.Errorf("error uninstall plugin(%s): %s", p, err)
 		return nil, pb.PluginErrUninstallPlugin()
 	}
+	if pr != nil && pr.Candidate != nil {
+		if err = s.uninstallRelease(pr.Candidate.AppID); err != nil {
+			log.Errorf("error uninstall plugin(%s) candidate release(%s): %s", p.ID, pr.Candidate.AppID, err)
+		}
+	}
+	tracker.finish(ctx, nil)
 	log.Debugf("uninstall plugin(%s) succ.", p)
 	rbStack = util.NewRollbackStack()
 	return &pb.UninstallPluginResponse{
 		Plugin: util.ConvertModel2PluginObjectPb(p, pr, model.TKeelTenant),
+		Report: util.ConvertModel2OperationReportPb(tracker.reports()),
 	}, nil
 }
 
@@ -420,7 +917,10 @@ func (s *PluginServiceV1) ListPlugin(ctx context.Context,
 
 func (s *PluginServiceV1) TenantEnable(ctx context.Context,
 	req *pb.TenantEnableRequest,
-) (*emptypb.Empty, error) {
+) (_ *emptypb.Empty, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionTenantEnablePlugin, "", req.Id, req, err)
+	}()
 	u, err := util.GetUser(ctx)
 	if err != nil {
 		log.Errorf("error get user: %s", err)
@@ -437,7 +937,10 @@ func (s *PluginServiceV1) TenantEnable(ctx context.Context,
 
 func (s *PluginServiceV1) TMTenantEnable(ctx context.Context,
 	req *pb.TMTenantEnableRequest,
-) (*emptypb.Empty, error) {
+) (_ *emptypb.Empty, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionTenantEnablePlugin, req.TenantId, req.PluginId, req, err)
+	}()
 	_, terr, err := s.tenantEnablePlugin(ctx, false, req.TenantId, model.TKeelUser, req.PluginId, req.Extra)
 	if err != nil {
 		log.Errorf("error tenant(%s) enable plugin(%s): %s", req.TenantId, model.TKeelUser, err)
@@ -449,7 +952,10 @@ func (s *PluginServiceV1) TMTenantEnable(ctx context.Context,
 
 func (s *PluginServiceV1) TenantDisable(ctx context.Context,
 	req *pb.TenantDisableRequest,
-) (*emptypb.Empty, error) {
+) (_ *emptypb.Empty, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionTenantDisablePlugin, "", req.Id, req, err)
+	}()
 	rbStack := util.NewRollbackStack()
 	defer rbStack.Run()
 	u, err := util.GetUser(ctx)
@@ -463,8 +969,7 @@ func (s *PluginServiceV1) TenantDisable(ctx context.Context,
 		return nil, pb.PluginErrInternalStore()
 	}
 
-	tmpP := p.Clone()
-	if p.TenantDisable(u.Tenant) {
+	if p.CheckTenantEnable(u.Tenant) {
 		// openapi tenant/disable.
 		rb, err := s.requestTenantDisable(ctx, req.Id, u.Tenant, req.Extra)
 		if err != nil {
@@ -479,8 +984,10 @@ func (s *PluginServiceV1) TenantDisable(ctx context.Context,
 			return nil, pb.PluginErrUnknown()
 		}
 		// plugin update.
-		if _, err = s.updatePlugin(ctx, tmpP, p); err != nil {
-			log.Errorf("error update tenant(%s) disable plugin(%s): %s", u.Tenant, p, err)
+		if err = s.updatePluginLocked(ctx, req.Id, func(p *model.Plugin) bool {
+			return p.TenantDisable(u.Tenant)
+		}); err != nil {
+			log.Errorf("error update tenant(%s) disable plugin(%s): %s", u.Tenant, req.Id, err)
 			return nil, pb.PluginErrInternalStore()
 		}
 	}
@@ -491,7 +998,10 @@ func (s *PluginServiceV1) TenantDisable(ctx context.Context,
 
 func (s *PluginServiceV1) TMTenantDisable(ctx context.Context,
 	req *pb.TMTenantDisableRequest,
-) (*emptypb.Empty, error) {
+) (_ *emptypb.Empty, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionTenantDisablePlugin, req.TenantId, req.PluginId, req, err)
+	}()
 	rbStack := util.NewRollbackStack()
 	defer rbStack.Run()
 	p, err := s.pluginOp.Get(ctx, req.PluginId)
@@ -500,8 +1010,7 @@ func (s *PluginServiceV1) TMTenantDisable(ctx context.Context,
 		return nil, pb.PluginErrInternalStore()
 	}
 
-	tmpP := p.Clone()
-	if p.TenantDisable(req.TenantId) {
+	if p.CheckTenantEnable(req.TenantId) {
 		// openapi tenant/disable.
 		rb, err := s.requestTenantDisable(ctx, req.PluginId, req.TenantId, req.Extra)
 		if err != nil {
@@ -516,8 +1025,10 @@ func (s *PluginServiceV1) TMTenantDisable(ctx context.Context,
 			return nil, pb.PluginErrUnknown()
 		}
 		// plugin update.
-		if _, err = s.updatePlugin(ctx, tmpP, p); err != nil {
-			log.Errorf("error update tenant(%s) disable plugin(%s): %s", req.TenantId, p, err)
+		if err = s.updatePluginLocked(ctx, req.PluginId, func(p *model.Plugin) bool {
+			return p.TenantDisable(req.TenantId)
+		}); err != nil {
+			log.Errorf("error update tenant(%s) disable plugin(%s): %s", req.TenantId, req.PluginId, err)
 			return nil, pb.PluginErrInternalStore()
 		}
 	}
@@ -650,11 +1161,11 @@ func (s *PluginServiceV1) updatePluginIdentify(ctx context.Context, pID string)
 	if err != nil {
 		return nil, errors.Wrapf(err, "query identify: %s", pID)
 	}
-	if err = s.checkIdentify(ctx, resp); err != nil {
+	if err = s.checkIdentify(ctx, resp, pluginVersion(p)); err != nil {
 		return nil, errors.Wrapf(err, "check identify: %s", resp)
 	}
 	oldP := p.Clone()
-	p.Register(resp, helm.SecretContext)
+	p.Register(resp)
 	rb, err := s.updatePlugin(ctx, oldP, p)
 	if err != nil {
 		return nil, errors.Wrapf(err, "update plugin: %s", pID)
@@ -663,33 +1174,46 @@ func (s *PluginServiceV1) updatePluginIdentify(ctx context.Context, pID string)
 	return rb, nil
 }
 
-func (s *PluginServiceV1) RegisterPluginAction(ctx context.Context, pID string, isUpgrade bool) bool {
-	resp, err := s.queryStatus(ctx, pID)
+func (s *PluginServiceV1) rollbackUpgrade(ctx context.Context, oldP *model.Plugin,
+	upgrader repository.Installer, revision int,
+) error {
+	if _, err := upgrader.Rollback(revision); err != nil {
+		return errors.Wrapf(err, "rollback plugin(%s) to revision(%d)", oldP.ID, revision)
+	}
+	p, err := s.pluginOp.Get(ctx, oldP.ID)
 	if err != nil {
-		log.Warnf("register query plugin(%s) status: %s", pID, err)
-		return false
-	} else if resp.Status == openapi_v1.PluginStatus_RUNNING {
-		log.Debugf("register plugin(%s)", pID)
-		// get register plugin identify.
-		if err = s.registerPluginProcess(ctx, pID, isUpgrade); err != nil {
-			log.Errorf("error register(%s): %s", pID, err)
-			return false
-		}
-		log.Debugf("register plugin(%s) ok", pID)
-		return true
+		return errors.Wrapf(err, "get plugin(%s)", oldP.ID)
+	}
+	restoreP := oldP.Clone()
+	restoreP.Version = p.Version
+	if err = s.pluginOp.Update(ctx, restoreP); err != nil {
+		return errors.Wrapf(err, "restore plugin(%s)", restoreP)
 	}
-	return false
+	return nil
 }
 
 func (s *PluginServiceV1) registerPluginProcess(ctx context.Context, pID string, isUpgrade bool) error {
+	tracker := operationFromContext(ctx)
+	tracker.start(ctx, model.PhaseIdentify)
+	p, err := s.pluginOp.Get(ctx, pID)
+	if err != nil {
+		err = errors.Wrap(err, "register error get plugin")
+		tracker.end(ctx, model.PhaseIdentify, err)
+		return err
+	}
 	resp, err := s.queryIdentify(ctx, pID)
 	if err != nil {
-		return errors.Wrap(err, "register error query identify")
+		err = errors.Wrap(err, "register error query identify")
+		tracker.end(ctx, model.PhaseIdentify, err)
+		return err
 	}
 	// check register plugin identify.
-	if err = s.checkIdentify(ctx, resp); err != nil {
-		return errors.Wrap(err, "register error check identify")
+	if err = s.checkIdentify(ctx, resp, pluginVersion(p)); err != nil {
+		err = errors.Wrap(err, "register error check identify")
+		tracker.end(ctx, model.PhaseIdentify, err)
+		return err
 	}
+	tracker.end(ctx, model.PhaseIdentify, nil)
 	if err = s.verifyPluginIdentity(ctx, resp, isUpgrade); err != nil {
 		return errors.Wrap(err, "register error register plugin")
 	}
@@ -735,8 +1259,10 @@ func (s *PluginServiceV1) queryStatus(ctx context.Context, pID string) (*openapi
 	return statusResp, nil
 }
 
+// checkIdentify check the identify of the plugin being registered,
+// ver is the installer version of the release which reported the identify.
 func (s *PluginServiceV1) checkIdentify(ctx context.Context,
-	resp *openapi_v1.IdentifyResponse,
+	resp *openapi_v1.IdentifyResponse, ver string,
 ) error {
 	ok, err := util.CheckRegisterPluginTkeelVersion(resp.TkeelVersion, version.Version)
 	if err != nil {
@@ -755,13 +1281,83 @@ func (s *PluginServiceV1) checkIdentify(ctx context.Context,
 			return errors.Wrapf(err, "get dependence plugin(%s)", v.Id)
 		}
 	}
-	return nil
+	conflicts, err := s.checkDeclaredConstraints(ctx, resp.PluginId,
+		identifyConstraints(resp.Dependence, resp.ImplementedPlugin))
+	if err != nil {
+		return errors.Wrapf(err, "check plugin(%s) declared version constraints", resp.PluginId)
+	}
+	// the installer version is checked as the upgrade pre-check does, rather than the reported version.
+	cs, err := s.checkDependentConstraints(ctx, resp.PluginId, ver, false)
+	if err != nil {
+		return errors.Wrapf(err, "check plugin(%s) dependent version constraints", resp.PluginId)
+	}
+	return append(conflicts, cs...).err()
 }
 
-func (s *PluginServiceV1) verifyPluginIdentity(ctx context.Context, resp *openapi_v1.IdentifyResponse, isUpgrade bool) error {
+// dryRunInstaller render the installer and check the tkeel version and dependences
+// declared in the chart annotations, problems are collected instead of returned.
+func (s *PluginServiceV1) dryRunInstaller(ctx context.Context, pluginID string, installer repository.Installer,
+	isUpgrade bool, opts []*repository.Option, planned map[string]bool,
+) *pb.DryRunResult {
+	ret := &pb.DryRunResult{Problems: make([]string, 0)}
+	res, err := installer.DryRun(isUpgrade, opts...)
+	if err != nil {
+		ret.Problems = append(ret.Problems, fmt.Sprintf("render installer(%s): %s", installer.Brief().Name, err))
+	} else {
+		ret.Manifest = res.Manifest
+		ret.Notes = res.Notes
+		if ret.Values, err = yaml.Marshal(res.Values); err != nil {
+			ret.Problems = append(ret.Problems, fmt.Sprintf("marshal values: %s", err))
+		}
+	}
+	annotations := installer.Annotations()
+	if tkeelVersion, ok := annotations[repository.TkeelVersionKey].(string); ok && tkeelVersion != "" {
+		ok, err = util.CheckRegisterPluginTkeelVersion(tkeelVersion, version.Version)
+		if err != nil {
+			ret.Problems = append(ret.Problems, fmt.Sprintf("check depend tkeel version(%s): %s", tkeelVersion, err))
+		} else if !ok {
+			ret.Problems = append(ret.Problems, fmt.Sprintf("depend tkeel version(%s) not invalid, current version(%s)",
+				tkeelVersion, version.Version))
+		}
+	}
+	deps, err := repository.ParseDependences(annotations)
+	if err != nil {
+		ret.Problems = append(ret.Problems, fmt.Sprintf("parse dependences: %s", err))
+	}
+	for _, v := range deps {
+		if pluginIsTkeelComponent(v.ID) || planned[v.ID] {
+			continue
+		}
+		if _, err = s.pluginOp.Get(ctx, v.ID); err != nil {
+			ret.Problems = append(ret.Problems, fmt.Sprintf("get dependence plugin(%s): %s", v.ID, err))
+		}
+	}
+	conflicts, err := s.checkInstallerConstraints(ctx, pluginID, installer)
+	if err != nil {
+		ret.Problems = append(ret.Problems, fmt.Sprintf("check version constraints: %s", err))
+	}
+	for _, v := range conflicts {
+		ret.Problems = append(ret.Problems, v.String())
+	}
+	return ret
+}
+
+func (s *PluginServiceV1) verifyPluginIdentity(ctx context.Context, resp *openapi_v1.IdentifyResponse, isUpgrade bool) (err error) {
 	rbStack := util.NewRollbackStack()
 	defer rbStack.Run()
+	tracker := operationFromContext(ctx)
+	phase := model.PhaseRouteCreation
+	tracker.start(ctx, phase)
+	defer func() {
+		tracker.end(ctx, phase, err)
+	}()
+	var oldRoute *model.PluginRoute
 	if isUpgrade {
+		// keep the app, the candidate and the maintenance mode across the upgrade.
+		var err1 error
+		if oldRoute, err1 = s.pluginRouteOp.Get(ctx, resp.PluginId); err1 != nil && !errors.Is(err1, proute.ErrPluginRouteNotExsist) {
+			return errors.Wrapf(err1, "get plugin route %s", resp.PluginId)
+		}
 		// delete plugin route.
 		rb, err := s.deletePluginRoute(ctx, resp.PluginId)
 		if err != nil {
@@ -777,7 +1373,12 @@ func (s *PluginServiceV1) verifyPluginIdentity(ctx context.Context, resp *openap
 	}
 	// create plugin route.
 	newPluginRoute := model.NewPluginRoute(resp)
-	err := s.pluginRouteOp.Create(ctx, newPluginRoute)
+	if oldRoute != nil {
+		newPluginRoute.AppID = oldRoute.AppID
+		newPluginRoute.Candidate = oldRoute.Candidate
+		newPluginRoute.Maintenance = oldRoute.Maintenance
+	}
+	err = s.pluginRouteOp.Create(ctx, newPluginRoute)
 	if err != nil {
 		if errors.Is(err, proute.ErrPluginRouteExsist) {
 			return ErrPluginRegistered
@@ -786,9 +1387,9 @@ func (s *PluginServiceV1) verifyPluginIdentity(ctx context.Context, resp *openap
 	}
 	rbStack = append(rbStack, func() error {
 		log.Debugf("save register plugin route roll back run.")
-		_, err = s.pluginRouteOp.Delete(ctx, newPluginRoute.ID)
-		if err != nil {
-			return errors.Wrapf(err, "delete new plugin(%s) route", newPluginRoute.ID)
+		// do not reuse the named result, it is read by the deferred operation phase.
+		if _, err1 := s.pluginRouteOp.Delete(ctx, newPluginRoute.ID); err1 != nil {
+			return errors.Wrapf(err1, "delete new plugin(%s) route", newPluginRoute.ID)
 		}
 		return nil
 	})
@@ -798,6 +1399,9 @@ func (s *PluginServiceV1) verifyPluginIdentity(ctx context.Context, resp *openap
 		return errors.Wrapf(err, "register implemented plugin(%s) route", resp.PluginId)
 	}
 	rbStack = append(rbStack, rbs...)
+	tracker.end(ctx, phase, nil)
+	phase = model.PhasePermissionRegistration
+	tracker.start(ctx, phase)
 	// add plugin permissions.
 	rbs, err = util.AddPluginPermissionOnSet(ctx, s.kvOp, resp.PluginId, resp.Permissions)
 	if err != nil {
@@ -809,7 +1413,7 @@ func (s *PluginServiceV1) verifyPluginIdentity(ctx context.Context, resp *openap
 	if err != nil {
 		return errors.Wrapf(err, "get plugin(%s)", resp.PluginId)
 	}
-	p.Register(resp, helm.SecretContext)
+	p.Register(resp)
 	p.Status = openapi_v1.PluginStatus_RUNNING
 	if err := s.pluginOp.Update(ctx, p); err != nil {
 		return errors.Wrapf(err, "update plugin(%s)", p)
@@ -916,21 +1520,56 @@ func (s *PluginServiceV1) tenantEnablePlugin(ctx context.Context, isDependence b
 		return nil, pb.PluginErrUnknown(), errors.Wrapf(err, "add tenant(%s) plugin(%s) rbac", tenantID, p)
 	}
 	// update plugin.
-	tmpP := p.Clone()
-	p.TenantEnable(&model.EnableTenant{
+	enableTenant := &model.EnableTenant{
 		TenantID:        tenantID,
 		OperatorID:      userID,
 		EnableTimestamp: time.Now().Unix(),
-	})
-	rb, err = s.updatePlugin(ctx, tmpP, p)
-	if err != nil {
+	}
+	if err = s.updatePluginLocked(ctx, pluginID, func(p *model.Plugin) bool {
+		if p.CheckTenantEnable(tenantID) {
+			return false
+		}
+		p.TenantEnable(enableTenant)
+		return true
+	}); err != nil {
 		rbStack.Run()
 		return nil, pb.PluginErrInternalStore(), errors.Wrapf(err, "tenant(%s) enable(%s) update plugin", tenantID, p)
 	}
-	rbStack = append(rbStack, rb)
+	rbStack = append(rbStack, func() error {
+		log.Debugf("tenant(%s) enable plugin(%s) update plugin roll back run.", tenantID, pluginID)
+		return s.updatePluginLocked(ctx, pluginID, func(p *model.Plugin) bool {
+			return p.TenantDisable(tenantID)
+		})
+	})
 	return rbStack, nil, nil
 }
 
+// UpdatePluginLocked apply the change to the latest plugin with the plugin locked,
+// it serializes the plugin changes of the background workers with the plugin operations.
+func (s *PluginServiceV1) UpdatePluginLocked(ctx context.Context, pluginID string, change func(p *model.Plugin) bool) error {
+	return s.updatePluginLocked(ctx, pluginID, change)
+}
+
+// updatePluginLocked apply the change to the latest plugin with the plugin locked, so that the
+// fields of the same plugin such as the enabled tenants can be updated concurrently.
+func (s *PluginServiceV1) updatePluginLocked(ctx context.Context, pluginID string, change func(p *model.Plugin) bool) error {
+	v, _ := s.pluginLocks.LoadOrStore(pluginID, &sync.Mutex{})
+	mu, _ := v.(*sync.Mutex)
+	mu.Lock()
+	defer mu.Unlock()
+	p, err := s.pluginOp.Get(ctx, pluginID)
+	if err != nil {
+		return errors.Wrapf(err, "get plugin(%s)", pluginID)
+	}
+	if !change(p) {
+		return nil
+	}
+	if err = s.pluginOp.Update(ctx, p); err != nil {
+		return errors.Wrapf(err, "update plugin(%s)", p)
+	}
+	return nil
+}
+
 func (s *PluginServiceV1) requestTenantEnable(ctx context.Context, pluginID string,
 	tenantID string, extra []byte,
 ) (util.RollbackFunc, error) {
@@ -1112,14 +1751,20 @@ func (s *PluginServiceV1) deleteTenantPluginEnable(ctx context.Context, pID stri
 	}, nil
 }
 
-func (s *PluginServiceV1) deletePermission(ctx context.Context, p *model.Plugin) (util.RollBackStack, error) {
-	rbStack := util.NewRollbackStack()
+// pluginPolicies get the rbac policies of the plugin permissions.
+func (s *PluginServiceV1) pluginPolicies(p *model.Plugin) [][]string {
 	pList := model.GetPermissionSet().GetAllPermissionByPluginID(p.ID)
-	removePolicies := make([][]string, 0)
+	ret := make([][]string, 0)
 	for _, v := range pList {
-		removePolicies = append(removePolicies,
+		ret = append(ret,
 			s.rbacOp.GetFilteredPolicy(2, v.Path, model.AllowedPermissionAction)...)
 	}
+	return ret
+}
+
+func (s *PluginServiceV1) deletePermission(ctx context.Context, p *model.Plugin) (util.RollBackStack, error) {
+	rbStack := util.NewRollbackStack()
+	removePolicies := s.pluginPolicies(p)
 	if _, err := s.rbacOp.RemovePolicies(removePolicies); err != nil {
 		return nil, errors.Wrapf(err, "RemovePolicies %v", removePolicies)
 	}
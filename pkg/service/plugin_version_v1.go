@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	openapi_v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
+	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+	"github.com/tkeel-io/tkeel/pkg/util"
+)
+
+var ErrVersionConstraintConflict = errors.New("version constraint conflict")
+
+// versionConflict the version constraint which plugin declared on the target plugin is broken.
+type versionConflict struct {
+	pluginID   string
+	target     string
+	constraint string
+	version    string
+	removed    bool // the target is going to be removed.
+}
+
+func (c *versionConflict) String() string {
+	target := c.target
+	if c.constraint != "" {
+		target += "@" + c.constraint
+	}
+	if c.removed {
+		return fmt.Sprintf("plugin(%s) requires plugin(%s) which is going to be removed", c.pluginID, target)
+	}
+	return fmt.Sprintf("plugin(%s) requires plugin(%s) but version is %s", c.pluginID, target, c.version)
+}
+
+// versionConflicts the broken version constraints.
+type versionConflicts []*versionConflict
+
+func (cs versionConflicts) Error() string {
+	ret := make([]string, 0, len(cs))
+	for _, v := range cs {
+		ret = append(ret, v.String())
+	}
+	return strings.Join(ret, "; ")
+}
+
+// pb convert to the plugin error with the conflicting plugins in the message.
+func (cs versionConflicts) pb() error {
+	return pb.PluginErrVersionConstraintConflict().WithMessage(
+		fmt.Sprintf("插件版本约束冲突: %s", cs.Error()))
+}
+
+// err return nil if there is no conflict.
+func (cs versionConflicts) err() error {
+	if len(cs) == 0 {
+		return nil
+	}
+	return errors.Wrap(ErrVersionConstraintConflict, cs.Error())
+}
+
+// pluginVersion get the version which the constraints are checked against. It is the installer(chart)
+// version, the only version known before the plugin is installed or upgraded, so that the constraints
+// are checked against the same version before and after the plugin registered.
+// The registered plugin version is used only if the installer is unknown.
+func pluginVersion(p *model.Plugin) string {
+	if p.Installer != nil && p.Installer.Version != "" {
+		return p.Installer.Version
+	}
+	return p.PluginVersion
+}
+
+// identifyConstraints get the version constraints declared by the dependences and implemented plugins.
+func identifyConstraints(dependences []*openapi_v1.BriefPluginInfo,
+	implemented []*openapi_v1.ImplementedPlugin,
+) map[string][]string {
+	ret := make(map[string][]string)
+	for _, v := range dependences {
+		if v != nil {
+			ret[v.Id] = append(ret[v.Id], v.Version)
+		}
+	}
+	for _, v := range implemented {
+		if v != nil && v.Plugin != nil {
+			ret[v.Plugin.Id] = append(ret[v.Plugin.Id], v.Plugin.Version)
+		}
+	}
+	return ret
+}
+
+// dependenceConstraints get the version constraints declared by the installer dependences.
+func dependenceConstraints(deps []*repository.Dependence) map[string][]string {
+	ret := make(map[string][]string)
+	for _, v := range deps {
+		ret[v.ID] = append(ret[v.ID], v.Version)
+	}
+	return ret
+}
+
+// checkVersion check the version against the constraints declared by plugin on target.
+func checkVersion(pluginID, target, ver string, constraints []string) (versionConflicts, error) {
+	ret := make(versionConflicts, 0)
+	for _, c := range constraints {
+		if strings.TrimSpace(c) == "" {
+			continue
+		}
+		if ver == "" {
+			ret = append(ret, &versionConflict{
+				pluginID:   pluginID,
+				target:     target,
+				constraint: c,
+				version:    "<unknown>",
+			})
+			continue
+		}
+		ok, err := util.CheckVersionConstraints(c, ver)
+		if err != nil {
+			return nil, errors.Wrapf(err, "plugin(%s) check plugin(%s) version", pluginID, target)
+		}
+		if !ok {
+			ret = append(ret, &versionConflict{
+				pluginID:   pluginID,
+				target:     target,
+				constraint: c,
+				version:    ver,
+			})
+		}
+	}
+	return ret, nil
+}
+
+// checkDeclaredConstraints check whether the installed plugins satisfy the constraints declared by the plugin.
+// The missing plugins are ignored, the dependence existence is checked by the caller.
+func (s *PluginServiceV1) checkDeclaredConstraints(ctx context.Context, pluginID string,
+	constraints map[string][]string,
+) (versionConflicts, error) {
+	ret := make(versionConflicts, 0)
+	targets := make([]string, 0, len(constraints))
+	for k := range constraints {
+		targets = append(targets, k)
+	}
+	sort.Strings(targets)
+	for _, target := range targets {
+		if pluginIsTkeelComponent(target) || target == pluginID {
+			continue
+		}
+		p, err := s.pluginOp.Get(ctx, target)
+		if err != nil {
+			if errors.Is(err, plugin.ErrPluginNotExsist) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "get plugin(%s)", target)
+		}
+		cs, err := checkVersion(pluginID, target, pluginVersion(p), constraints[target])
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, cs...)
+	}
+	return ret, nil
+}
+
+// checkDependentConstraints check whether the plugin version satisfies the constraints
+// declared by the other installed plugins, any declaration conflicts if the plugin is going to be removed.
+func (s *PluginServiceV1) checkDependentConstraints(ctx context.Context, pluginID, ver string,
+	removed bool,
+) (versionConflicts, error) {
+	ret := make(versionConflicts, 0)
+	ps, err := s.pluginOp.List(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list plugin")
+	}
+	sort.Slice(ps, func(i, j int) bool {
+		return ps[i].ID < ps[j].ID
+	})
+	for _, p := range ps {
+		if p.ID == pluginID {
+			continue
+		}
+		constraints, ok := identifyConstraints(p.PluginDependences, p.ImplementedPlugin)[pluginID]
+		if !ok {
+			continue
+		}
+		if removed {
+			for _, c := range constraints {
+				ret = append(ret, &versionConflict{
+					pluginID:   p.ID,
+					target:     pluginID,
+					constraint: c,
+					removed:    true,
+				})
+			}
+			continue
+		}
+		cs, err := checkVersion(p.ID, pluginID, ver, constraints)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, cs...)
+	}
+	return ret, nil
+}
+
+// checkInstallerConstraints check the constraints declared by the installer dependences,
+// and the constraints declared by the other installed plugins on the installer version.
+func (s *PluginServiceV1) checkInstallerConstraints(ctx context.Context, pluginID string,
+	installer repository.Installer,
+) (versionConflicts, error) {
+	deps, err := repository.ParseDependences(installer.Annotations())
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse plugin(%s) dependences", pluginID)
+	}
+	ret, err := s.checkDeclaredConstraints(ctx, pluginID, dependenceConstraints(deps))
+	if err != nil {
+		return nil, err
+	}
+	cs, err := s.checkDependentConstraints(ctx, pluginID, installer.Brief().Version, false)
+	if err != nil {
+		return nil, err
+	}
+	return append(ret, cs...), nil
+}
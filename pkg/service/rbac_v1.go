@@ -38,7 +38,10 @@ func NewRBACService(db *gorm.DB, rbac *casbin.SyncedEnforcer, tenantPluginOp rba
 	}
 }
 
-func (s *RBACService) CreateRoles(ctx context.Context, req *pb.CreateRoleRequest) (*pb.CreateRoleResponse, error) {
+func (s *RBACService) CreateRoles(ctx context.Context, req *pb.CreateRoleRequest) (_ *pb.CreateRoleResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionCreateRole, "", req.GetRole().GetName(), req, err)
+	}()
 	u, err := util.GetUser(ctx)
 	if err != nil {
 		log.Errorf("error get user: %s", err)
@@ -140,7 +143,10 @@ func (s *RBACService) GetRole(ctx context.Context, req *pb.GetRoleRequest) (*pb.
 	}, nil
 }
 
-func (s *RBACService) DeleteRole(ctx context.Context, req *pb.DeleteRoleRequest) (*pb.DeleteRoleResponse, error) {
+func (s *RBACService) DeleteRole(ctx context.Context, req *pb.DeleteRoleRequest) (_ *pb.DeleteRoleResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionDeleteRole, "", req.Id, req, err)
+	}()
 	u, err := util.GetUser(ctx)
 	if err != nil {
 		log.Errorf("error get user: %s", err)
@@ -176,7 +182,10 @@ func (s *RBACService) DeleteRole(ctx context.Context, req *pb.DeleteRoleRequest)
 	}, nil
 }
 
-func (s *RBACService) UpdateRole(ctx context.Context, req *pb.UpdateRoleRequest) (*pb.UpdateRoleResponse, error) {
+func (s *RBACService) UpdateRole(ctx context.Context, req *pb.UpdateRoleRequest) (_ *pb.UpdateRoleResponse, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionUpdateRole, "", req.Id, req, err)
+	}()
 	u, err := util.GetUser(ctx)
 	if err != nil {
 		log.Errorf("error get user: %s", err)
@@ -242,7 +251,10 @@ func (s *RBACService) UpdateRole(ctx context.Context, req *pb.UpdateRoleRequest)
 	return &pb.UpdateRoleResponse{}, nil
 }
 
-func (s *RBACService) UpdateUserRoleBinding(ctx context.Context, req *pb.UpdateUserRoleBindingRequest) (*emptypb.Empty, error) {
+func (s *RBACService) UpdateUserRoleBinding(ctx context.Context, req *pb.UpdateUserRoleBindingRequest) (_ *emptypb.Empty, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionUpdateRoleBinding, "", req.UserId, req, err)
+	}()
 	u, err := util.GetUser(ctx)
 	if err != nil {
 		log.Errorf("error get user: %s", err)
@@ -280,7 +292,10 @@ func (s *RBACService) UpdateUserRoleBinding(ctx context.Context, req *pb.UpdateU
 	return &emptypb.Empty{}, nil
 }
 
-func (s *RBACService) CreateRoleBinding(ctx context.Context, req *pb.CreateRoleBindingRequest) (*emptypb.Empty, error) {
+func (s *RBACService) CreateRoleBinding(ctx context.Context, req *pb.CreateRoleBindingRequest) (_ *emptypb.Empty, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionCreateRoleBinding, "", req.RoleId, req, err)
+	}()
 	u, err := util.GetUser(ctx)
 	if err != nil {
 		log.Errorf("error get user: %s", err)
@@ -326,7 +341,10 @@ func (s *RBACService) CreateRoleBinding(ctx context.Context, req *pb.CreateRoleB
 	return &emptypb.Empty{}, nil
 }
 
-func (s *RBACService) DeleteRoleBinding(ctx context.Context, req *pb.DeleteRoleBindingRequest) (*emptypb.Empty, error) {
+func (s *RBACService) DeleteRoleBinding(ctx context.Context, req *pb.DeleteRoleBindingRequest) (_ *emptypb.Empty, err error) {
+	defer func() {
+		recordAudit(ctx, model.AuditActionDeleteRoleBinding, "", req.RoleId, req, err)
+	}()
 	u, err := util.GetUser(ctx)
 	if err != nil {
 		log.Errorf("error get user: %s", err)
@@ -11,6 +11,7 @@ import (
 	"github.com/tkeel-io/kit/log"
 	pb "github.com/tkeel-io/tkeel/api/repo/v1"
 	"github.com/tkeel-io/tkeel/pkg/hub"
+	"github.com/tkeel-io/tkeel/pkg/model/prepo"
 	"github.com/tkeel-io/tkeel/pkg/repository"
 	"github.com/tkeel-io/tkeel/pkg/repository/helm"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -26,15 +27,34 @@ func NewRepoService() *RepoService {
 
 func (s *RepoService) CreateRepo(ctx context.Context, req *pb.CreateRepoRequest) (*emptypb.Empty, error) {
 	info := &repository.Info{
-		Name: req.Name,
-		URL:  req.GetUrl().Url,
+		Name:            req.Name,
+		URL:             req.GetUrl().GetUrl(),
+		Credentials:     convertPB2Credentials(req.GetUrl().GetAuth()),
+		Verify:          req.GetUrl().GetVerify(),
+		Keyring:         req.GetUrl().GetKeyring(),
+		RefreshInterval: req.GetUrl().GetRefreshInterval(),
 		// TODO: add annotations.
 	}
+	if _, err := hub.ParseRefreshInterval(info.RefreshInterval); err != nil {
+		log.Errorf("error repo(%s) refresh interval: %s", info.Name, err)
+		return nil, pb.ErrInvalidArgument()
+	}
+	if !repository.ValidVerifyPolicy(info.Verify) {
+		log.Errorf("error repo(%s) verify policy: %s", info.Name, info.Verify)
+		return nil, pb.ErrInvalidArgument()
+	}
+	if _, err := helm.ParseKeyring(info.Keyring); err != nil {
+		log.Errorf("error repo(%s) keyring: %s", info.Name, err)
+		return nil, pb.ErrInvalidArgument()
+	}
 	if err := hub.GetInstance().Add(info); err != nil {
 		log.Errorf("error hub add repo(%s): %s", info, err)
 		if errors.Is(err, hub.ErrRepoExist) {
 			return nil, pb.ErrRepoExist()
 		}
+		if errors.Is(err, prepo.ErrCredentialsKeyRequired) {
+			return nil, pb.ErrInvalidArgument().WithMessage(err.Error())
+		}
 		return nil, pb.ErrInternalError()
 	}
 	return &emptypb.Empty{}, nil
@@ -58,8 +78,15 @@ func (s *RepoService) ListRepo(ctx context.Context, req *emptypb.Empty) (*pb.Lis
 	return &pb.ListRepoResponse{
 		Repos: func() []*pb.RepoObject {
 			ret := make([]*pb.RepoObject, 0, len(repoList))
+			listed := make(map[string]struct{}, len(repoList))
 			for _, v := range repoList {
 				ret = append(ret, convertRepo2PB(v))
+				listed[v.Info().Name] = struct{}{}
+			}
+			for _, v := range hub.GetInstance().Broken() {
+				if _, ok := listed[v.Name]; !ok {
+					ret = append(ret, convertBrokenRepo2PB(v))
+				}
 			}
 			sort.Sort(repoSort(ret))
 			return ret
@@ -67,6 +94,41 @@ func (s *RepoService) ListRepo(ctx context.Context, req *emptypb.Empty) (*pb.Lis
 	}, nil
 }
 
+func (s *RepoService) GetRepo(ctx context.Context, req *pb.GetRepoRequest) (*pb.GetRepoResponse, error) {
+	for _, v := range hub.GetInstance().Broken() {
+		if v.Name == req.Name {
+			return &pb.GetRepoResponse{
+				Repo: convertBrokenRepo2PB(v),
+			}, nil
+		}
+	}
+	repo, err := hub.GetInstance().Get(req.Name)
+	if err != nil {
+		log.Errorf("error hub get repo(%s): %s", req.Name, err)
+		if errors.Is(err, hub.ErrRepoNotFound) {
+			return nil, pb.ErrRepoNotFound()
+		}
+		return nil, pb.ErrInternalError()
+	}
+	return &pb.GetRepoResponse{
+		Repo: convertRepo2PB(repo),
+	}, nil
+}
+
+func (s *RepoService) RefreshRepo(ctx context.Context, req *pb.RefreshRepoRequest) (*pb.RefreshRepoResponse, error) {
+	repo, err := hub.GetInstance().Refresh(req.Name)
+	if err != nil {
+		log.Errorf("error hub refresh repo(%s): %s", req.Name, err)
+		if errors.Is(err, hub.ErrRepoNotFound) {
+			return nil, pb.ErrRepoNotFound()
+		}
+		return nil, pb.ErrRefreshRepo()
+	}
+	return &pb.RefreshRepoResponse{
+		Repo: convertRepo2PB(repo),
+	}, nil
+}
+
 func (s *RepoService) ListAllRepoInstaller(ctx context.Context,
 	req *pb.ListAllRepoInstallerRequest,
 ) (*pb.ListAllRepoInstallerResponse, error) {
@@ -127,6 +189,7 @@ func (s *RepoService) ListRepoInstaller(ctx context.Context,
 		if errors.Is(err, hub.ErrRepoNotFound) {
 			return nil, pb.ErrRepoNotFound()
 		}
+		return nil, pb.ErrInternalError()
 	}
 	searchWord := getReglarStringKeyWords(req.KeyWords)
 	log.Debugf("search words %s -- %s", req.KeyWords, searchWord)
@@ -202,6 +265,7 @@ func (s *RepoService) GetRepoInstaller(ctx context.Context,
 		if errors.Is(err, hub.ErrRepoNotFound) {
 			return nil, pb.ErrRepoNotFound()
 		}
+		return nil, pb.ErrInternalError()
 	}
 	installer, err := repo.Get(req.InstallerName, req.InstallerVersion)
 	if err != nil {
@@ -251,6 +315,70 @@ func convertRepo2PB(r repository.Repository) *pb.RepoObject {
 			return ret
 		}(),
 		InstallerNum: int32(total),
+		Auth:         convertCredentials2PB(r.Info().Credentials),
+		Verify:       r.Info().Verify,
+		Status:       convertSyncStatus2PB(hub.GetInstance().Status(r.Info().Name)),
+	}
+}
+
+// convertBrokenRepo2PB convert the repository which failed to construct, only its info and status are known.
+func convertBrokenRepo2PB(i *repository.Info) *pb.RepoObject {
+	return &pb.RepoObject{
+		Name:        i.Name,
+		Url:         i.URL,
+		Metadata:    make(map[string][]byte),
+		Annotations: make(map[string]string),
+		Auth:        convertCredentials2PB(i.Credentials),
+		Verify:      i.Verify,
+		Status:      convertSyncStatus2PB(hub.GetInstance().Status(i.Name)),
+	}
+}
+
+func convertSyncStatus2PB(st *repository.SyncStatus) *pb.RepoStatus {
+	if st == nil {
+		return nil
+	}
+	return &pb.RepoStatus{
+		LastSyncTime:       st.LastSyncTimestamp,
+		LastError:          st.LastError,
+		LastErrorTime:      st.LastErrorTimestamp,
+		ChartCount:         int32(st.ChartCount),
+		IndexGeneratedTime: st.IndexGenerated,
+		RefreshInterval:    st.RefreshInterval,
+	}
+}
+
+func convertPB2Credentials(a *pb.RepoAuth) *repository.Credentials {
+	if a == nil {
+		return nil
+	}
+	c := &repository.Credentials{
+		Username:              a.Username,
+		Password:              a.Password,
+		Token:                 a.Token,
+		CertData:              a.CertData,
+		KeyData:               a.KeyData,
+		CAData:                a.CaData,
+		InsecureSkipTLSVerify: a.InsecureSkipTlsVerify,
+	}
+	if c.IsZero() {
+		return nil
+	}
+	return c
+}
+
+// convertCredentials2PB get the auth status of the repository, the secrets are never returned.
+func convertCredentials2PB(c *repository.Credentials) *pb.RepoAuthStatus {
+	if c.IsZero() {
+		return nil
+	}
+	return &pb.RepoAuthStatus{
+		Username:              c.Username,
+		PasswordSet:           c.Password != "",
+		TokenSet:              c.Token != "",
+		ClientCertSet:         c.CertData != "",
+		CaSet:                 c.CAData != "",
+		InsecureSkipTlsVerify: c.InsecureSkipTLSVerify,
 	}
 }
 
@@ -291,6 +419,7 @@ func convertInstallerBrief2PB(ib *repository.InstallerBrief) *pb.InstallerObject
 				CreateTime: uint64(ib.CreateTimestamp),
 			},
 		},
+		Provenance: convertProvenance2PB(ib.Provenance),
 	}
 }
 
@@ -352,6 +481,21 @@ func convertInstaller2PB(i repository.Installer) *pb.InstallerObject {
 		Timestamp:   uint64(ib.CreateTimestamp),
 		Icon:        ib.Icon,
 		VersionList: ib.VersionList,
+		Provenance:  convertProvenance2PB(ib.Provenance),
+	}
+}
+
+func convertProvenance2PB(p *repository.Provenance) *pb.InstallerProvenance {
+	if p == nil {
+		return nil
+	}
+	return &pb.InstallerProvenance{
+		Policy:   p.Policy,
+		Status:   p.Status,
+		SignedBy: p.SignedBy,
+		KeyId:    p.KeyID,
+		FileHash: p.FileHash,
+		Message:  p.Message,
 	}
 }
 
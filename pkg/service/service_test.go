@@ -1,12 +1,339 @@
 package service
 
 import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	transport_http "github.com/tkeel-io/kit/transport/http"
 	v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
+	plugin_pb "github.com/tkeel-io/tkeel/api/plugin/v1"
+	repo_pb "github.com/tkeel-io/tkeel/api/repo/v1"
+	"github.com/tkeel-io/tkeel/pkg/client/openapi"
+	"github.com/tkeel-io/tkeel/pkg/hub"
+	"github.com/tkeel-io/tkeel/pkg/manifest"
+	"github.com/tkeel-io/tkeel/pkg/model"
+	"github.com/tkeel-io/tkeel/pkg/model/audit"
+	"github.com/tkeel-io/tkeel/pkg/model/operation"
+	"github.com/tkeel-io/tkeel/pkg/model/plugin"
+	"github.com/tkeel-io/tkeel/pkg/register"
+	"github.com/tkeel-io/tkeel/pkg/repository"
+	"github.com/tkeel-io/tkeel/pkg/repository/helm"
+	"github.com/tkeel-io/tkeel/pkg/version"
+	"google.golang.org/protobuf/proto"
 )
 
+// fakePluginOperator keep the plugins in memory with the same version check as the state store.
+type fakePluginOperator struct {
+	sync.Mutex
+	plugins map[string]*model.Plugin
+	updates int
+}
+
+func newFakePluginOperator(ps ...*model.Plugin) *fakePluginOperator {
+	o := &fakePluginOperator{plugins: make(map[string]*model.Plugin)}
+	for _, v := range ps {
+		o.plugins[v.ID] = v.Clone()
+	}
+	return o
+}
+
+func (o *fakePluginOperator) Create(ctx context.Context, p *model.Plugin) error {
+	o.Lock()
+	defer o.Unlock()
+	if _, ok := o.plugins[p.ID]; ok {
+		return plugin.ErrPluginExsist
+	}
+	o.plugins[p.ID] = p.Clone()
+	return nil
+}
+
+func (o *fakePluginOperator) Update(ctx context.Context, p *model.Plugin) error {
+	o.Lock()
+	defer o.Unlock()
+	old, ok := o.plugins[p.ID]
+	if !ok {
+		return plugin.ErrPluginNotExsist
+	}
+	if old.Version != p.Version {
+		return plugin.ErrPluginVersionMismatch
+	}
+	v, _ := strconv.Atoi(p.Version)
+	p.Version = strconv.Itoa(v + 1)
+	o.plugins[p.ID] = p.Clone()
+	o.updates++
+	return nil
+}
+
+func (o *fakePluginOperator) Get(ctx context.Context, pluginID string) (*model.Plugin, error) {
+	o.Lock()
+	defer o.Unlock()
+	p, ok := o.plugins[pluginID]
+	if !ok {
+		return nil, plugin.ErrPluginNotExsist
+	}
+	return p.Clone(), nil
+}
+
+func (o *fakePluginOperator) Delete(ctx context.Context, pluginID string) (*model.Plugin, error) {
+	o.Lock()
+	defer o.Unlock()
+	p, ok := o.plugins[pluginID]
+	if !ok {
+		return nil, plugin.ErrPluginNotExsist
+	}
+	delete(o.plugins, pluginID)
+	return p, nil
+}
+
+func (o *fakePluginOperator) List(ctx context.Context) ([]*model.Plugin, error) {
+	o.Lock()
+	defer o.Unlock()
+	ret := make([]*model.Plugin, 0, len(o.plugins))
+	for _, v := range o.plugins {
+		ret = append(ret, v.Clone())
+	}
+	return ret, nil
+}
+
+// fakeOperationOperator keep the operations in memory and count the writes.
+type fakeOperationOperator struct {
+	sync.Mutex
+	ops    map[string]*model.Operation
+	writes int
+}
+
+func newFakeOperationOperator() *fakeOperationOperator {
+	return &fakeOperationOperator{ops: make(map[string]*model.Operation)}
+}
+
+func (o *fakeOperationOperator) Create(ctx context.Context, op *model.Operation) error {
+	o.Lock()
+	defer o.Unlock()
+	if _, ok := o.ops[op.ID]; ok {
+		return operation.ErrOperationExsist
+	}
+	o.ops[op.ID] = op.Clone()
+	o.writes++
+	return nil
+}
+
+func (o *fakeOperationOperator) Update(ctx context.Context, op *model.Operation) error {
+	o.Lock()
+	defer o.Unlock()
+	old, ok := o.ops[op.ID]
+	if !ok {
+		return operation.ErrOperationNotExsist
+	}
+	if old.Version != op.Version {
+		return operation.ErrOperationVersionMismatch
+	}
+	v, _ := strconv.Atoi(op.Version)
+	op.Version = strconv.Itoa(v + 1)
+	o.ops[op.ID] = op.Clone()
+	o.writes++
+	return nil
+}
+
+func (o *fakeOperationOperator) Get(ctx context.Context, operationID string) (*model.Operation, error) {
+	o.Lock()
+	defer o.Unlock()
+	op, ok := o.ops[operationID]
+	if !ok {
+		return nil, operation.ErrOperationNotExsist
+	}
+	return op.Clone(), nil
+}
+
+func (o *fakeOperationOperator) List(ctx context.Context) ([]*model.Operation, error) {
+	o.Lock()
+	defer o.Unlock()
+	ret := make([]*model.Operation, 0, len(o.ops))
+	for _, v := range o.ops {
+		ret = append(ret, v.Clone())
+	}
+	return ret, nil
+}
+
+func (o *fakeOperationOperator) Delete(ctx context.Context, operationIDs ...string) error {
+	o.Lock()
+	defer o.Unlock()
+	for _, v := range operationIDs {
+		delete(o.ops, v)
+	}
+	return nil
+}
+
+// fakeInstaller record the release actions of the installer.
+type fakeInstaller struct {
+	repository.Installer
+	brief       repository.InstallerBrief
+	annotations repository.Annotations
+	revision    int
+	rolledBack  []int
+	upgradeErr  error
+}
+
+func (i *fakeInstaller) SetPluginID(pluginID string) {}
+
+func (i *fakeInstaller) Annotations() repository.Annotations { return i.annotations }
+
+func (i *fakeInstaller) Revision() (int, error) { return i.revision, nil }
+
+func (i *fakeInstaller) Upgrade(opts ...*repository.Option) error { return i.upgradeErr }
+
+func (i *fakeInstaller) Rollback(revision int) (*repository.InstallerBrief, error) {
+	i.rolledBack = append(i.rolledBack, revision)
+	brief := i.brief
+	return &brief, nil
+}
+
+func (i *fakeInstaller) Brief() *repository.InstallerBrief { return &i.brief }
+
+// fakeOpenapiClient answer the status and identify of the plugins.
+type fakeOpenapiClient struct {
+	openapi.Client
+	status   map[string]v1.PluginStatus
+	identify map[string]*v1.IdentifyResponse
+}
+
+func (c *fakeOpenapiClient) Status(ctx context.Context, pluginID string) (*v1.StatusResponse, error) {
+	status, ok := c.status[pluginID]
+	if !ok {
+		return nil, errors.Errorf("plugin(%s) unreachable", pluginID)
+	}
+	return &v1.StatusResponse{Res: &v1.Result{Ret: v1.Retcode_OK}, Status: status}, nil
+}
+
+func (c *fakeOpenapiClient) Identify(ctx context.Context, pluginID string) (*v1.IdentifyResponse, error) {
+	resp, ok := c.identify[pluginID]
+	if !ok {
+		return nil, errors.Errorf("plugin(%s) unreachable", pluginID)
+	}
+	return resp, nil
+}
+
+// fakeRepo a repository of the fake installers, the installers of a name are ordered by version.
+type fakeRepo struct {
+	info       *repository.Info
+	installers map[string][]*fakeInstaller
+}
+
+func newFakeRepo(name string, installers ...*fakeInstaller) *fakeRepo {
+	r := &fakeRepo{
+		info:       repository.NewInfo(name, "https://"+name, nil),
+		installers: make(map[string][]*fakeInstaller),
+	}
+	for _, v := range installers {
+		v.brief.Repo = name
+		r.installers[v.brief.Name] = append(r.installers[v.brief.Name], v)
+	}
+	for _, list := range r.installers {
+		versions := make([]*repo_pb.VersionList, 0, len(list))
+		for _, v := range list {
+			versions = append(versions, &repo_pb.VersionList{Version: v.brief.Version})
+		}
+		for _, v := range list {
+			v.brief.VersionList = versions
+		}
+	}
+	return r
+}
+
+func (r *fakeRepo) Info() *repository.Info { return r.info }
+
+func (r *fakeRepo) Search(word string) ([]*repository.InstallerBrief, error) { return nil, nil }
+
+func (r *fakeRepo) Get(name, version string) (repository.Installer, error) {
+	list := r.installers[name]
+	if len(list) == 0 {
+		return nil, errors.Errorf("installer(%s) not found", name)
+	}
+	if version == "" {
+		return list[len(list)-1], nil
+	}
+	for _, v := range list {
+		if v.brief.Version == version {
+			return v, nil
+		}
+	}
+	return nil, errors.Errorf("installer(%s/%s) not found", name, version)
+}
+
+func (r *fakeRepo) Installed() ([]repository.Installer, error) { return nil, nil }
+
+func (r *fakeRepo) Update() (bool, error) { return false, nil }
+
+func (r *fakeRepo) Len() int { return len(r.installers) }
+
+func (r *fakeRepo) Generated() time.Time { return time.Time{} }
+
+func (r *fakeRepo) Close() error { return nil }
+
+// fakeRepoInfoOperator keep no repository info, the test repositories are added to the hub.
+type fakeRepoInfoOperator struct{}
+
+func (fakeRepoInfoOperator) Create(ctx context.Context, i *repository.Info) error { return nil }
+
+func (fakeRepoInfoOperator) Update(ctx context.Context, i *repository.Info) error { return nil }
+
+func (fakeRepoInfoOperator) Get(ctx context.Context, name string) (*repository.Info, error) {
+	return nil, errors.New("not found")
+}
+
+func (fakeRepoInfoOperator) Delete(ctx context.Context, name string) (*repository.Info, error) {
+	return repository.NewInfo(name, "", nil), nil
+}
+
+func (fakeRepoInfoOperator) List(ctx context.Context) ([]*repository.Info, error) { return nil, nil }
+
+func (fakeRepoInfoOperator) Watch(ctx context.Context, interval string, callback func(news, updates, deletes []*repository.Info) error) error {
+	return nil
+}
+
+func (fakeRepoInfoOperator) SaveStatus(ctx context.Context, name string, status *repository.SyncStatus) error {
+	return nil
+}
+
+func (fakeRepoInfoOperator) ListStatus(ctx context.Context, names ...string) (map[string]*repository.SyncStatus, error) {
+	return nil, nil
+}
+
+var _testRepos sync.Map
+
+// addTestRepo add the fake repository to the hub until the test finished.
+func addTestRepo(t *testing.T, r *fakeRepo) {
+	t.Helper()
+	hub.Init("1h", fakeRepoInfoOperator{},
+		func(i *repository.Info, args ...interface{}) (repository.Repository, error) {
+			r, ok := _testRepos.Load(i.Name)
+			if !ok {
+				return nil, errors.Errorf("repo(%s) not found", i.Name)
+			}
+			// the repo is constructed with the info.
+			repo := *r.(*fakeRepo)
+			repo.info = i
+			return &repo, nil
+		},
+		func(pluginID string) error { return nil })
+	_testRepos.Store(r.info.Name, r)
+	assert.Nil(t, hub.GetInstance().Add(r.info))
+	t.Cleanup(func() {
+		_, err := hub.GetInstance().Delete(r.info.Name)
+		assert.Nil(t, err)
+		_testRepos.Delete(r.info.Name)
+	})
+}
+
 func TestCommonGetQueryItemsStartAndEnd(t *testing.T) {
 	s, e := getQueryItemsStartAndEnd(1, 10, 0)
 	assert.Equal(t, s, 0)
@@ -153,3 +480,668 @@ func TestMergeEntry(t *testing.T) {
 	mergeEntry(test1, test2)
 	t.Log(test1)
 }
+
+func TestCheckVersion(t *testing.T) {
+	cs, err := checkVersion("a", "b", "0.5.1", []string{">=0.4.0,<0.6", "v0.4.0", ""})
+	assert.Nil(t, err)
+	assert.Len(t, cs, 0)
+	cs, err = checkVersion("a", "b", "v0.6.0", []string{">=0.4.0,<0.6", "v0.4.0"})
+	assert.Nil(t, err)
+	assert.Len(t, cs, 1)
+	assert.Equal(t, "plugin(a) requires plugin(b@>=0.4.0,<0.6) but version is v0.6.0", cs.Error())
+	_, err = checkVersion("a", "b", "0.5.0", []string{">=>0.4"})
+	assert.NotNil(t, err)
+}
+
+func TestRegisteredAddons(t *testing.T) {
+	assert.Len(t, registeredAddons(nil), 0)
+	addons := registeredAddons(&model.PluginRoute{
+		ID: "a",
+		RegisterAddons: map[string]string{
+			"point2":  "b/endpoint2",
+			"point1":  "b/endpoint1",
+			"point3":  "c/endpoint3?x=1",
+			"invalid": "invalid",
+		},
+	})
+	assert.Equal(t, map[string][]string{
+		"b": {"point1", "point2"},
+		"c": {"point3"},
+	}, addons)
+	assert.Equal(t, []string{"b", "c"}, sortedKeys(addons))
+}
+
+func TestSelectUpgradeVersions(t *testing.T) {
+	versions := []string{"0.4.0", "0.4.1", "0.4.3", "0.5.0", "0.6.0-rc1", "1.0.0", "invalid"}
+	tests := []struct {
+		policy string
+		latest string
+		auto   string
+	}{
+		{model.UpgradePolicyManual, "1.0.0", ""},
+		{model.UpgradePolicyNotify, "1.0.0", ""},
+		{model.UpgradePolicyPatchAuto, "1.0.0", "0.4.3"},
+		{model.UpgradePolicyMinorAuto, "1.0.0", "0.5.0"},
+	}
+	for _, tt := range tests {
+		latest, auto := selectUpgradeVersions(tt.policy, "0.4.1", versions)
+		assert.Equal(t, tt.latest, latest, tt.policy)
+		assert.Equal(t, tt.auto, auto, tt.policy)
+	}
+	latest, auto := selectUpgradeVersions(model.UpgradePolicyMinorAuto, "1.0.0", versions)
+	assert.Equal(t, "", latest)
+	assert.Equal(t, "", auto)
+}
+
+func TestAutoUpgradeFailed(t *testing.T) {
+	p := model.NewPlugin("iothub", &model.Installer{Repo: "tkeel", Name: "iothub", Version: "0.4.0"})
+	p.Status = v1.PluginStatus_RUNNING
+	pOp, opOp := newFakePluginOperator(p), newFakeOperationOperator()
+	s := &PluginServiceV1{pluginOp: pOp, operationOp: opOp}
+	ctx := context.Background()
+
+	// the upgrade failed after the registration is recorded when the operation finished.
+	op := model.NewOperation(model.OperationTypeUpgrade, "iothub")
+	assert.Nil(t, opOp.Create(ctx, op))
+	pending := map[string]*autoUpgradeOperation{"iothub": {operationID: op.ID, version: "0.4.1"}}
+	s.checkAutoUpgrades(ctx, pending)
+	assert.Len(t, pending, 1)
+	op.Finish(errors.New("register failed, rolled back"))
+	assert.Nil(t, opOp.Update(ctx, op))
+	s.checkAutoUpgrades(ctx, pending)
+	assert.Empty(t, pending)
+	got, err := pOp.Get(ctx, "iothub")
+	assert.Nil(t, err)
+	assert.Equal(t, "0.4.1", got.FailedAutoVersion)
+
+	// the failed version is skipped until a newer version found.
+	u := &model.AvailableUpgrade{PluginID: "iothub", Repo: "tkeel", Installer: "iothub", CurrentVersion: "0.4.0", AutoVersion: "0.4.1"}
+	opID, err := s.autoUpgrade(ctx, u)
+	assert.Nil(t, err)
+	assert.Empty(t, opID)
+	assert.False(t, versionNewer("0.4.1", "0.4.1"))
+	assert.True(t, versionNewer("0.4.2", "0.4.1"))
+	assert.True(t, versionNewer("0.4.1", ""))
+}
+
+func TestMaintenanceWindow(t *testing.T) {
+	w, err := parseMaintenanceWindow("")
+	assert.Nil(t, err)
+	assert.Nil(t, w)
+	_, err = parseMaintenanceWindow("02:00")
+	assert.NotNil(t, err)
+	_, err = parseMaintenanceWindow("02:00-02:00")
+	assert.NotNil(t, err)
+
+	w, err = parseMaintenanceWindow("02:00-04:30")
+	assert.Nil(t, err)
+	assert.True(t, w.contains(time.Date(2022, 1, 1, 2, 0, 0, 0, time.UTC)))
+	assert.True(t, w.contains(time.Date(2022, 1, 1, 4, 29, 0, 0, time.UTC)))
+	assert.False(t, w.contains(time.Date(2022, 1, 1, 4, 30, 0, 0, time.UTC)))
+
+	w, err = parseMaintenanceWindow("23:00-01:00")
+	assert.Nil(t, err)
+	assert.True(t, w.contains(time.Date(2022, 1, 1, 23, 30, 0, 0, time.UTC)))
+	assert.True(t, w.contains(time.Date(2022, 1, 1, 0, 30, 0, 0, time.UTC)))
+	assert.False(t, w.contains(time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)))
+}
+
+func TestPluginMaintenance(t *testing.T) {
+	admin := &model.User{User: model.TKeelUser, Tenant: model.TKeelTenant}
+	req := httptest.NewRequest(http.MethodGet, "/apis/iothub/v1/devices", nil)
+	assert.False(t, maintenanceBypass(req, admin))
+	req.Header.Set(model.XtKeelMaintenanceBypassHeader, "true")
+	assert.True(t, maintenanceBypass(req, admin))
+	assert.False(t, maintenanceBypass(req, &model.User{User: "u1", Tenant: "t1"}))
+
+	rec := httptest.NewRecorder()
+	writeMaintenanceResult(rec, "iothub", &model.PluginMaintenance{Message: "data migration"})
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, strconv.Itoa(_maintenanceRetryAfter), rec.Header().Get("Retry-After"))
+	assert.Contains(t, rec.Body.String(), _maintenanceCode)
+	assert.Contains(t, rec.Body.String(), "data migration")
+
+	rec = httptest.NewRecorder()
+	writeMaintenanceResult(rec, "iothub", &model.PluginMaintenance{EndTimestamp: time.Now().Unix() + 3600})
+	retryAfter, err := strconv.Atoi(rec.Header().Get("Retry-After"))
+	assert.Nil(t, err)
+	assert.InDelta(t, 3600, retryAfter, 5)
+}
+
+func TestPluginCandidateRoute(t *testing.T) {
+	p := &model.Plugin{ID: "iothub"}
+	assert.Equal(t, "iothub-green", candidateAppID(p))
+	p.AppID = "iothub-green"
+	assert.Equal(t, "iothub", candidateAppID(p))
+
+	pr := &model.PluginRoute{ID: "iothub", Candidate: &model.PluginCandidate{
+		AppID:   "iothub-green",
+		Tenants: []string{"t1"},
+		Status:  model.CandidateStatusDeploying,
+	}}
+	dst := &endpoint{ID: "iothub", route: pr}
+	assert.Equal(t, "iothub", dst.appID("t1"))
+	pr.Candidate.Status = model.CandidateStatusReady
+	assert.Equal(t, "iothub-green", dst.appID("t1"))
+	assert.Equal(t, "iothub", dst.appID("t2"))
+	pr.Candidate.Percent = 100
+	assert.Equal(t, "iothub-green", dst.appID("t2"))
+	assert.Equal(t, "iothub-green", (&endpoint{ID: "iothub", AppID: "iothub-green"}).appID("t2"))
+
+	assert.True(t, validCandidateRoute([]string{"t1"}, 50))
+	assert.False(t, validCandidateRoute(nil, 101))
+	assert.False(t, validCandidateRoute([]string{""}, 0))
+}
+
+func TestPluginGraph(t *testing.T) {
+	ps := []*model.Plugin{
+		{
+			ID: "iothub", PluginVersion: "v0.4.1",
+			PluginDependences: []*v1.BriefPluginInfo{{Id: "core", Version: ">=v0.4.0"}},
+			EnableTenantes:    []*model.EnableTenant{{TenantID: "t1"}, {TenantID: "t2"}},
+		},
+		{
+			ID: "rule-manager",
+			ImplementedPlugin: []*v1.ImplementedPlugin{{
+				Plugin: &v1.BriefPluginInfo{Id: "iothub"},
+				Addons: []*v1.ImplementedAddons{{AddonsPoint: "device-changed"}},
+			}},
+			EnableTenantes: []*model.EnableTenant{{TenantID: "t1"}},
+		},
+		{ID: "console", EnableTenantes: []*model.EnableTenant{{TenantID: "t2"}}},
+	}
+	routes := map[string]*model.PluginRoute{
+		"iothub": {ID: "iothub", RegisterAddons: map[string]string{"device-changed": "rule-manager/device"}},
+	}
+	g := buildPluginGraph(ps, routes)
+	assert.Len(t, g.nodes, 4)
+	assert.Equal(t, _graphStatusNotInstalled, g.node("core").Status)
+	assert.Len(t, g.edges, 3)
+
+	impact := g.impact("iothub")
+	ids := make([]string, 0)
+	for _, v := range impact.nodes {
+		ids = append(ids, v.Id)
+	}
+	assert.Equal(t, []string{"core", "iothub", "rule-manager"}, ids)
+	assert.Len(t, g.impact("console").edges, 0)
+
+	t2 := g.tenant("t2")
+	assert.Len(t, t2.nodes, 2)
+	assert.Len(t, t2.edges, 0)
+
+	dot := g.dot()
+	assert.Contains(t, dot, `"iothub" [label="iothub\nv0.4.1"];`)
+	assert.Contains(t, dot, `"iothub" -> "core" [label="dependence: >=v0.4.0", style=solid];`)
+	assert.Contains(t, dot, `"rule-manager" -> "iothub" [label="implementation: device-changed", style=dashed];`)
+}
+
+func TestDetectDrifts(t *testing.T) {
+	now := time.Now()
+	old := now.Add(-time.Hour)
+	releases := []*helm.Release{
+		{Name: "iothub", Updated: old},
+		{Name: "iothub-green", Updated: old},
+		{Name: "leftover", Chart: "leftover", ChartVersion: "0.1.0", Status: "failed", Updated: old},
+		{Name: "installing", Updated: now},
+	}
+	ps := []*model.Plugin{
+		{ID: "iothub", Installer: &model.Installer{}, Status: v1.PluginStatus_RUNNING},
+		{ID: "removed", Installer: &model.Installer{}, Status: v1.PluginStatus_RUNNING},
+	}
+	routes := model.PluginProxyRouteMap{
+		"iothub": {ID: "iothub", Candidate: &model.PluginCandidate{AppID: "iothub-green"}},
+		"stale":  {ID: "stale"},
+	}
+	drifts := detectDrifts(now, releases, ps, routes, []string{"iothub", "stale", "rudder"})
+	got := make([]string, 0, len(drifts))
+	for _, v := range drifts {
+		got = append(got, v.Key())
+	}
+	assert.Equal(t, []string{
+		"orphan_release/leftover", "missing_release/removed", "orphan_route/stale",
+		"missing_route/removed", "orphan_permission/stale", "missing_permission/removed",
+	}, got)
+
+	repair, err := driftKindSet(splitDriftKinds(" orphan_route, orphan_release"))
+	assert.Nil(t, err)
+	assert.True(t, repair[model.DriftOrphanRoute])
+	_, err = driftKindSet([]string{"unknown"})
+	assert.NotNil(t, err)
+}
+
+func TestRepairDrifts(t *testing.T) {
+	p := model.NewPlugin("iothub", &model.Installer{Version: "0.4.1"})
+	p.Status = v1.PluginStatus_RUNNING
+	pOp := newFakePluginOperator(p)
+	s := &PluginServiceV1{pluginOp: pOp}
+	ctx := context.Background()
+	newDrifts := func() []*model.Drift {
+		return []*model.Drift{
+			{Kind: model.DriftMissingRoute, Target: "iothub"},
+			{Kind: model.DriftOrphanRoute, Target: "old"},
+		}
+	}
+	repair := func(d *model.Drift) bool {
+		return d.Kind == model.DriftMissingRoute
+	}
+
+	// the drift found the first time is not repaired.
+	drifts := newDrifts()
+	s.repairDrifts(ctx, drifts, repair)
+	assert.False(t, drifts[0].Repaired)
+	got, err := pOp.Get(ctx, "iothub")
+	assert.Nil(t, err)
+	assert.Equal(t, v1.PluginStatus_RUNNING, got.Status)
+
+	// the drift found again is repaired, the kinds not requested are only reported.
+	drifts = newDrifts()
+	s.repairDrifts(ctx, drifts, repair)
+	assert.True(t, drifts[0].Repaired)
+	assert.False(t, drifts[1].Repaired)
+	got, err = pOp.Get(ctx, "iothub")
+	assert.Nil(t, err)
+	assert.Equal(t, v1.PluginStatus_ERR_REGISTER, got.Status)
+
+	// the repaired drift found again is seen the first time.
+	drifts = newDrifts()
+	s.repairDrifts(ctx, drifts, repair)
+	assert.False(t, drifts[0].Repaired)
+}
+
+func TestRollbackUpgrade(t *testing.T) {
+	oldP := model.NewPlugin("iothub", &model.Installer{Repo: "tkeel", Name: "iothub", Version: "0.4.0"})
+	oldP.PluginVersion = "v0.4.0"
+	upgraded := oldP.Clone()
+	upgraded.Upgrade(&model.Installer{Repo: "tkeel", Name: "iothub", Version: "0.5.0"})
+	upgraded.Version = "3"
+	pOp := newFakePluginOperator(upgraded)
+	s := &PluginServiceV1{pluginOp: pOp}
+	upgrader := &fakeInstaller{brief: repository.InstallerBrief{Name: "iothub", Version: "0.4.0"}}
+
+	assert.Nil(t, s.rollbackUpgrade(context.TODO(), oldP, upgrader, 2))
+	assert.Equal(t, []int{2}, upgrader.rolledBack)
+	p, err := pOp.Get(context.TODO(), "iothub")
+	assert.Nil(t, err)
+	assert.Equal(t, "0.4.0", p.Installer.Version)
+	assert.Equal(t, "v0.4.0", p.PluginVersion)
+	assert.Equal(t, "4", p.Version)
+
+	// the plugin removed meanwhile is not restored.
+	_, err = pOp.Delete(context.TODO(), "iothub")
+	assert.Nil(t, err)
+	assert.NotNil(t, s.rollbackUpgrade(context.TODO(), oldP, upgrader, 2))
+	_, err = pOp.Get(context.TODO(), "iothub")
+	assert.True(t, errors.Is(err, plugin.ErrPluginNotExsist))
+}
+
+func TestAsyncOperationRecover(t *testing.T) {
+	opOp := newFakeOperationOperator()
+	s := &PluginServiceV1{operationOp: opOp}
+	opID, err := s.asyncOperation(context.TODO(), model.OperationTypeInstall, "iothub", func(ctx context.Context) error {
+		operationFromContext(ctx).start(ctx, model.PhaseHelmAction)
+		var repo repository.Repository
+		_, err := repo.Get("iothub", "0.4.1")
+		return err
+	})
+	assert.Nil(t, err)
+	var op *model.Operation
+	assert.Eventually(t, func() bool {
+		op, err = opOp.Get(context.TODO(), opID)
+		return err == nil && op.IsFinished()
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, model.OperationStatusFailed, op.Status)
+	assert.Contains(t, op.Message, "panic")
+	assert.Equal(t, model.OperationStatusFailed, op.Phases[0].Status)
+}
+
+func TestApplyRepoUpdate(t *testing.T) {
+	r := newFakeRepo("manifest")
+	r.info.Annotations = repository.Annotations{"owner": "tkeel"}
+	r.info.Verify = "if-present"
+	r.info.RefreshInterval = "30m"
+	addTestRepo(t, r)
+	s := &ManifestService{}
+	assert.Nil(t, s.applyRepoChange(&manifest.Change{
+		Kind:   manifest.KindRepo,
+		Action: manifest.ActionUpdate,
+		Repo:   &manifest.Repo{Name: "manifest", URL: "https://example.com/charts"},
+	}))
+	repo, err := hub.GetInstance().Get("manifest")
+	assert.Nil(t, err)
+	info := repo.Info()
+	assert.Equal(t, "https://example.com/charts", info.URL)
+	assert.Equal(t, repository.Annotations{"owner": "tkeel"}, info.Annotations)
+	assert.Equal(t, "if-present", info.Verify)
+	assert.Equal(t, "30m", info.RefreshInterval)
+}
+
+func TestRunBatchTenant(t *testing.T) {
+	opOp := newFakeOperationOperator()
+	s := &PluginServiceV1{operationOp: opOp}
+	p := model.NewPlugin("iothub", nil)
+	p.TenantEnable(&model.EnableTenant{TenantID: "t000"})
+	tenants := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		tenants = append(tenants, fmt.Sprintf("t%03d", i))
+	}
+	action := &batchTenantAction{
+		opType:       model.OperationTypeBatchTenantEnable,
+		reportAction: model.ReportActionTenantEnable,
+		skip: func(p *model.Plugin, tenantID string) bool {
+			return p.CheckTenantEnable(tenantID)
+		},
+		run: func(ctx context.Context, pluginID, tenantID string, extra []byte) error {
+			if strings.HasSuffix(tenantID, "9") {
+				return errors.New("tenant enable failed")
+			}
+			return nil
+		},
+	}
+	opID, err := s.asyncOperation(context.TODO(), action.opType, p.ID, func(ctx context.Context) error {
+		return s.runBatchTenant(ctx, p, tenants, &plugin_pb.BatchTenantRequest{Id: p.ID}, action)
+	})
+	assert.Nil(t, err)
+	var op *model.Operation
+	assert.Eventually(t, func() bool {
+		op, err = opOp.Get(context.TODO(), opID)
+		return err == nil && op.IsFinished()
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, model.OperationStatusFailed, op.Status)
+	assert.Equal(t, "10 of 100 tenants failed", op.Message)
+	assert.Len(t, op.Report, 100)
+	resp := convertBatchTenantReport(op.ID, tenants, op.Report)
+	assert.Equal(t, int32(10), resp.Failed)
+	assert.Equal(t, int32(90), resp.Succeeded)
+	// the reports are saved in batches and the operation is finished once.
+	opOp.Lock()
+	writes := opOp.writes
+	opOp.Unlock()
+	assert.Less(t, writes, 100/_reportSaveBatch+8)
+}
+
+func TestOperationFinishOnce(t *testing.T) {
+	opOp := newFakeOperationOperator()
+	s := &PluginServiceV1{operationOp: opOp}
+	ctx, tracker, err := s.newOperation(context.TODO(), model.OperationTypeInstall, "iothub")
+	assert.Nil(t, err)
+	tracker.finish(ctx, errors.New("install failed"))
+	tracker.finish(ctx, errors.New("other"))
+	tracker.finish(ctx, nil)
+	op, err := opOp.Get(ctx, tracker.id())
+	assert.Nil(t, err)
+	assert.Equal(t, model.OperationStatusFailed, op.Status)
+	assert.Equal(t, "install failed", op.Message)
+}
+
+func TestPruneOperations(t *testing.T) {
+	now := time.Now()
+	newOp := func(id, status string, age time.Duration) *model.Operation {
+		op := model.NewOperation(model.OperationTypeInstall, "iothub")
+		op.ID, op.Status, op.UpdateTimestamp = id, status, now.Add(-age).Unix()
+		return op
+	}
+	ops := []*model.Operation{
+		newOp("expired", model.OperationStatusSucceeded, 8*24*time.Hour),
+		newOp("running", model.OperationStatusRunning, 30*24*time.Hour),
+		newOp("old", model.OperationStatusFailed, 3*time.Hour),
+		newOp("older", model.OperationStatusSucceeded, 4*time.Hour),
+		newOp("new", model.OperationStatusSucceeded, time.Hour),
+	}
+	assert.Equal(t, []string{"expired"}, expiredOperations(ops, now, 7*24*time.Hour, 10))
+	assert.Equal(t, []string{"expired", "older", "old"}, expiredOperations(ops, now, 7*24*time.Hour, 2))
+	assert.Len(t, expiredOperations(ops[1:2], now, time.Hour, 0), 0)
+
+	opOp := newFakeOperationOperator()
+	for _, v := range ops {
+		assert.Nil(t, opOp.Create(context.TODO(), v))
+	}
+	s := &PluginServiceV1{operationOp: opOp}
+	assert.Nil(t, s.pruneOperations(context.TODO(), now))
+	remain, err := opOp.List(context.TODO())
+	assert.Nil(t, err)
+	assert.Len(t, remain, 4)
+	_, err = opOp.Get(context.TODO(), "expired")
+	assert.True(t, errors.Is(err, operation.ErrOperationNotExsist))
+}
+
+func TestResolveInstallPlan(t *testing.T) {
+	installer := func(name, version, deps string) *fakeInstaller {
+		return &fakeInstaller{
+			brief:       repository.InstallerBrief{Name: name, Version: version},
+			annotations: repository.Annotations{repository.DependencesKey: deps},
+		}
+	}
+	// app depends on b and c, both depend on d with different constraints.
+	newRepo := func(name string, dVersions ...string) (*fakeRepo, *fakeInstaller) {
+		app := installer("app", "1.0.0", "b,c")
+		installers := []*fakeInstaller{app, installer("b", "0.1.0", "d@>=0.4"), installer("c", "0.1.0", "d@>=0.5")}
+		for _, v := range dVersions {
+			installers = append(installers, installer("d", v, ""))
+		}
+		return newFakeRepo(name, installers...), app
+	}
+	t.Run("diamond", func(t *testing.T) {
+		r, app := newRepo("resolve", "0.4.0", "0.5.0")
+		addTestRepo(t, r)
+		s := &PluginServiceV1{pluginOp: newFakePluginOperator()}
+		plan, err := s.resolveInstallPlan(context.Background(), "app", app)
+		assert.Nil(t, err)
+		ids := make([]string, 0, len(plan))
+		for _, v := range plan {
+			ids = append(ids, v.id+"@"+v.installer.Brief().Version)
+		}
+		assert.Equal(t, []string{"d@0.5.0", "b@0.1.0", "c@0.1.0", "app@1.0.0"}, ids)
+	})
+	t.Run("visited dependence conflict", func(t *testing.T) {
+		r, app := newRepo("resolve", "0.4.0")
+		addTestRepo(t, r)
+		s := &PluginServiceV1{pluginOp: newFakePluginOperator()}
+		_, err := s.resolveInstallPlan(context.Background(), "app", app)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "plugin(c) requires plugin(d@>=0.5) but version is 0.4.0")
+	})
+	t.Run("installed dependence conflict", func(t *testing.T) {
+		r, app := newRepo("resolve", "0.5.0")
+		addTestRepo(t, r)
+		s := &PluginServiceV1{pluginOp: newFakePluginOperator(&model.Plugin{ID: "d", PluginVersion: "0.4.0"})}
+		_, err := s.resolveInstallPlan(context.Background(), "app", app)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "plugin(c) requires plugin(d@>=0.5) but version is 0.4.0")
+	})
+}
+
+func TestCheckDependencesRegistered(t *testing.T) {
+	s := &PluginServiceV1{pluginOp: newFakePluginOperator(
+		&model.Plugin{ID: "b", Status: v1.PluginStatus_RUNNING},
+		&model.Plugin{ID: "c", Status: v1.PluginStatus_WAIT_RUNNING},
+		&model.Plugin{ID: "d", Status: v1.PluginStatus_ERR_REGISTER},
+	)}
+	ctx := context.Background()
+	reg := model.NewRegistration(model.RegistrationActionInstall, "app", "")
+	assert.Nil(t, s.checkDependencesRegistered(ctx, reg))
+	reg.Dependences = []string{"b"}
+	assert.Nil(t, s.checkDependencesRegistered(ctx, reg))
+	reg.Dependences = []string{"b", "c"}
+	assert.ErrorIs(t, s.checkDependencesRegistered(ctx, reg), register.ErrNotReady)
+	reg.Dependences = []string{"b", "d"}
+	err := s.checkDependencesRegistered(ctx, reg)
+	assert.NotNil(t, err)
+	assert.NotErrorIs(t, err, register.ErrNotReady)
+	reg.Dependences = []string{"missing"}
+	assert.NotNil(t, s.checkDependencesRegistered(ctx, reg))
+}
+
+func TestConstraintVersionSource(t *testing.T) {
+	oldVersion := version.Version
+	version.Version = "v0.4.0"
+	defer func() { version.Version = oldVersion }()
+	// device reports a version newer than its chart.
+	s := &PluginServiceV1{pluginOp: newFakePluginOperator(
+		&model.Plugin{
+			ID: "iothub", Installer: &model.Installer{Version: "0.4.0"},
+			PluginDependences: []*v1.BriefPluginInfo{{Id: "device", Version: ">=0.5.0"}},
+		},
+		&model.Plugin{ID: "device", PluginVersion: "0.6.0", Installer: &model.Installer{Version: "0.4.0"}},
+	)}
+	ctx := context.Background()
+	assert.Equal(t, "0.4.0", pluginVersion(&model.Plugin{PluginVersion: "0.6.0", Installer: &model.Installer{Version: "0.4.0"}}))
+	assert.Equal(t, "0.6.0", pluginVersion(&model.Plugin{PluginVersion: "0.6.0"}))
+
+	// the upgrade pre-check and the registration agree on the chart version.
+	cs, err := s.checkInstallerConstraints(ctx, "device", &fakeInstaller{brief: repository.InstallerBrief{Name: "device", Version: "0.4.0"}})
+	assert.Nil(t, err)
+	assert.Len(t, cs, 1)
+	resp := &v1.IdentifyResponse{PluginId: "device", Version: "0.6.0", TkeelVersion: "v0.4.0"}
+	assert.ErrorIs(t, s.checkIdentify(ctx, resp, "0.4.0"), ErrVersionConstraintConflict)
+
+	cs, err = s.checkInstallerConstraints(ctx, "device", &fakeInstaller{brief: repository.InstallerBrief{Name: "device", Version: "0.5.0"}})
+	assert.Nil(t, err)
+	assert.Len(t, cs, 0)
+	resp.Version = "0.3.0"
+	assert.Nil(t, s.checkIdentify(ctx, resp, "0.5.0"))
+
+	cs, err = s.checkDeclaredConstraints(ctx, "iothub", map[string][]string{"device": {">=0.5.0"}})
+	assert.Nil(t, err)
+	assert.Len(t, cs, 1)
+}
+
+// fakeAuditOperator keep the recorded audit logs.
+type fakeAuditOperator struct {
+	logs []*model.AuditLog
+}
+
+func (o *fakeAuditOperator) Create(ctx context.Context, a *model.AuditLog) error {
+	o.logs = append(o.logs, a)
+	return nil
+}
+
+func (o *fakeAuditOperator) List(ctx context.Context, q *audit.Query) ([]*model.AuditLog, int64, error) {
+	return o.logs, int64(len(o.logs)), nil
+}
+
+func TestRecordAudit(t *testing.T) {
+	op := &fakeAuditOperator{}
+	InitAuditRecorder(op)
+	defer InitAuditRecorder(nil)
+	header := http.Header{}
+	header.Set(model.XtKeelAuthHeader, (&model.User{User: "admin", Tenant: model.TKeelTenant}).Base64Encode())
+	ctx := transport_http.ContextWithHeader(context.Background(), header)
+
+	recordAudit(ctx, model.AuditActionInstallPlugin, model.TKeelTenant, "iothub", &plugin_pb.InstallPluginRequest{
+		Id: "iothub",
+		Installer: &plugin_pb.Installer{
+			Name: "iothub",
+			Type: plugin_pb.ConfigurationType_YAML,
+			Configuration: []byte("image: iothub:v1\ndb:\n  password: p@ssw0rd\nsecret: s3cr3t\n" +
+				"users:\n- name: u1\n  token: t0k3n\n"),
+		},
+	}, nil)
+	recordAudit(ctx, model.AuditActionReconfigurePlugin, model.TKeelTenant, "iothub", &plugin_pb.ReconfigurePluginRequest{
+		Id:            "iothub",
+		Type:          plugin_pb.ConfigurationType_JSON,
+		Configuration: []byte(`{"replicas":2,"redis":{"password":"r3dis"}}`),
+	}, errors.New("failed"))
+	recordAudit(ctx, model.AuditActionReconfigurePlugin, model.TKeelTenant, "iothub", &plugin_pb.ReconfigurePluginRequest{
+		Id:            "iothub",
+		Configuration: []byte("not a map"),
+	}, nil)
+
+	assert.Len(t, op.logs, 3)
+	for _, v := range op.logs {
+		assert.Equal(t, "admin", v.Actor)
+		assert.Equal(t, model.TKeelTenant, v.ActorTenant)
+		assert.Equal(t, "iothub", v.Target)
+		for _, secret := range []string{"p@ssw0rd", "s3cr3t", "t0k3n", "r3dis", "not a map"} {
+			assert.NotContains(t, v.Params, secret)
+		}
+		assert.NotContains(t, v.Params, base64.StdEncoding.EncodeToString([]byte("not a map")))
+	}
+	assert.Equal(t, model.AuditActionInstallPlugin, op.logs[0].Action)
+	assert.Contains(t, op.logs[0].Params, `"image":"iothub:v1"`)
+	assert.Contains(t, op.logs[0].Params, `"name":"u1"`)
+	assert.Equal(t, model.AuditActionReconfigurePlugin, op.logs[1].Action)
+	assert.Equal(t, model.AuditResultFailure, op.logs[1].Result)
+	assert.Contains(t, op.logs[1].Params, `"replicas":2`)
+	assert.Contains(t, op.logs[2].Params, `"configuration":"******"`)
+}
+
+func TestReconfigureRegistration(t *testing.T) {
+	identify := &v1.IdentifyResponse{
+		Res:          &v1.Result{Ret: v1.Retcode_OK},
+		PluginId:     "iothub",
+		Version:      "0.4.1",
+		TkeelVersion: "v0.4.0",
+		Dependence:   []*v1.BriefPluginInfo{{Id: "core", Version: ">=0.4.0"}},
+	}
+	p := model.NewPlugin("iothub", &model.Installer{Version: "0.4.1"})
+	p.Register(identify)
+	p.Status = v1.PluginStatus_RUNNING
+	pOp, opOp := newFakePluginOperator(p), newFakeOperationOperator()
+	cli := &fakeOpenapiClient{
+		status:   map[string]v1.PluginStatus{"iothub": v1.PluginStatus_WAIT_RUNNING},
+		identify: map[string]*v1.IdentifyResponse{"iothub": identify},
+	}
+	s := &PluginServiceV1{pluginOp: pOp, operationOp: opOp, openapiClient: cli}
+	ctx := context.Background()
+	newReg := func() *model.Registration {
+		op := model.NewOperation(model.OperationTypeReconfigure, "iothub")
+		assert.Nil(t, opOp.Create(ctx, op))
+		reg := model.NewRegistration(model.RegistrationActionReconfigure, "iothub", op.ID)
+		reg.OldPlugin = p.Clone()
+		return reg
+	}
+
+	// the registration waits for the reconfigured plugin running.
+	reg := newReg()
+	assert.ErrorIs(t, s.HandleRegistration(ctx, reg, false), register.ErrNotReady)
+	cli.status["iothub"] = v1.PluginStatus_RUNNING
+
+	// the unchanged identify finishes the operation without registering.
+	assert.Nil(t, s.HandleRegistration(ctx, reg, false))
+	op, err := opOp.Get(ctx, reg.OperationID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.OperationStatusSucceeded, op.Status)
+	assert.Equal(t, 0, pOp.updates)
+
+	// the changed identify is registered again, the invalid identify fails the attempt.
+	changed := proto.Clone(identify).(*v1.IdentifyResponse)
+	changed.TkeelVersion = "invalid"
+	assert.False(t, p.IdentifyEqual(changed))
+	cli.identify["iothub"] = changed
+	reg = newReg()
+	err = s.HandleRegistration(ctx, reg, false)
+	assert.NotNil(t, err)
+	assert.NotErrorIs(t, err, register.ErrNotReady)
+	op, err = opOp.Get(ctx, reg.OperationID)
+	assert.Nil(t, err)
+	assert.False(t, op.IsFinished())
+}
+
+func TestRegistrationNotReadyGivenUp(t *testing.T) {
+	p := model.NewPlugin("iothub", &model.Installer{Version: "0.4.1"})
+	p.Status = v1.PluginStatus_WAIT_RUNNING
+	pOp, opOp := newFakePluginOperator(p), newFakeOperationOperator()
+	cli := &fakeOpenapiClient{status: map[string]v1.PluginStatus{"iothub": v1.PluginStatus_WAIT_RUNNING}}
+	s := &PluginServiceV1{pluginOp: pOp, operationOp: opOp, openapiClient: cli}
+	ctx := context.Background()
+	op := model.NewOperation(model.OperationTypeInstall, "iothub")
+	assert.Nil(t, opOp.Create(ctx, op))
+	reg := model.NewRegistration(model.RegistrationActionInstall, "iothub", op.ID)
+
+	// the plugin not ready waits for the next attempt.
+	assert.ErrorIs(t, s.HandleRegistration(ctx, reg, false), register.ErrNotReady)
+	op, err := opOp.Get(ctx, reg.OperationID)
+	assert.Nil(t, err)
+	assert.False(t, op.IsFinished())
+
+	// the plugin not ready on the final attempt fails the registration.
+	err = s.HandleRegistration(ctx, reg, true)
+	assert.ErrorIs(t, err, register.ErrReadyTimeout)
+	op, err = opOp.Get(ctx, reg.OperationID)
+	assert.Nil(t, err)
+	assert.Equal(t, model.OperationStatusFailed, op.Status)
+	got, err := pOp.Get(ctx, "iothub")
+	assert.Nil(t, err)
+	assert.Equal(t, v1.PluginStatus_ERR_REGISTER, got.Status)
+}
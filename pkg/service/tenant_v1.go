@@ -14,6 +14,7 @@ import (
 	pb "github.com/tkeel-io/tkeel/api/tenant/v1"
 	t_model "github.com/tkeel-io/tkeel/pkg/model"
 	"github.com/tkeel-io/tkeel/pkg/model/metrics"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"gorm.io/gorm"
 )
@@ -39,12 +40,19 @@ func NewTenantService(db *gorm.DB, tenantPluginOp rbac.TenantPluginMgr, rbacOp *
 	return &TenantService{DB: db, TenantPluginOp: tenantPluginOp, RBACOp: rbacOp, DaprClient: daprClient, DaprStore: daprStore}
 }
 
-func (s *TenantService) CreateTenant(ctx context.Context, req *pb.CreateTenantRequest) (*pb.CreateTenantResponse, error) {
+func (s *TenantService) CreateTenant(ctx context.Context, req *pb.CreateTenantRequest) (_ *pb.CreateTenantResponse, err error) {
 	var (
-		err    error
 		tenant = &model.Tenant{}
 		resp   = &pb.CreateTenantResponse{}
 	)
+	defer func() {
+		// do not record the admin password.
+		params, _ := proto.Clone(req).(*pb.CreateTenantRequest)
+		if params.GetBody().GetAdmin() != nil {
+			params.Body.Admin.Password = ""
+		}
+		recordAudit(ctx, t_model.AuditActionCreateTenant, tenant.ID, tenant.ID, params, err)
+	}()
 	tenant.ID = req.Body.GetTenantId()
 	if tenant.ID == "" {
 		tenant.ID, _ = utils.RandBase64String(6)
@@ -259,7 +267,10 @@ func (s *TenantService) TenantByExactSearch(ctx context.Context, req *pb.ExactTe
 	return &pb.ExactTenantResponse{TenantId: tenants[0].ID, Title: tenants[0].Title, AuthType: authType}, nil
 }
 
-func (s *TenantService) UpdateTenant(ctx context.Context, req *pb.UpdateTenantRequest) (*pb.UpdateTenantResponse, error) {
+func (s *TenantService) UpdateTenant(ctx context.Context, req *pb.UpdateTenantRequest) (_ *pb.UpdateTenantResponse, err error) {
+	defer func() {
+		recordAudit(ctx, t_model.AuditActionUpdateTenant, req.GetTenantId(), req.GetTenantId(), req, err)
+	}()
 	tenantDao := &model.Tenant{}
 	where := map[string]interface{}{"id": req.GetTenantId()}
 	updates := map[string]interface{}{"title": req.GetBody().GetTitle(), "remark": req.GetBody().GetRemark()}
@@ -267,7 +278,7 @@ func (s *TenantService) UpdateTenant(ctx context.Context, req *pb.UpdateTenantRe
 	if tenantDao.Existed(s.DB) {
 		return nil, pb.ErrTenantAlreadyExisted()
 	}
-	_, err := tenantDao.Update(s.DB, where, updates)
+	_, err = tenantDao.Update(s.DB, where, updates)
 	if err != nil {
 		log.Error(err)
 		return nil, pb.ErrInternalStore()
@@ -275,12 +286,14 @@ func (s *TenantService) UpdateTenant(ctx context.Context, req *pb.UpdateTenantRe
 	return &pb.UpdateTenantResponse{}, nil
 }
 
-func (s *TenantService) DeleteTenant(ctx context.Context, req *pb.DeleteTenantRequest) (*emptypb.Empty, error) {
+func (s *TenantService) DeleteTenant(ctx context.Context, req *pb.DeleteTenantRequest) (_ *emptypb.Empty, err error) {
 	var (
-		err    error
 		tenant = &model.Tenant{}
 		resp   = &emptypb.Empty{}
 	)
+	defer func() {
+		recordAudit(ctx, t_model.AuditActionDeleteTenant, req.GetTenantId(), req.GetTenantId(), req, err)
+	}()
 	tenant.ID = req.TenantId
 	if _, err = s.RBACOp.RemoveFilteredPolicy(1, req.TenantId); err != nil {
 		log.Error(err)
@@ -2,6 +2,7 @@ package util
 
 import (
 	v1 "github.com/tkeel-io/tkeel-interface/openapi/v1"
+	audit_pb "github.com/tkeel-io/tkeel/api/audit/v1"
 	pb "github.com/tkeel-io/tkeel/api/plugin/v1"
 	"github.com/tkeel-io/tkeel/pkg/model"
 	"github.com/tkeel-io/tkeel/pkg/repository"
@@ -57,6 +58,12 @@ func ConvertModel2PluginBriefObjectPb(p *model.Plugin, tenantID string) *pb.Plug
 		Status: func() v1.PluginStatus {
 			return p.Status
 		}(),
+		Health: func() string {
+			if p.Health == nil {
+				return ""
+			}
+			return p.Health.State
+		}(),
 		Switchable: func() bool {
 			for _, v := range model.TKeelConsole {
 				if p.ID == v {
@@ -73,7 +80,6 @@ func ConvertModel2PluginObjectPb(p *model.Plugin, pr *model.PluginRoute, tenantI
 		Plugin:            ConvertModel2PluginBriefObjectPb(p, tenantID),
 		AddonsPoint:       p.AddonsPoint,
 		ImplementedPlugin: p.ImplementedPlugin,
-		Secret:            p.Secret,
 		EnableTenantes: func() []*pb.EnabledTenant {
 			ret := make([]*pb.EnabledTenant, 0, len(p.EnableTenantes))
 			for _, v := range p.EnableTenantes {
@@ -102,7 +108,69 @@ func ConvertModel2PluginObjectPb(p *model.Plugin, pr *model.PluginRoute, tenantI
 		}(),
 
 		ConsoleEntries: p.ConsoleEntries,
+		Health:         ConvertModel2PluginHealthPb(p.Health),
+		UpgradePolicy:  upgradePolicy(p.UpgradePolicy),
+		Maintenance: func() *pb.PluginMaintenance {
+			if pr == nil || pr.Maintenance == nil {
+				return nil
+			}
+			return &pb.PluginMaintenance{
+				Message:        pr.Maintenance.Message,
+				StartTimestamp: pr.Maintenance.StartTimestamp,
+				EndTimestamp:   pr.Maintenance.EndTimestamp,
+				OperatorId:     pr.Maintenance.OperatorID,
+			}
+		}(),
+		Candidate: func() *pb.PluginCandidate {
+			if pr == nil || pr.Candidate == nil {
+				return nil
+			}
+			c := &pb.PluginCandidate{
+				AppId:          pr.Candidate.AppID,
+				Tenants:        pr.Candidate.Tenants,
+				Percent:        pr.Candidate.Percent,
+				Status:         pr.Candidate.Status,
+				Message:        pr.Candidate.Message,
+				OperatorId:     pr.Candidate.OperatorID,
+				StartTimestamp: pr.Candidate.StartTimestamp,
+			}
+			if pr.Candidate.Installer != nil {
+				c.Repo = pr.Candidate.Installer.Repo
+				c.Installer = pr.Candidate.Installer.Name
+				c.Version = pr.Candidate.Installer.Version
+			}
+			return c
+		}(),
+	}
+}
+
+// upgradePolicy get the upgrade policy, empty means manual.
+func upgradePolicy(policy string) string {
+	if policy == "" {
+		return model.UpgradePolicyManual
+	}
+	return policy
+}
+
+func ConvertModel2PluginHealthPb(h *model.PluginHealth) *pb.PluginHealth {
+	if h == nil {
+		return nil
+	}
+	ret := &pb.PluginHealth{
+		State:       h.State,
+		Reason:      h.Reason,
+		Since:       h.Since,
+		Transitions: make([]*pb.HealthTransition, 0, len(h.Transitions)),
 	}
+	for _, v := range h.Transitions {
+		ret.Transitions = append(ret.Transitions, &pb.HealthTransition{
+			From:      v.From,
+			To:        v.To,
+			Reason:    v.Reason,
+			Timestamp: v.Timestamp,
+		})
+	}
+	return ret
 }
 
 func ConvertModel2RepositoryInstallerObject(i *model.Installer) *repository.InstallerBrief {
@@ -113,3 +181,59 @@ func ConvertModel2RepositoryInstallerObject(i *model.Installer) *repository.Inst
 		State:   repository.StateInstalled,
 	}
 }
+
+func ConvertModel2OperationObjectPb(op *model.Operation) *pb.OperationObject {
+	return &pb.OperationObject{
+		Id:              op.ID,
+		Type:            op.Type,
+		PluginId:        op.PluginID,
+		Status:          op.Status,
+		Message:         op.Message,
+		CreateTimestamp: op.CreateTimestamp,
+		UpdateTimestamp: op.UpdateTimestamp,
+		Phases: func() []*pb.OperationPhase {
+			ret := make([]*pb.OperationPhase, 0, len(op.Phases))
+			for _, v := range op.Phases {
+				ret = append(ret, &pb.OperationPhase{
+					Name:           v.Name,
+					Status:         v.Status,
+					Message:        v.Message,
+					StartTimestamp: v.StartTimestamp,
+					EndTimestamp:   v.EndTimestamp,
+				})
+			}
+			return ret
+		}(),
+		Report: ConvertModel2OperationReportPb(op.Report),
+	}
+}
+
+func ConvertModel2OperationReportPb(report []*model.OperationReportItem) []*pb.OperationReportItem {
+	ret := make([]*pb.OperationReportItem, 0, len(report))
+	for _, v := range report {
+		ret = append(ret, &pb.OperationReportItem{
+			Action:    v.Action,
+			Target:    v.Target,
+			Detail:    v.Detail,
+			Status:    v.Status,
+			Message:   v.Message,
+			Timestamp: v.Timestamp,
+		})
+	}
+	return ret
+}
+
+func ConvertModel2AuditLogObjectPb(a *model.AuditLog) *audit_pb.AuditLogObject {
+	return &audit_pb.AuditLogObject{
+		Id:          uint64(a.ID),
+		Actor:       a.Actor,
+		ActorTenant: a.ActorTenant,
+		TenantId:    a.Tenant,
+		Action:      a.Action,
+		Target:      a.Target,
+		Params:      a.Params,
+		Result:      a.Result,
+		Message:     a.Message,
+		Timestamp:   a.Timestamp,
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+
+	g_version "github.com/hashicorp/go-version"
 )
 
 type Version struct {
@@ -96,3 +98,36 @@ func CheckRegisterPluginTkeelVersion(dependVersion string, currVersion string) (
 	}
 	return true, nil
 }
+
+// ParseVersionConstraints parse the version constraint expression such as ">=0.4.0,<0.6".
+// A bare version(e.g. "v0.4.0") means at least that version, an empty expression matches any version.
+func ParseVersionConstraints(constraint string) (g_version.Constraints, error) {
+	constraint = strings.TrimSpace(constraint)
+	if constraint == "" {
+		return g_version.Constraints{}, nil
+	}
+	if _, err := g_version.NewVersion(constraint); err == nil {
+		constraint = ">=" + constraint
+	}
+	ret, err := g_version.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("error version constraint(%s): %w", constraint, err)
+	}
+	return ret, nil
+}
+
+// CheckVersionConstraints whether the version satisfies the constraint expression.
+func CheckVersionConstraints(constraint, ver string) (bool, error) {
+	cs, err := ParseVersionConstraints(constraint)
+	if err != nil {
+		return false, err
+	}
+	if len(cs) == 0 {
+		return true, nil
+	}
+	v, err := g_version.NewVersion(ver)
+	if err != nil {
+		return false, fmt.Errorf("error version(%s): %w", ver, err)
+	}
+	return cs.Check(v), nil
+}